@@ -0,0 +1,44 @@
+package config
+
+import (
+	"flag"
+	"os"
+
+	"github.com/goccy/go-yaml"
+)
+
+// MustLoad reads the yaml file given by the --config flag or the CONFIG
+// environment variable into cfg, secrets and validation stay with the service.
+func MustLoad(cfg any) {
+	confPath := fetchConfigPath()
+
+	if confPath == "" {
+		panic("config path is empty")
+	}
+
+	if _, err := os.Stat(confPath); err != nil {
+		panic("cannot open config path")
+	}
+
+	data, err := os.ReadFile(confPath)
+	if err != nil {
+		panic("cannot read config path")
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		panic("cannot parse config path")
+	}
+}
+
+func fetchConfigPath() string {
+	var confPath string
+
+	flag.StringVar(&confPath, "config", "", "path to config")
+	flag.Parse()
+
+	if confPath == "" {
+		confPath = os.Getenv("CONFIG")
+	}
+
+	return confPath
+}
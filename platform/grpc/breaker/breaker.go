@@ -0,0 +1,186 @@
+package breaker
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type State int
+
+const (
+	StateClosed State = iota
+	StateHalfOpen
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+var ErrOpen = status.Error(codes.Unavailable, "circuit breaker is open")
+
+type result int
+
+const (
+	resultSuccess result = iota
+	resultFailure
+	// resultNone is a call the caller abandoned, it says nothing either way
+	resultNone
+)
+
+// Breaker opens after failureThreshold consecutive failures and rejects calls
+// until openTimeout passes, then lets halfOpenRequests probes through and
+// closes once all of them succeed.
+type Breaker struct {
+	log              *slog.Logger
+	failureThreshold uint32
+	openTimeout      time.Duration
+	halfOpenRequests uint32
+
+	mu        sync.Mutex
+	state     State
+	failures  uint32
+	probes    uint32
+	successes uint32
+	openedAt  time.Time
+	gen       uint64
+	now       func() time.Time
+}
+
+func NewBreaker(log *slog.Logger, failureThreshold uint32, openTimeout time.Duration, halfOpenRequests uint32) *Breaker {
+	return &Breaker{
+		log:              log,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		halfOpenRequests: halfOpenRequests,
+		now:              time.Now,
+	}
+}
+
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		gen, ok := b.allow()
+		if !ok {
+			return ErrOpen
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.done(gen, classify(ctx, err))
+		return err
+	}
+}
+
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+func (b *Breaker) allow() (uint64, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return 0, false
+		}
+		b.setState(StateHalfOpen)
+		fallthrough
+	case StateHalfOpen:
+		if b.probes >= b.halfOpenRequests {
+			return 0, false
+		}
+		b.probes++
+	}
+
+	return b.gen, true
+}
+
+// done ignores results of calls started before the last state change, a slow
+// call issued while closed must not decide the outcome of a half-open probe.
+// An abandoned probe gives its slot back so another call can probe instead.
+func (b *Breaker) done(gen uint64, res result) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if gen != b.gen {
+		return
+	}
+
+	if res == resultNone {
+		if b.state == StateHalfOpen {
+			b.probes--
+		}
+		return
+	}
+
+	failed := res == resultFailure
+
+	switch b.state {
+	case StateClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.failureThreshold {
+			b.setState(StateOpen)
+		}
+	case StateHalfOpen:
+		if failed {
+			b.setState(StateOpen)
+			return
+		}
+		b.successes++
+		if b.successes >= b.halfOpenRequests {
+			b.setState(StateClosed)
+		}
+	}
+}
+
+func (b *Breaker) setState(state State) {
+	const op = "breaker.setState"
+	b.log.Warn("circuit breaker state changed", slog.String("op", op), slog.String("from", b.state.String()), slog.String("to", state.String()))
+
+	b.state = state
+	b.gen++
+	b.failures = 0
+	b.probes = 0
+	b.successes = 0
+	if state == StateOpen {
+		b.openedAt = b.now()
+	}
+}
+
+// classify only counts errors that say something about the upstream health,
+// NotFound or Unauthenticated are regular answers. A call the caller cancelled
+// or outlived its own deadline has no result, the upstream may be fine.
+func classify(ctx context.Context, err error) result {
+	switch status.Code(err) {
+	case codes.Canceled:
+		return resultNone
+	case codes.DeadlineExceeded:
+		if ctx.Err() != nil {
+			return resultNone
+		}
+		return resultFailure
+	case codes.Unavailable, codes.Internal, codes.Unknown, codes.ResourceExhausted:
+		return resultFailure
+	default:
+		return resultSuccess
+	}
+}
@@ -0,0 +1,152 @@
+package breaker
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func call(b *Breaker, err error) (bool, error) {
+	return callContext(context.Background(), b, err)
+}
+
+func callContext(ctx context.Context, b *Breaker, err error) (bool, error) {
+	invoked := false
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		invoked = true
+		return err
+	}
+	res := b.UnaryClientInterceptor()(ctx, "/test", nil, nil, nil, invoker)
+	return invoked, res
+}
+
+func TestBreaker(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	notFound := status.Error(codes.NotFound, "not found")
+
+	now := time.Now()
+	b := NewBreaker(slog.New(slog.NewTextHandler(io.Discard, nil)), 3, 10*time.Second, 2)
+	b.now = func() time.Time { return now }
+
+	for range 2 {
+		_, err := call(b, unavailable)
+		require.Equal(t, unavailable, err)
+	}
+	_, err := call(b, notFound)
+	require.Equal(t, notFound, err)
+	require.Equal(t, StateClosed, b.State())
+
+	for range 3 {
+		call(b, unavailable)
+	}
+	require.Equal(t, StateOpen, b.State())
+
+	invoked, err := call(b, nil)
+	require.False(t, invoked)
+	require.Equal(t, ErrOpen, err)
+
+	now = now.Add(10 * time.Second)
+	invoked, err = call(b, nil)
+	require.True(t, invoked)
+	require.NoError(t, err)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	invoked, _ = call(b, unavailable)
+	require.True(t, invoked)
+	require.Equal(t, StateOpen, b.State())
+
+	now = now.Add(10 * time.Second)
+	for range 2 {
+		invoked, err = call(b, nil)
+		require.True(t, invoked)
+		require.NoError(t, err)
+	}
+	require.Equal(t, StateClosed, b.State())
+}
+
+func TestBreaker_HalfOpenLimit(t *testing.T) {
+	now := time.Now()
+	b := NewBreaker(slog.New(slog.NewTextHandler(io.Discard, nil)), 1, time.Second, 1)
+	b.now = func() time.Time { return now }
+
+	call(b, status.Error(codes.Unavailable, "unavailable"))
+	require.Equal(t, StateOpen, b.State())
+
+	now = now.Add(time.Second)
+	gen, ok := b.allow()
+	require.True(t, ok)
+
+	_, ok = b.allow()
+	require.False(t, ok)
+
+	b.done(gen, resultSuccess)
+	require.Equal(t, StateClosed, b.State())
+}
+
+func TestBreaker_StaleResult(t *testing.T) {
+	now := time.Now()
+	b := NewBreaker(slog.New(slog.NewTextHandler(io.Discard, nil)), 1, time.Second, 1)
+	b.now = func() time.Time { return now }
+
+	slow, ok := b.allow()
+	require.True(t, ok)
+
+	call(b, status.Error(codes.Unavailable, "unavailable"))
+	now = now.Add(time.Second)
+	probe, ok := b.allow()
+	require.True(t, ok)
+
+	b.done(slow, resultSuccess)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	b.done(probe, resultFailure)
+	require.Equal(t, StateOpen, b.State())
+}
+
+func TestBreaker_AbandonedCalls(t *testing.T) {
+	canceled := status.Error(codes.Canceled, "canceled")
+	deadline := status.Error(codes.DeadlineExceeded, "deadline exceeded")
+
+	cancelledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+	expiredCtx, cancel := context.WithDeadline(context.Background(), time.Now().Add(-time.Second))
+	defer cancel()
+
+	now := time.Now()
+	b := NewBreaker(slog.New(slog.NewTextHandler(io.Discard, nil)), 2, time.Second, 1)
+	b.now = func() time.Time { return now }
+
+	// abandoned calls neither count as failures nor reset the streak
+	call(b, status.Error(codes.Unavailable, "unavailable"))
+	callContext(cancelledCtx, b, canceled)
+	callContext(expiredCtx, b, deadline)
+	require.Equal(t, StateClosed, b.State())
+
+	// a deadline the upstream ran into while the caller still waits is a failure
+	call(b, deadline)
+	require.Equal(t, StateOpen, b.State())
+
+	now = now.Add(time.Second)
+	invoked, err := callContext(cancelledCtx, b, canceled)
+	require.True(t, invoked)
+	require.Equal(t, canceled, err)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	// the abandoned probe released its slot, so the next call probes
+	invoked, err = callContext(expiredCtx, b, deadline)
+	require.True(t, invoked)
+	require.Equal(t, deadline, err)
+	require.Equal(t, StateHalfOpen, b.State())
+
+	invoked, err = call(b, nil)
+	require.True(t, invoked)
+	require.NoError(t, err)
+	require.Equal(t, StateClosed, b.State())
+}
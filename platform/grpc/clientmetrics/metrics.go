@@ -0,0 +1,55 @@
+package clientmetrics
+
+import (
+	"context"
+	"platform/grpc/breaker"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+type ClientMetrics struct {
+	reg      prometheus.Registerer
+	requests *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+}
+
+func NewClientMetrics(reg prometheus.Registerer) *ClientMetrics {
+	m := &ClientMetrics{
+		reg: reg,
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "grpc_client_requests_total",
+			Help: "Outgoing gRPC calls by target, method and status code.",
+		}, []string{"target", "method", "code"}),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "grpc_client_request_duration_seconds",
+			Help:    "Latency of outgoing gRPC calls including retries.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"target", "method"}),
+	}
+	reg.MustRegister(m.requests, m.duration)
+	return m
+}
+
+func (m *ClientMetrics) UnaryClientInterceptor(target string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		m.requests.WithLabelValues(target, method, status.Code(err).String()).Inc()
+		m.duration.WithLabelValues(target, method).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
+
+func (m *ClientMetrics) ObserveBreaker(target string, b *breaker.Breaker) {
+	m.reg.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name:        "grpc_client_circuit_breaker_state",
+		Help:        "Circuit breaker state: 0 closed, 1 half-open, 2 open.",
+		ConstLabels: prometheus.Labels{"target": target},
+	}, func() float64 {
+		return float64(b.State())
+	}))
+}
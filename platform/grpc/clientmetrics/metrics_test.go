@@ -0,0 +1,44 @@
+package clientmetrics
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"platform/grpc/breaker"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClientMetrics(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	m := NewClientMetrics(reg)
+	m.ObserveBreaker("userservice", breaker.NewBreaker(slog.New(slog.NewTextHandler(io.Discard, nil)), 1, time.Second, 1))
+
+	interceptor := m.UnaryClientInterceptor("userservice")
+	for _, err := range []error{nil, nil, status.Error(codes.NotFound, "not found")} {
+		invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+			return err
+		}
+		require.Equal(t, err, interceptor(context.Background(), "/userservice/GetUser", nil, nil, nil, invoker))
+	}
+
+	expected := `
+# HELP grpc_client_requests_total Outgoing gRPC calls by target, method and status code.
+# TYPE grpc_client_requests_total counter
+grpc_client_requests_total{code="NotFound",method="/userservice/GetUser",target="userservice"} 1
+grpc_client_requests_total{code="OK",method="/userservice/GetUser",target="userservice"} 2
+# HELP grpc_client_circuit_breaker_state Circuit breaker state: 0 closed, 1 half-open, 2 open.
+# TYPE grpc_client_circuit_breaker_state gauge
+grpc_client_circuit_breaker_state{target="userservice"} 0
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "grpc_client_requests_total", "grpc_client_circuit_breaker_state"))
+	require.Equal(t, 1, testutil.CollectAndCount(m.duration))
+}
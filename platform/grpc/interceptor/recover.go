@@ -18,7 +18,7 @@ func RecoverInterceptor(log *slog.Logger) grpc.UnaryServerInterceptor {
 	) (resp any, err error) {
 		defer func() {
 			if r := recover(); r != nil {
-				log.Error("request caused panic", slog.Any("panic", r))
+				log.ErrorContext(ctx, "request caused panic", slog.Any("panic", r))
 				resp = nil
 				err = status.Error(codes.Internal, "internal server error")
 			}
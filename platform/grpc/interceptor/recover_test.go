@@ -0,0 +1,42 @@
+package interceptor
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	projectservicev1 "platform/proto/projectservice"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRecover(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	interceptor := RecoverInterceptor(log)
+
+	handlSuccess := func(ctx context.Context, req any) (any, error) {
+		return &projectservicev1.CheckAccessResponse{
+			HasAccess: true,
+		}, nil
+	}
+
+	resp, err := interceptor(context.Background(), &projectservicev1.CheckAccessRequest{UserId: 1, ProjectId: 1}, &grpc.UnaryServerInfo{}, handlSuccess)
+	require.NoError(t, err)
+	require.True(t, resp.(*projectservicev1.CheckAccessResponse).HasAccess)
+
+	handlPanic := func(ctx context.Context, req any) (any, error) {
+		panic("panic")
+	}
+
+	resp, err = interceptor(context.Background(), &projectservicev1.CheckAccessRequest{UserId: 1, ProjectId: 1}, &grpc.UnaryServerInfo{}, handlPanic)
+
+	s, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, codes.Internal, s.Code())
+	require.Equal(t, "internal server error", s.Message())
+	require.Nil(t, resp)
+}
@@ -4,9 +4,9 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	projectservicev1 "platform/proto/projectservice"
 	"testing"
 	"time"
-	userservicev1 "userservice/proto/userservice"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -20,23 +20,23 @@ func TestTimeout(t *testing.T) {
 	interceptor := TimeoutInterceptor(log, 1*time.Millisecond)
 
 	handlSuccess := func(ctx context.Context, req any) (any, error) {
-		return &userservicev1.GetIdBySessionResponse{
-			UserId: 1,
+		return &projectservicev1.CheckAccessResponse{
+			HasAccess: true,
 		}, nil
 	}
 
-	resp, err := interceptor(context.Background(), &userservicev1.GetIdBySessionRequest{SessionId: "sessionId"}, &grpc.UnaryServerInfo{}, handlSuccess)
+	resp, err := interceptor(context.Background(), &projectservicev1.CheckAccessRequest{UserId: 1, ProjectId: 1}, &grpc.UnaryServerInfo{}, handlSuccess)
 	require.NoError(t, err)
-	require.Equal(t, uint32(1), resp.(*userservicev1.GetIdBySessionResponse).UserId)
+	require.True(t, resp.(*projectservicev1.CheckAccessResponse).HasAccess)
 
 	handlTimeout := func(ctx context.Context, req any) (any, error) {
 		time.Sleep(2 * time.Millisecond)
-		return &userservicev1.GetIdBySessionResponse{
-			UserId: 1,
+		return &projectservicev1.CheckAccessResponse{
+			HasAccess: true,
 		}, nil
 	}
 
-	resp, err = interceptor(context.Background(), &userservicev1.GetIdBySessionRequest{SessionId: "sessionId"}, &grpc.UnaryServerInfo{}, handlTimeout)
+	resp, err = interceptor(context.Background(), &projectservicev1.CheckAccessRequest{UserId: 1, ProjectId: 1}, &grpc.UnaryServerInfo{}, handlTimeout)
 	s, ok := status.FromError(err)
 	require.True(t, ok)
 	require.Equal(t, codes.DeadlineExceeded, s.Code())
@@ -0,0 +1,125 @@
+package userclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	userservicev1 "platform/proto/userservice"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	_ "google.golang.org/grpc/health"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/resolver/manual"
+)
+
+const resolverScheme = "userservice"
+
+// RetryPolicy retries UNAVAILABLE calls with exponential backoff, one
+// attempt or less turns retries off.
+type RetryPolicy struct {
+	MaxAttempts       int           `yaml:"max_attempts"`
+	InitialBackoff    time.Duration `yaml:"initial_backoff"`
+	MaxBackoff        time.Duration `yaml:"max_backoff"`
+	BackoffMultiplier float64       `yaml:"backoff_multiplier"`
+}
+
+type Client struct {
+	log    *slog.Logger
+	conn   *grpc.ClientConn
+	client userservicev1.UserServiceClient
+}
+
+// NewClient balances calls round robin over addresses and skips
+// backends whose health service does not report SERVING. Extra options such
+// as interceptors or a custom dialer are appended to the defaults.
+func NewClient(log *slog.Logger, addresses []string, retry RetryPolicy, opts ...grpc.DialOption) *Client {
+	const op = "userclient.NewClient"
+
+	log.Info("create grpc client", slog.String("op", op), slog.Any("addresses", addresses))
+	r := manual.NewBuilderWithScheme(resolverScheme)
+	state := resolver.State{}
+	for _, addr := range addresses {
+		state.Addresses = append(state.Addresses, resolver.Address{Addr: addr})
+	}
+	r.InitialState(state)
+
+	opts = append([]grpc.DialOption{
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithResolvers(r),
+		grpc.WithDefaultServiceConfig(serviceConfig(retry)),
+	}, opts...)
+
+	conn, err := grpc.NewClient(resolverScheme+":///userservice", opts...)
+	if err != nil {
+		panic("cannot create new grpc client: " + err.Error())
+	}
+
+	client := userservicev1.NewUserServiceClient(conn)
+
+	return &Client{
+		log:    log,
+		conn:   conn,
+		client: client,
+	}
+}
+
+// every UserService method is a read, so the whole service is safe to retry
+func serviceConfig(retry RetryPolicy) string {
+	methodConfig := map[string]any{
+		"name": []map[string]string{{"service": userservicev1.UserService_ServiceDesc.ServiceName}},
+	}
+	if retry.MaxAttempts > 1 {
+		methodConfig["retryPolicy"] = map[string]any{
+			"maxAttempts":          retry.MaxAttempts,
+			"initialBackoff":       fmt.Sprintf("%.3fs", retry.InitialBackoff.Seconds()),
+			"maxBackoff":           fmt.Sprintf("%.3fs", retry.MaxBackoff.Seconds()),
+			"backoffMultiplier":    retry.BackoffMultiplier,
+			"retryableStatusCodes": []string{"UNAVAILABLE"},
+		}
+	}
+
+	cfg, _ := json.Marshal(map[string]any{
+		"loadBalancingConfig": []map[string]any{{"round_robin": map[string]any{}}},
+		"healthCheckConfig":   map[string]string{"serviceName": ""},
+		"methodConfig":        []any{methodConfig},
+	})
+	return string(cfg)
+}
+
+func (u *Client) GetIdBySession(ctx context.Context, sessionId string) (uint32, error) {
+	in := &userservicev1.GetIdBySessionRequest{
+		SessionId: sessionId,
+	}
+
+	res, err := u.client.GetIdBySession(ctx, in)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.UserId, nil
+}
+
+func (u *Client) GetIdByToken(ctx context.Context, token string) (uint32, error) {
+	in := &userservicev1.GetIdByTokenRequest{
+		Token: token,
+	}
+
+	res, err := u.client.GetIdByToken(ctx, in)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.UserId, nil
+}
+
+// UserService exposes the underlying stub for calls a service wraps itself.
+func (u *Client) UserService() userservicev1.UserServiceClient {
+	return u.client
+}
+
+func (u *Client) Stop() {
+	u.conn.Close()
+}
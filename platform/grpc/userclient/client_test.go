@@ -0,0 +1,200 @@
+package userclient
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"platform/grpc/breaker"
+	userservicev1 "platform/proto/userservice"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeUserService struct {
+	userservicev1.UnimplementedUserServiceServer
+
+	mu    sync.Mutex
+	errs  []error
+	calls int
+}
+
+func (f *fakeUserService) GetIdBySession(ctx context.Context, in *userservicev1.GetIdBySessionRequest) (*userservicev1.GetIdBySessionResponse, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls++
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &userservicev1.GetIdBySessionResponse{UserId: 7}, nil
+}
+
+func (f *fakeUserService) Calls() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.calls
+}
+
+type backend struct {
+	service *fakeUserService
+	health  *health.Server
+}
+
+func startBackends(t *testing.T, names ...string) (map[string]*backend, grpc.DialOption) {
+	backends := make(map[string]*backend, len(names))
+	listeners := make(map[string]*bufconn.Listener, len(names))
+	for _, name := range names {
+		b := &backend{service: &fakeUserService{}, health: health.NewServer()}
+		lis := bufconn.Listen(1024 * 1024)
+		serv := grpc.NewServer()
+		userservicev1.RegisterUserServiceServer(serv, b.service)
+		healthpb.RegisterHealthServer(serv, b.health)
+		go serv.Serve(lis)
+		t.Cleanup(serv.Stop)
+
+		backends[name] = b
+		listeners[name] = lis
+	}
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return listeners[addr].DialContext(ctx)
+	})
+	return backends, dialer
+}
+
+func newTestClient(t *testing.T, addresses []string, dialer grpc.DialOption, opts ...grpc.DialOption) *Client {
+	retry := RetryPolicy{MaxAttempts: 3, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond, BackoffMultiplier: 2}
+	client := NewClient(slog.New(slog.NewTextHandler(io.Discard, nil)), addresses, retry, append(opts, dialer)...)
+	t.Cleanup(client.Stop)
+	return client
+}
+
+func TestClient_Retry(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+
+	tests := []struct {
+		testName string
+
+		servErrs []error
+
+		expOut   uint32
+		expCode  codes.Code
+		expCalls int
+	}{
+		{
+			testName: "Success",
+
+			servErrs: nil,
+
+			expOut:   7,
+			expCode:  codes.OK,
+			expCalls: 1,
+		}, {
+			testName: "Retried unavailable",
+
+			servErrs: []error{unavailable, unavailable},
+
+			expOut:   7,
+			expCode:  codes.OK,
+			expCalls: 3,
+		}, {
+			testName: "Attempts exhausted",
+
+			servErrs: []error{unavailable, unavailable, unavailable, unavailable},
+
+			expOut:   0,
+			expCode:  codes.Unavailable,
+			expCalls: 3,
+		}, {
+			testName: "Not found not retried",
+
+			servErrs: []error{status.Error(codes.NotFound, "session not found")},
+
+			expOut:   0,
+			expCode:  codes.NotFound,
+			expCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			backends, dialer := startBackends(t, "a")
+			backends["a"].service.errs = tt.servErrs
+			client := newTestClient(t, []string{"a"}, dialer)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			out, err := client.GetIdBySession(ctx, "session")
+			require.Equal(t, tt.expCode, status.Code(err))
+			require.Equal(t, tt.expOut, out)
+			require.Equal(t, tt.expCalls, backends["a"].service.Calls())
+		})
+	}
+}
+
+func TestClient_LoadBalancing(t *testing.T) {
+	backends, dialer := startBackends(t, "a", "b")
+	client := newTestClient(t, []string{"a", "b"}, dialer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.Eventually(t, func() bool {
+		_, err := client.GetIdBySession(ctx, "session")
+		require.NoError(t, err)
+		return backends["a"].service.Calls() > 0 && backends["b"].service.Calls() > 0
+	}, 5*time.Second, time.Millisecond)
+}
+
+func TestClient_HealthAware(t *testing.T) {
+	backends, dialer := startBackends(t, "a", "b")
+	backends["b"].health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	client := newTestClient(t, []string{"a", "b"}, dialer)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 10 {
+		_, err := client.GetIdBySession(ctx, "session")
+		require.NoError(t, err)
+	}
+	require.Equal(t, 10, backends["a"].service.Calls())
+	require.Equal(t, 0, backends["b"].service.Calls())
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	backends, dialer := startBackends(t, "a")
+	backends["a"].service.errs = []error{
+		status.Error(codes.Internal, "internal"),
+		status.Error(codes.Internal, "internal"),
+	}
+	b := breaker.NewBreaker(slog.New(slog.NewTextHandler(io.Discard, nil)), 2, time.Minute, 1)
+	client := newTestClient(t, []string{"a"}, dialer, grpc.WithUnaryInterceptor(b.UnaryClientInterceptor()))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	for range 2 {
+		_, err := client.GetIdBySession(ctx, "session")
+		require.Equal(t, codes.Internal, status.Code(err))
+	}
+
+	_, err := client.GetIdBySession(ctx, "session")
+	require.Equal(t, breaker.ErrOpen, err)
+	require.Equal(t, 2, backends["a"].service.Calls())
+}
@@ -0,0 +1,65 @@
+package invalidation
+
+import (
+	"context"
+	"log/slog"
+	"platform/sessionvalidator"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type Subscriber struct {
+	log         *slog.Logger
+	client      *redis.Client
+	channel     string
+	invalidator sessionvalidator.SessionInvalidator
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewSubscriber(log *slog.Logger, client *redis.Client, channel string, invalidator sessionvalidator.SessionInvalidator) *Subscriber {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Subscriber{
+		log:         log,
+		client:      client,
+		channel:     channel,
+		invalidator: invalidator,
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+	}
+}
+
+func (s *Subscriber) Start() {
+	const op = "invalidation.Start"
+	s.log.Info("starting session invalidation subscriber", slog.String("op", op), slog.String("channel", s.channel))
+	defer close(s.done)
+
+	// go-redis resubscribes on reconnect, messages published while the
+	// connection is down are lost and only the cache ttl bounds them
+	pubsub := s.client.Subscribe(s.ctx, s.channel)
+	defer pubsub.Close()
+
+	messages := pubsub.Channel()
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			s.invalidator.Invalidate(msg.Payload)
+		}
+	}
+}
+
+func (s *Subscriber) Stop() {
+	const op = "invalidation.Stop"
+	s.log.Info("start session invalidation subscriber shutdown", slog.String("op", op))
+	s.cancel()
+	<-s.done
+	s.log.Info("session invalidation subscriber stopped", slog.String("op", op))
+}
@@ -0,0 +1,48 @@
+package invalidation
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+type invalidatorFunc func(sessionHash string)
+
+func (f invalidatorFunc) Invalidate(sessionHash string) {
+	f(sessionHash)
+}
+
+func TestSubscriber(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+
+	hashes := make(chan string, 1)
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	sub := NewSubscriber(log, client, "session_invalidation", invalidatorFunc(func(sessionHash string) {
+		hashes <- sessionHash
+	}))
+
+	go sub.Start()
+
+	require.Eventually(t, func() bool {
+		return mr.PubSubNumSub("session_invalidation")["session_invalidation"] == 1
+	}, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, client.Publish(context.Background(), "session_invalidation", "hash").Err())
+
+	select {
+	case got := <-hashes:
+		require.Equal(t, "hash", got)
+	case <-time.After(time.Second):
+		t.Fatal("invalidation was not delivered")
+	}
+
+	sub.Stop()
+}
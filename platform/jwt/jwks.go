@@ -0,0 +1,182 @@
+package jwtvalidator
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+const minRefreshInterval = 10 * time.Second
+
+var (
+	ErrKeyNotFound = errors.New("signing key not found")
+)
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type KeySet struct {
+	url             string
+	client          *http.Client
+	refreshInterval time.Duration
+
+	group singleflight.Group
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	attemptedAt time.Time
+	now         func() time.Time
+}
+
+func NewKeySet(url string, refreshInterval, timeout time.Duration) *KeySet {
+	return &KeySet{
+		url:             url,
+		client:          &http.Client{Timeout: timeout},
+		refreshInterval: refreshInterval,
+		keys:            make(map[string]crypto.PublicKey),
+		now:             time.Now,
+	}
+}
+
+func (k *KeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	key, ok, refresh := k.lookup(kid, k.now())
+	if refresh {
+		// concurrent misses share one fetch, which runs without holding mu
+		// so lookups of known kids are not blocked by a slow jwks endpoint
+		_, err, _ := k.group.Do("jwks", func() (any, error) {
+			return nil, k.refresh(context.WithoutCancel(ctx))
+		})
+		if err != nil {
+			if ok {
+				return key, nil
+			}
+			return nil, err
+		}
+		key, ok, _ = k.lookup(kid, k.now())
+	}
+
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+	return key, nil
+}
+
+// lookup returns the key for kid and whether the set should be refetched.
+func (k *KeySet) lookup(kid string, now time.Time) (crypto.PublicKey, bool, bool) {
+	k.mu.RLock()
+	defer k.mu.RUnlock()
+
+	key, ok := k.keys[kid]
+
+	// an unknown kid usually means userservice has just rotated its key,
+	// refetches are throttled so forged kids cannot flood the jwks endpoint
+	stale := now.Sub(k.fetchedAt) >= k.refreshInterval
+	return key, ok, (stale || !ok) && now.Sub(k.attemptedAt) >= minRefreshInterval
+}
+
+func (k *KeySet) refresh(ctx context.Context) error {
+	k.mu.Lock()
+	now := k.now()
+	// a fetch that finished just before this one started already covers it
+	if now.Sub(k.attemptedAt) < minRefreshInterval {
+		k.mu.Unlock()
+		return nil
+	}
+	k.attemptedAt = now
+	k.mu.Unlock()
+
+	keys, err := k.fetch(ctx)
+	if err != nil {
+		return err
+	}
+
+	k.mu.Lock()
+	k.keys = keys
+	k.fetchedAt = now
+	k.mu.Unlock()
+	return nil
+}
+
+func (k *KeySet) fetch(ctx context.Context) (map[string]crypto.PublicKey, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, k.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := k.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected jwks status: %d", resp.StatusCode)
+	}
+
+	var set jwks
+	if err := json.NewDecoder(resp.Body).Decode(&set); err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(set.Keys))
+	for _, j := range set.Keys {
+		key, err := parseJWK(j)
+		if err != nil {
+			continue
+		}
+		keys[j.Kid] = key
+	}
+
+	return keys, nil
+}
+
+func parseJWK(j jwk) (crypto.PublicKey, error) {
+	switch {
+	case j.Kty == "OKP" && j.Crv == "Ed25519":
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil {
+			return nil, err
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, errors.New("invalid ed25519 key size")
+		}
+		return ed25519.PublicKey(x), nil
+	case j.Kty == "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{
+			N: new(big.Int).SetBytes(n),
+			E: int(new(big.Int).SetBytes(e).Int64()),
+		}, nil
+	default:
+		return nil, errors.New("unsupported key type: " + j.Kty)
+	}
+}
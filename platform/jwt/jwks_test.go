@@ -0,0 +1,168 @@
+package jwtvalidator
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newJWKSServer(t *testing.T, set *jwks) (*httptest.Server, *atomic.Int32) {
+	var calls atomic.Int32
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		require.NoError(t, json.NewEncoder(w).Encode(set))
+	}))
+	t.Cleanup(serv.Close)
+	return serv, &calls
+}
+
+func TestKeySet_Key(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	rsaPriv, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	set := &jwks{Keys: []jwk{
+		{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edPub)},
+		{
+			Kty: "RSA",
+			Kid: "rsa",
+			N:   base64.RawURLEncoding.EncodeToString(rsaPriv.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(rsaPriv.E)).Bytes()),
+		},
+		{Kty: "EC", Kid: "ec"},
+	}}
+	serv, calls := newJWKSServer(t, set)
+
+	now := time.Now()
+	keys := NewKeySet(serv.URL, time.Minute, time.Second)
+	keys.now = func() time.Time { return now }
+
+	key, err := keys.Key(context.Background(), "ed")
+	require.NoError(t, err)
+	require.Equal(t, edPub, key)
+
+	key, err = keys.Key(context.Background(), "rsa")
+	require.NoError(t, err)
+	require.Equal(t, &rsaPriv.PublicKey, key)
+	require.Equal(t, int32(1), calls.Load())
+
+	_, err = keys.Key(context.Background(), "ec")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	require.Equal(t, int32(1), calls.Load(), "unknown kid refetch must be throttled")
+
+	now = now.Add(minRefreshInterval)
+	_, err = keys.Key(context.Background(), "unknown")
+	require.ErrorIs(t, err, ErrKeyNotFound)
+	require.Equal(t, int32(2), calls.Load())
+
+	now = now.Add(time.Minute)
+	_, err = keys.Key(context.Background(), "ed")
+	require.NoError(t, err)
+	require.Equal(t, int32(3), calls.Load(), "stale keys must be refetched")
+}
+
+func TestKeySet_Key_FetchError(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var fail atomic.Bool
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if fail.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(&jwks{Keys: []jwk{
+			{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edPub)},
+		}}))
+	}))
+	defer serv.Close()
+
+	now := time.Now()
+	keys := NewKeySet(serv.URL, time.Minute, time.Second)
+	keys.now = func() time.Time { return now }
+
+	_, err = keys.Key(context.Background(), "ed")
+	require.NoError(t, err)
+
+	fail.Store(true)
+	now = now.Add(2 * time.Minute)
+
+	key, err := keys.Key(context.Background(), "ed")
+	require.NoError(t, err, "cached key must be served while jwks is unavailable")
+	require.Equal(t, edPub, key)
+
+	now = now.Add(minRefreshInterval)
+	_, err = keys.Key(context.Background(), "other")
+	require.Error(t, err)
+	require.NotErrorIs(t, err, ErrKeyNotFound)
+}
+
+func TestKeySet_Key_ConcurrentRefresh(t *testing.T) {
+	edPub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	var calls atomic.Int32
+	var block atomic.Bool
+	release := make(chan struct{})
+	serv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if block.Load() {
+			<-release
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(&jwks{Keys: []jwk{
+			{Kty: "OKP", Kid: "ed", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(edPub)},
+		}}))
+	}))
+	defer serv.Close()
+
+	now := time.Now()
+	keys := NewKeySet(serv.URL, time.Minute, 5*time.Second)
+	keys.now = func() time.Time { return now }
+
+	_, err = keys.Key(context.Background(), "ed")
+	require.NoError(t, err)
+
+	block.Store(true)
+	now = now.Add(minRefreshInterval)
+
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := keys.Key(context.Background(), "new")
+			require.ErrorIs(t, err, ErrKeyNotFound)
+		}()
+	}
+	require.Eventually(t, func() bool { return calls.Load() == 2 }, time.Second, time.Millisecond)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		key, err := keys.Key(context.Background(), "ed")
+		require.NoError(t, err)
+		require.Equal(t, edPub, key)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("known kid lookup blocked by jwks refresh")
+	}
+
+	close(release)
+	wg.Wait()
+	require.Equal(t, int32(2), calls.Load(), "concurrent misses must share one fetch")
+}
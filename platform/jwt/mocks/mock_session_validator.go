@@ -1,13 +1,13 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: ./../../../repository/sessionvalidator/session_validator.go
+// Source: ./../sessionvalidator/session_validator.go
 //
 // Generated by this command:
 //
-//	mockgen -source=./../../../repository/sessionvalidator/session_validator.go -destination=./mocks/mock_session_validator.go -package=middlewaremocks
+//	mockgen -source=./../sessionvalidator/session_validator.go -destination=./mocks/mock_session_validator.go -package=jwtmocks
 //
 
-// Package middlewaremocks is a generated GoMock package.
-package middlewaremocks
+// Package jwtmocks is a generated GoMock package.
+package jwtmocks
 
 import (
 	context "context"
@@ -54,3 +54,18 @@ func (mr *MockSessionValidatorMockRecorder) GetIdBySession(ctx, sessionId any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdBySession", reflect.TypeOf((*MockSessionValidator)(nil).GetIdBySession), ctx, sessionId)
 }
+
+// GetIdByToken mocks base method.
+func (m *MockSessionValidator) GetIdByToken(ctx context.Context, token string) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIdByToken", ctx, token)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIdByToken indicates an expected call of GetIdByToken.
+func (mr *MockSessionValidatorMockRecorder) GetIdByToken(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIdByToken", reflect.TypeOf((*MockSessionValidator)(nil).GetIdByToken), ctx, token)
+}
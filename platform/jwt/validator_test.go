@@ -0,0 +1,198 @@
+package jwtvalidator
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"log/slog"
+	jwtmocks "platform/jwt/mocks"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func signToken(t *testing.T, key ed25519.PrivateKey, kid, typ string, claims jwt.RegisteredClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header["typ"] = typ
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+//go:generate mockgen -source=./../sessionvalidator/session_validator.go -destination=./mocks/mock_session_validator.go -package=jwtmocks
+func TestValidator_GetIdByToken(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	_, otherPriv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	serv, _ := newJWKSServer(t, &jwks{Keys: []jwk{
+		{Kty: "OKP", Kid: "kid", Crv: "Ed25519", X: base64.RawURLEncoding.EncodeToString(pub)},
+	}})
+
+	now := time.Now()
+	valid := jwt.RegisteredClaims{
+		Issuer:    "userservice",
+		Subject:   "7",
+		Audience:  jwt.ClaimStrings{"projectservice", "taskservice"},
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(now.Add(5 * time.Minute)),
+	}
+	expired := valid
+	expired.ExpiresAt = jwt.NewNumericDate(now.Add(-time.Hour))
+	wrongIssuer := valid
+	wrongIssuer.Issuer = "attacker"
+	badSubject := valid
+	badSubject.Subject = "admin"
+	wrongAudience := valid
+	wrongAudience.Audience = jwt.ClaimStrings{"taskservice"}
+	noAudience := valid
+	noAudience.Audience = nil
+
+	tests := []struct {
+		testName string
+		token    string
+
+		expNext bool
+		nextOut uint32
+		nextErr error
+
+		expOut  uint32
+		expCode codes.Code
+	}{
+		{
+			testName: "Valid token",
+			token:    signToken(t, priv, "kid", accessTokenType, valid),
+
+			expOut:  7,
+			expCode: codes.OK,
+		}, {
+			testName: "Personal access token",
+			token:    "pat_0123456789abcdef0123456789abcdef",
+
+			expNext: true,
+			nextOut: 3,
+			nextErr: nil,
+
+			expOut:  3,
+			expCode: codes.OK,
+		}, {
+			testName: "Expired token",
+			token:    signToken(t, priv, "kid", accessTokenType, expired),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Wrong issuer",
+			token:    signToken(t, priv, "kid", accessTokenType, wrongIssuer),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Wrong audience",
+			token:    signToken(t, priv, "kid", accessTokenType, wrongAudience),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Missing audience",
+			token:    signToken(t, priv, "kid", accessTokenType, noAudience),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Not an access token",
+			token:    signToken(t, priv, "kid", "JWT", valid),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Wrong signature",
+			token:    signToken(t, otherPriv, "kid", accessTokenType, valid),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Unknown kid",
+			token:    signToken(t, priv, "other", accessTokenType, valid),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Missing kid",
+			token:    signToken(t, priv, "", accessTokenType, valid),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Invalid subject",
+			token:    signToken(t, priv, "kid", accessTokenType, badSubject),
+
+			expCode: codes.Unauthenticated,
+		}, {
+			testName: "Unsigned token",
+			token:    "eyJhbGciOiJub25lIn0.eyJzdWIiOiI3In0.",
+
+			expCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			nextMock := jwtmocks.NewMockSessionValidator(ctrl)
+			if tt.expNext {
+				nextMock.EXPECT().GetIdByToken(gomock.Any(), tt.token).
+					Return(tt.nextOut, tt.nextErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			validator := NewValidator(log, nextMock, NewKeySet(serv.URL, time.Minute, time.Second), "userservice", "projectservice")
+
+			userId, err := validator.GetIdByToken(context.Background(), tt.token)
+			require.Equal(t, tt.expCode, status.Code(err))
+			require.Equal(t, tt.expOut, userId)
+		})
+	}
+}
+
+func TestValidator_GetIdByToken_KeysUnavailable(t *testing.T) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	validator := NewValidator(log, jwtmocks.NewMockSessionValidator(ctrl), NewKeySet("http://127.0.0.1:0", time.Minute, time.Second), "userservice", "projectservice")
+
+	token := signToken(t, priv, "kid", accessTokenType, jwt.RegisteredClaims{
+		Issuer:    "userservice",
+		Subject:   "7",
+		Audience:  jwt.ClaimStrings{"projectservice", "taskservice"},
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	})
+
+	_, err = validator.GetIdByToken(context.Background(), token)
+	require.Equal(t, codes.Unavailable, status.Code(err))
+}
+
+func TestValidator_GetIdBySession(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	nextMock := jwtmocks.NewMockSessionValidator(ctrl)
+	nextMock.EXPECT().GetIdBySession(gomock.Any(), "sessionId").
+		Return(uint32(1), nil)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	validator := NewValidator(log, nextMock, NewKeySet("http://127.0.0.1:0", time.Minute, time.Second), "userservice", "projectservice")
+
+	userId, err := validator.GetIdBySession(context.Background(), "sessionId")
+	require.NoError(t, err)
+	require.Equal(t, uint32(1), userId)
+}
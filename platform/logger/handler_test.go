@@ -0,0 +1,46 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"platform/requestid"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestContextHandler(t *testing.T) {
+	var buf bytes.Buffer
+	log := slog.New(NewContextHandler(slog.NewJSONHandler(&buf, nil))).With(slog.String("op", "test"))
+
+	log.InfoContext(requestid.NewContext(context.Background(), "req-1"), "with id")
+	log.InfoContext(context.Background(), "without id")
+
+	dec := json.NewDecoder(&buf)
+
+	var rec map[string]any
+	require.NoError(t, dec.Decode(&rec))
+	require.Equal(t, "with id", rec["msg"])
+	require.Equal(t, "test", rec["op"])
+	require.Equal(t, "req-1", rec["request_id"])
+
+	rec = nil
+	require.NoError(t, dec.Decode(&rec))
+	require.Equal(t, "without id", rec["msg"])
+	require.NotContains(t, rec, "request_id")
+}
+
+func TestSetupLogger(t *testing.T) {
+	for _, level := range []string{LvlDebug, LvlInfo, LvlWarn, LvlError} {
+		for _, format := range []string{FormatText, FormatJSON} {
+			require.NotNil(t, SetupLogger(level, format))
+		}
+	}
+
+	require.True(t, SetupLogger(LvlWarn, FormatJSON).Enabled(context.Background(), slog.LevelWarn))
+	require.False(t, SetupLogger(LvlWarn, FormatJSON).Enabled(context.Background(), slog.LevelInfo))
+	require.Panics(t, func() { SetupLogger("trace", FormatText) })
+	require.Panics(t, func() { SetupLogger(LvlInfo, "xml") })
+}
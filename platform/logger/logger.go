@@ -0,0 +1,48 @@
+package logger
+
+import (
+	"log/slog"
+	"os"
+)
+
+var (
+	LvlDebug = "debug"
+	LvlInfo  = "info"
+	LvlWarn  = "warn"
+	LvlError = "error"
+)
+
+var (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+func SetupLogger(level string, format string) *slog.Logger {
+	var lvl slog.Level
+	switch level {
+	case LvlDebug:
+		lvl = slog.LevelDebug
+	case LvlInfo:
+		lvl = slog.LevelInfo
+	case LvlWarn:
+		lvl = slog.LevelWarn
+	case LvlError:
+		lvl = slog.LevelError
+	default:
+		panic("unknown logger level: " + level)
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl}
+
+	var handler slog.Handler
+	switch format {
+	case FormatText, "":
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	case FormatJSON:
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	default:
+		panic("unknown logger format: " + format)
+	}
+
+	return slog.New(NewContextHandler(handler))
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unmatchedRoute keeps 404 scans from blowing up the route label cardinality.
+const unmatchedRoute = "unmatched"
+
+func HTTPMiddleware(reg prometheus.Registerer) gin.HandlerFunc {
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "http_requests_total",
+		Help: "HTTP requests by method, route and status code.",
+	}, []string{"method", "route", "code"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "http_request_duration_seconds",
+		Help:    "Latency of HTTP requests by method, route and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "code"})
+	reg.MustRegister(requests, duration)
+
+	return func(ctx *gin.Context) {
+		start := time.Now()
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		code := strconv.Itoa(ctx.Writer.Status())
+
+		requests.WithLabelValues(ctx.Request.Method, route, code).Inc()
+		duration.WithLabelValues(ctx.Request.Method, route, code).Observe(time.Since(start).Seconds())
+	}
+}
@@ -0,0 +1,90 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestHTTPMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	reg := prometheus.NewRegistry()
+
+	router := gin.New()
+	router.Use(HTTPMiddleware(reg))
+	router.GET("/project/:id", func(ctx *gin.Context) {
+		ctx.Status(http.StatusOK)
+	})
+
+	for _, path := range []string{"/project/1", "/project/2", "/unknown"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	expected := `
+# HELP http_requests_total HTTP requests by method, route and status code.
+# TYPE http_requests_total counter
+http_requests_total{code="200",method="GET",route="/project/:id"} 2
+http_requests_total{code="404",method="GET",route="unmatched"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "http_requests_total"))
+}
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	interceptor := UnaryServerInterceptor(reg)
+	info := &grpc.UnaryServerInfo{FullMethod: "/userservice/GetUser"}
+
+	for _, err := range []error{nil, status.Error(codes.NotFound, "not found")} {
+		handler := func(ctx context.Context, req any) (any, error) {
+			return nil, err
+		}
+		_, gotErr := interceptor(context.Background(), nil, info, handler)
+		require.Equal(t, err, gotErr)
+	}
+
+	expected := `
+# HELP grpc_server_requests_total Handled gRPC calls by method and status code.
+# TYPE grpc_server_requests_total counter
+grpc_server_requests_total{code="NotFound",method="/userservice/GetUser"} 1
+grpc_server_requests_total{code="OK",method="/userservice/GetUser"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "grpc_server_requests_total"))
+}
+
+type stubPool struct {
+	stats *redis.PoolStats
+}
+
+func (s stubPool) PoolStats() *redis.PoolStats {
+	return s.stats
+}
+
+func TestRegisterRedisStats(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	RegisterRedisStats(reg, stubPool{stats: &redis.PoolStats{Hits: 5, Misses: 1, TotalConns: 3, IdleConns: 2}})
+
+	expected := `
+# HELP redis_pool_hits_total Times a free connection was found in the pool.
+# TYPE redis_pool_hits_total counter
+redis_pool_hits_total 5
+# HELP redis_pool_idle_connections Number of idle connections in the pool.
+# TYPE redis_pool_idle_connections gauge
+redis_pool_idle_connections 2
+# HELP redis_pool_total_connections Number of connections in the pool.
+# TYPE redis_pool_total_connections gauge
+redis_pool_total_connections 3
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected),
+		"redis_pool_hits_total", "redis_pool_idle_connections", "redis_pool_total_connections"))
+}
@@ -0,0 +1,60 @@
+package metrics
+
+import (
+	"database/sql"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/redis/go-redis/v9"
+)
+
+func RegisterDBStats(reg prometheus.Registerer, dbName string, db *sql.DB) {
+	reg.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+type PoolStatser interface {
+	PoolStats() *redis.PoolStats
+}
+
+func RegisterRedisStats(reg prometheus.Registerer, client PoolStatser) {
+	reg.MustRegister(&redisCollector{
+		client:     client,
+		hits:       prometheus.NewDesc("redis_pool_hits_total", "Times a free connection was found in the pool.", nil, nil),
+		misses:     prometheus.NewDesc("redis_pool_misses_total", "Times a free connection was not found in the pool.", nil, nil),
+		timeouts:   prometheus.NewDesc("redis_pool_timeouts_total", "Times a wait for a pool connection timed out.", nil, nil),
+		totalConns: prometheus.NewDesc("redis_pool_total_connections", "Number of connections in the pool.", nil, nil),
+		idleConns:  prometheus.NewDesc("redis_pool_idle_connections", "Number of idle connections in the pool.", nil, nil),
+		staleConns: prometheus.NewDesc("redis_pool_stale_connections_total", "Stale connections removed from the pool.", nil, nil),
+	})
+}
+
+type redisCollector struct {
+	client PoolStatser
+
+	hits       *prometheus.Desc
+	misses     *prometheus.Desc
+	timeouts   *prometheus.Desc
+	totalConns *prometheus.Desc
+	idleConns  *prometheus.Desc
+	staleConns *prometheus.Desc
+}
+
+func (c *redisCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.hits
+	ch <- c.misses
+	ch <- c.timeouts
+	ch <- c.totalConns
+	ch <- c.idleConns
+	ch <- c.staleConns
+}
+
+func (c *redisCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.client.PoolStats()
+
+	ch <- prometheus.MustNewConstMetric(c.hits, prometheus.CounterValue, float64(stats.Hits))
+	ch <- prometheus.MustNewConstMetric(c.misses, prometheus.CounterValue, float64(stats.Misses))
+	ch <- prometheus.MustNewConstMetric(c.timeouts, prometheus.CounterValue, float64(stats.Timeouts))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stats.TotalConns))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stats.IdleConns))
+	ch <- prometheus.MustNewConstMetric(c.staleConns, prometheus.CounterValue, float64(stats.StaleConns))
+}
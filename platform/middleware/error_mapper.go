@@ -16,6 +16,10 @@ func grpcErrorToHttp(err error) (int, string) {
 	switch s.Code() {
 	case codes.NotFound:
 		return http.StatusNotFound, "user not found"
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized, "invalid or expired token"
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable, "user service unavailable"
 	case codes.Internal:
 		return http.StatusBadGateway, "upstream error"
 	default:
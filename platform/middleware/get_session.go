@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+const bearerScheme = "Bearer "
+
+func GetSessionMiddleware(log *slog.Logger) gin.HandlerFunc {
+	const op = "middleware.GetSessionMiddleware"
+	return func(ctx *gin.Context) {
+		if header := ctx.GetHeader("Authorization"); header != "" {
+			token, ok := strings.CutPrefix(header, bearerScheme)
+			if !ok || strings.TrimSpace(token) == "" {
+				log.InfoContext(ctx, "a request arrived with a malformed authorization header", slog.String("op", op))
+				ctx.JSON(http.StatusUnauthorized, gin.H{
+					"error": "invalid authorization header",
+				})
+				ctx.Abort()
+				return
+			}
+			ctx.Set("apiToken", strings.TrimSpace(token))
+			ctx.Next()
+			return
+		}
+
+		sessionId, err := ctx.Cookie("sessionId")
+		if err != nil {
+			log.InfoContext(ctx, "a request arrived without a sessionId or api token", slog.String("op", op))
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": "needed cookie with sessionId or bearer token",
+			})
+			ctx.Abort()
+			return
+		}
+		ctx.Set("sessionId", sessionId)
+		ctx.Next()
+	}
+}
@@ -67,9 +67,71 @@ func TestGetSessionMiddleware_WithoutCookie(t *testing.T) {
 		Err string `json:"error"`
 	}
 
-	expBody := "needed cookie with sessionId"
+	expBody := "needed cookie with sessionId or bearer token"
 
 	require.NoError(t, json.NewDecoder(resp.Body).Decode(&respBody))
 	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
 	require.Equal(t, expBody, respBody.Err)
 }
+
+func TestGetSessionMiddleware_BearerToken(t *testing.T) {
+	tests := []struct {
+		testName string
+		header   string
+
+		expCode  int
+		expToken string
+	}{
+		{
+			testName: "Success",
+			header:   "Bearer pat_token",
+
+			expCode:  http.StatusOK,
+			expToken: "pat_token",
+		}, {
+			testName: "Wrong scheme",
+			header:   "Basic dXNlcjpwYXNz",
+
+			expCode: http.StatusUnauthorized,
+		}, {
+			testName: "Empty token",
+			header:   "Bearer  ",
+
+			expCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(GetSessionMiddleware(log))
+
+			router.GET("/test", func(ctx *gin.Context) {
+				token, ok := ctx.Get("apiToken")
+				require.True(t, ok)
+				require.Equal(t, tt.expToken, token)
+				_, ok = ctx.Get("sessionId")
+				require.False(t, ok)
+				ctx.JSON(http.StatusOK, "ok")
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/test", nil)
+			require.NoError(t, err)
+
+			req.Header.Set("Authorization", tt.header)
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: "123",
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expCode, w.Result().StatusCode)
+		})
+	}
+}
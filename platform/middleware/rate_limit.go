@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"platform/ratelimit"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+const defaultRateLimitRoute = "default"
+
+type RateLimitRules struct {
+	Default ratelimit.Limit
+	Routes  map[string]ratelimit.Limit
+}
+
+func RateLimitRoute(method, path string) string {
+	return method + " " + path
+}
+
+// IPRateLimitMiddleware limits every request by client ip alone. It goes in
+// front of the auth middlewares so unauthenticated floods never reach them.
+func IPRateLimitMiddleware(log *slog.Logger, limiter ratelimit.Limiter, limit ratelimit.Limit) gin.HandlerFunc {
+	const op = "middleware.IPRateLimitMiddleware"
+	return func(ctx *gin.Context) {
+		client := "ip:" + ctx.ClientIP()
+		if !allow(ctx, log.With(slog.String("op", op)), limiter, client, limit) {
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// RateLimitMiddleware limits requests per route, keyed by user once
+// authenticated and by client ip otherwise.
+func RateLimitMiddleware(log *slog.Logger, limiter ratelimit.Limiter, rules *RateLimitRules) gin.HandlerFunc {
+	const op = "middleware.RateLimitMiddleware"
+	return func(ctx *gin.Context) {
+		route := RateLimitRoute(ctx.Request.Method, ctx.FullPath())
+		limit, ok := rules.Routes[route]
+		if !ok {
+			route = defaultRateLimitRoute
+			limit = rules.Default
+		}
+
+		client := "ip:" + ctx.ClientIP()
+		if userId, exists := ctx.Get("userId"); exists {
+			client = fmt.Sprintf("user:%v", userId)
+		}
+
+		if !allow(ctx, log.With(slog.String("op", op), slog.String("route", route)), limiter, route+":"+client, limit) {
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+// allow sets the rate limit headers and aborts with 429 when the key is over
+// its limit. A failing limiter lets the request through.
+func allow(ctx *gin.Context, log *slog.Logger, limiter ratelimit.Limiter, key string, limit ratelimit.Limit) bool {
+	res, err := limiter.Allow(ctx.Request.Context(), key, limit)
+	if err != nil {
+		log.WarnContext(ctx, "rate limiter unavailable", slog.String("error", err.Error()))
+		return true
+	}
+
+	ctx.Header("X-RateLimit-Limit", strconv.FormatInt(res.Limit, 10))
+	ctx.Header("X-RateLimit-Remaining", strconv.FormatInt(res.Remaining, 10))
+	ctx.Header("X-RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+
+	if !res.Allowed {
+		log.InfoContext(ctx, "rate limit exceeded", slog.String("key", key))
+		ctx.Header("Retry-After", strconv.Itoa(ceilSeconds(res.RetryAfter)))
+		ctx.JSON(http.StatusTooManyRequests, gin.H{
+			"error": "too many requests",
+		})
+		ctx.Abort()
+		return false
+	}
+
+	return true
+}
+
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
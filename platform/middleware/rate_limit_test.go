@@ -0,0 +1,264 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	middlewaremocks "platform/middleware/mocks"
+	"platform/ratelimit"
+	"platform/server/rest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../ratelimit/ratelimit.go -destination=./mocks/mock_rate_limiter.go -package=middlewaremocks
+
+func TestRateLimitMiddleware(t *testing.T) {
+	defaultLimit := ratelimit.Limit{Requests: 10, Period: time.Second, Burst: 20}
+	createLimit := ratelimit.Limit{Requests: 1, Period: time.Minute, Burst: 5}
+	rules := &RateLimitRules{
+		Default: defaultLimit,
+		Routes: map[string]ratelimit.Limit{
+			RateLimitRoute(http.MethodPost, "/task/create"): createLimit,
+		},
+	}
+
+	tests := []struct {
+		testName string
+
+		method string
+		path   string
+		userId uint32
+
+		limKey       string
+		limLimit     ratelimit.Limit
+		limReturn    *ratelimit.Result
+		limReturnErr error
+
+		expCode       int
+		expBody       string
+		expLimit      string
+		expRemaining  string
+		expReset      string
+		expRetryAfter string
+	}{
+		{
+			testName: "Success by user",
+
+			method: http.MethodDelete,
+			path:   "/task/delete",
+			userId: 1,
+
+			limKey:       "default:user:1",
+			limLimit:     defaultLimit,
+			limReturn:    &ratelimit.Result{Allowed: true, Limit: 20, Remaining: 19, ResetAfter: 100 * time.Millisecond},
+			limReturnErr: nil,
+
+			expCode:      http.StatusOK,
+			expBody:      `"ok"`,
+			expLimit:     "20",
+			expRemaining: "19",
+			expReset:     "1",
+		}, {
+			testName: "Route limit by ip",
+
+			method: http.MethodPost,
+			path:   "/task/create",
+
+			limKey:       "POST /task/create:ip:192.0.2.1",
+			limLimit:     createLimit,
+			limReturn:    &ratelimit.Result{Allowed: true, Limit: 5, Remaining: 4, ResetAfter: time.Minute},
+			limReturnErr: nil,
+
+			expCode:      http.StatusOK,
+			expBody:      `"ok"`,
+			expLimit:     "5",
+			expRemaining: "4",
+			expReset:     "60",
+		}, {
+			testName: "Too many requests",
+
+			method: http.MethodPost,
+			path:   "/task/create",
+			userId: 1,
+
+			limKey:       "POST /task/create:user:1",
+			limLimit:     createLimit,
+			limReturn:    &ratelimit.Result{Allowed: false, Limit: 5, Remaining: 0, RetryAfter: 1500 * time.Millisecond, ResetAfter: 5 * time.Minute},
+			limReturnErr: nil,
+
+			expCode:       http.StatusTooManyRequests,
+			expBody:       `{"error":"too many requests"}`,
+			expLimit:      "5",
+			expRemaining:  "0",
+			expReset:      "300",
+			expRetryAfter: "2",
+		}, {
+			testName: "Limiter unavailable",
+
+			method: http.MethodDelete,
+			path:   "/task/delete",
+			userId: 1,
+
+			limKey:       "default:user:1",
+			limLimit:     defaultLimit,
+			limReturn:    nil,
+			limReturnErr: errors.New("connection refused"),
+
+			expCode: http.StatusOK,
+			expBody: `"ok"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limMock := middlewaremocks.NewMockLimiter(ctrl)
+			limMock.EXPECT().Allow(gomock.Any(), tt.limKey, tt.limLimit).
+				Return(tt.limReturn, tt.limReturnErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(func(ctx *gin.Context) {
+				if tt.userId != 0 {
+					ctx.Set("userId", tt.userId)
+				}
+			})
+			router.Use(RateLimitMiddleware(log, limMock, rules))
+			router.Handle(tt.method, tt.path, func(ctx *gin.Context) {
+				ctx.JSON(http.StatusOK, "ok")
+			})
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expCode, w.Code)
+			require.Equal(t, tt.expBody, w.Body.String())
+			require.Equal(t, tt.expLimit, w.Header().Get("X-RateLimit-Limit"))
+			require.Equal(t, tt.expRemaining, w.Header().Get("X-RateLimit-Remaining"))
+			require.Equal(t, tt.expReset, w.Header().Get("X-RateLimit-Reset"))
+			require.Equal(t, tt.expRetryAfter, w.Header().Get("Retry-After"))
+		})
+	}
+}
+
+func TestIPRateLimitMiddleware(t *testing.T) {
+	limit := ratelimit.Limit{Requests: 10, Period: time.Second, Burst: 20}
+
+	tests := []struct {
+		testName string
+
+		limReturn    *ratelimit.Result
+		limReturnErr error
+
+		expCode int
+		expBody string
+	}{
+		{
+			testName: "Success",
+
+			limReturn:    &ratelimit.Result{Allowed: true, Limit: 20, Remaining: 19},
+			limReturnErr: nil,
+
+			expCode: http.StatusOK,
+			expBody: `"ok"`,
+		}, {
+			testName: "Too many requests",
+
+			limReturn:    &ratelimit.Result{Allowed: false, Limit: 20, Remaining: 0, RetryAfter: 100 * time.Millisecond},
+			limReturnErr: nil,
+
+			expCode: http.StatusTooManyRequests,
+			expBody: `{"error":"too many requests"}`,
+		}, {
+			testName: "Limiter unavailable",
+
+			limReturn:    nil,
+			limReturnErr: errors.New("connection refused"),
+
+			expCode: http.StatusOK,
+			expBody: `"ok"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			limMock := middlewaremocks.NewMockLimiter(ctrl)
+			limMock.EXPECT().Allow(gomock.Any(), "ip:192.0.2.1", limit).
+				Return(tt.limReturn, tt.limReturnErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(IPRateLimitMiddleware(log, limMock, limit))
+			// stands in for the auth middlewares, which must not run for limited requests
+			router.Use(func(ctx *gin.Context) {
+				ctx.Set("userId", uint32(1))
+			})
+			router.GET("/project/getall", func(ctx *gin.Context) {
+				ctx.JSON(http.StatusOK, "ok")
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/project/getall", nil)
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.expCode, w.Code)
+			require.Equal(t, tt.expBody, w.Body.String())
+		})
+	}
+}
+
+func TestRateLimitMiddleware_SpoofedForwardedFor(t *testing.T) {
+	loginLimit := ratelimit.Limit{Requests: 1, Period: time.Minute, Burst: 2}
+	rules := &RateLimitRules{
+		Default: ratelimit.Limit{Requests: 10, Period: time.Second, Burst: 20},
+		Routes: map[string]ratelimit.Limit{
+			RateLimitRoute(http.MethodPost, "/user/login"): loginLimit,
+		},
+	}
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	limiter := ratelimit.NewMemoryLimiter()
+
+	gin.SetMode(gin.TestMode)
+	router := rest.MustNewRouter(nil)
+	router.Use(IPRateLimitMiddleware(log, limiter, rules.Default))
+	router.Use(RateLimitMiddleware(log, limiter, rules))
+	router.POST("/user/login", func(ctx *gin.Context) {
+		ctx.JSON(http.StatusOK, "ok")
+	})
+
+	// every request claims another client, the limiter must still see one ip
+	expCodes := []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}
+	for i, expCode := range expCodes {
+		spoofed := fmt.Sprintf("203.0.113.%d", i+1)
+
+		req := httptest.NewRequest(http.MethodPost, "/user/login", nil)
+		req.Header.Set("X-Forwarded-For", spoofed)
+		req.Header.Set("X-Real-IP", spoofed)
+		w := httptest.NewRecorder()
+
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, expCode, w.Code)
+	}
+}
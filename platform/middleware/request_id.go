@@ -0,0 +1,18 @@
+package middleware
+
+import (
+	"platform/requestid"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDMiddleware stores the id on the request context, routers set
+// ContextWithFallback so handlers logging with *gin.Context pick it up too.
+func RequestIDMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		id := requestid.Ensure(ctx.GetHeader(requestid.Header))
+		ctx.Request = ctx.Request.WithContext(requestid.NewContext(ctx.Request.Context(), id))
+		ctx.Header(requestid.Header, id)
+		ctx.Next()
+	}
+}
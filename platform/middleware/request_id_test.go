@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"platform/requestid"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		header string
+
+		expGenerated bool
+	}{
+		{
+			testName: "Header kept",
+
+			header: "req-1",
+
+			expGenerated: false,
+		}, {
+			testName: "Header missing",
+
+			header: "",
+
+			expGenerated: true,
+		}, {
+			testName: "Header malformed",
+
+			header: "req 1",
+
+			expGenerated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.ContextWithFallback = true
+			router.Use(RequestIDMiddleware())
+
+			var got string
+			router.GET("/test", func(ctx *gin.Context) {
+				got, _ = requestid.FromContext(ctx)
+				ctx.JSON(http.StatusOK, "ok")
+			})
+
+			req, err := http.NewRequest(http.MethodGet, "/test", nil)
+			require.NoError(t, err)
+			if tt.header != "" {
+				req.Header.Set(requestid.Header, tt.header)
+			}
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, http.StatusOK, w.Code)
+			require.Equal(t, got, w.Header().Get(requestid.Header))
+			if tt.expGenerated {
+				require.Len(t, got, 32)
+				return
+			}
+			require.Equal(t, tt.header, got)
+		})
+	}
+}
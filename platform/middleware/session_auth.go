@@ -5,7 +5,7 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
-	"taskservice/internal/repository/sessionvalidator"
+	"platform/sessionvalidator"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -14,19 +14,22 @@ import (
 func SessionAuthMiddleware(log *slog.Logger, sessionValid sessionvalidator.SessionValidator, respTimeout time.Duration) gin.HandlerFunc {
 	const op = "middleware.SessionAuthMiddleware"
 	return func(ctx *gin.Context) {
-		sessionId, ok := ctx.Get("sessionId")
-		if !ok {
+		tctx, cancel := context.WithTimeout(ctx.Request.Context(), respTimeout)
+		defer cancel()
+
+		var userId uint32
+		var err error
+		if token, ok := ctx.Get("apiToken"); ok {
+			userId, err = sessionValid.GetIdByToken(tctx, token.(string))
+		} else if sessionId, ok := ctx.Get("sessionId"); ok {
+			userId, err = sessionValid.GetIdBySession(tctx, sessionId.(string))
+		} else {
 			ctx.JSON(http.StatusInternalServerError, "internal server error")
 			ctx.Abort()
 			return
 		}
-
-		tctx, cancel := context.WithTimeout(ctx.Request.Context(), respTimeout)
-		defer cancel()
-
-		userId, err := sessionValid.GetIdBySession(tctx, sessionId.(string))
 		if err != nil {
-			log.Info("failed to get userID", slog.String("op", op))
+			log.InfoContext(ctx, "failed to get userID", slog.String("op", op))
 			if errors.Is(err, context.DeadlineExceeded) || errors.Is(tctx.Err(), context.DeadlineExceeded) {
 				ctx.JSON(http.StatusGatewayTimeout, gin.H{
 					"error": "user service timeout",
@@ -0,0 +1,465 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.21.12
+// source: proto/projectservice/project.proto
+
+package projectservicev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Project struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OwnerId       uint32                 `protobuf:"varint,2,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Project) Reset() {
+	*x = Project{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Project) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Project) ProtoMessage() {}
+
+func (x *Project) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Project.ProtoReflect.Descriptor instead.
+func (*Project) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Project) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Project) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+func (x *Project) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Project) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetProjectRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProjectId     uint32                 `protobuf:"varint,1,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectRequest) Reset() {
+	*x = GetProjectRequest{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectRequest) ProtoMessage() {}
+
+func (x *GetProjectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectRequest.ProtoReflect.Descriptor instead.
+func (*GetProjectRequest) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetProjectRequest) GetProjectId() uint32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type GetProjectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Project       *Project               `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProjectResponse) Reset() {
+	*x = GetProjectResponse{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProjectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProjectResponse) ProtoMessage() {}
+
+func (x *GetProjectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProjectResponse.ProtoReflect.Descriptor instead.
+func (*GetProjectResponse) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetProjectResponse) GetProject() *Project {
+	if x != nil {
+		return x.Project
+	}
+	return nil
+}
+
+type ListProjectsByOwnerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OwnerId       uint32                 `protobuf:"varint,1,opt,name=ownerId,proto3" json:"ownerId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsByOwnerRequest) Reset() {
+	*x = ListProjectsByOwnerRequest{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsByOwnerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsByOwnerRequest) ProtoMessage() {}
+
+func (x *ListProjectsByOwnerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsByOwnerRequest.ProtoReflect.Descriptor instead.
+func (*ListProjectsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ListProjectsByOwnerRequest) GetOwnerId() uint32 {
+	if x != nil {
+		return x.OwnerId
+	}
+	return 0
+}
+
+type ListProjectsByOwnerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Projects      []*Project             `protobuf:"bytes,1,rep,name=projects,proto3" json:"projects,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProjectsByOwnerResponse) Reset() {
+	*x = ListProjectsByOwnerResponse{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProjectsByOwnerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProjectsByOwnerResponse) ProtoMessage() {}
+
+func (x *ListProjectsByOwnerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProjectsByOwnerResponse.ProtoReflect.Descriptor instead.
+func (*ListProjectsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ListProjectsByOwnerResponse) GetProjects() []*Project {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+type CheckAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	ProjectId     uint32                 `protobuf:"varint,2,opt,name=projectId,proto3" json:"projectId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAccessRequest) Reset() {
+	*x = CheckAccessRequest{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessRequest) ProtoMessage() {}
+
+func (x *CheckAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessRequest.ProtoReflect.Descriptor instead.
+func (*CheckAccessRequest) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CheckAccessRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckAccessRequest) GetProjectId() uint32 {
+	if x != nil {
+		return x.ProjectId
+	}
+	return 0
+}
+
+type CheckAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	HasAccess     bool                   `protobuf:"varint,1,opt,name=hasAccess,proto3" json:"hasAccess,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckAccessResponse) Reset() {
+	*x = CheckAccessResponse{}
+	mi := &file_proto_projectservice_project_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckAccessResponse) ProtoMessage() {}
+
+func (x *CheckAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_projectservice_project_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckAccessResponse.ProtoReflect.Descriptor instead.
+func (*CheckAccessResponse) Descriptor() ([]byte, []int) {
+	return file_proto_projectservice_project_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *CheckAccessResponse) GetHasAccess() bool {
+	if x != nil {
+		return x.HasAccess
+	}
+	return false
+}
+
+func (x *CheckAccessResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+var File_proto_projectservice_project_proto protoreflect.FileDescriptor
+
+const file_proto_projectservice_project_proto_rawDesc = "" +
+	"\n" +
+	"\"proto/projectservice/project.proto\x12\x11projectservice.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x81\x01\n" +
+	"\aProject\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x18\n" +
+	"\aownerId\x18\x02 \x01(\rR\aownerId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x128\n" +
+	"\tcreatedAt\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"1\n" +
+	"\x11GetProjectRequest\x12\x1c\n" +
+	"\tprojectId\x18\x01 \x01(\rR\tprojectId\"J\n" +
+	"\x12GetProjectResponse\x124\n" +
+	"\aproject\x18\x01 \x01(\v2\x1a.projectservice.v1.ProjectR\aproject\"6\n" +
+	"\x1aListProjectsByOwnerRequest\x12\x18\n" +
+	"\aownerId\x18\x01 \x01(\rR\aownerId\"U\n" +
+	"\x1bListProjectsByOwnerResponse\x126\n" +
+	"\bprojects\x18\x01 \x03(\v2\x1a.projectservice.v1.ProjectR\bprojects\"J\n" +
+	"\x12CheckAccessRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\rR\x06userId\x12\x1c\n" +
+	"\tprojectId\x18\x02 \x01(\rR\tprojectId\"G\n" +
+	"\x13CheckAccessResponse\x12\x1c\n" +
+	"\thasAccess\x18\x01 \x01(\bR\thasAccess\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role2\xbf\x02\n" +
+	"\x0eProjectService\x12Y\n" +
+	"\n" +
+	"GetProject\x12$.projectservice.v1.GetProjectRequest\x1a%.projectservice.v1.GetProjectResponse\x12t\n" +
+	"\x13ListProjectsByOwner\x12-.projectservice.v1.ListProjectsByOwnerRequest\x1a..projectservice.v1.ListProjectsByOwnerResponse\x12\\\n" +
+	"\vCheckAccess\x12%.projectservice.v1.CheckAccessRequest\x1a&.projectservice.v1.CheckAccessResponseB\x15Z\x13./;projectservicev1b\x06proto3"
+
+var (
+	file_proto_projectservice_project_proto_rawDescOnce sync.Once
+	file_proto_projectservice_project_proto_rawDescData []byte
+)
+
+func file_proto_projectservice_project_proto_rawDescGZIP() []byte {
+	file_proto_projectservice_project_proto_rawDescOnce.Do(func() {
+		file_proto_projectservice_project_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_projectservice_project_proto_rawDesc), len(file_proto_projectservice_project_proto_rawDesc)))
+	})
+	return file_proto_projectservice_project_proto_rawDescData
+}
+
+var file_proto_projectservice_project_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_proto_projectservice_project_proto_goTypes = []any{
+	(*Project)(nil),                     // 0: projectservice.v1.Project
+	(*GetProjectRequest)(nil),           // 1: projectservice.v1.GetProjectRequest
+	(*GetProjectResponse)(nil),          // 2: projectservice.v1.GetProjectResponse
+	(*ListProjectsByOwnerRequest)(nil),  // 3: projectservice.v1.ListProjectsByOwnerRequest
+	(*ListProjectsByOwnerResponse)(nil), // 4: projectservice.v1.ListProjectsByOwnerResponse
+	(*CheckAccessRequest)(nil),          // 5: projectservice.v1.CheckAccessRequest
+	(*CheckAccessResponse)(nil),         // 6: projectservice.v1.CheckAccessResponse
+	(*timestamppb.Timestamp)(nil),       // 7: google.protobuf.Timestamp
+}
+var file_proto_projectservice_project_proto_depIdxs = []int32{
+	7, // 0: projectservice.v1.Project.createdAt:type_name -> google.protobuf.Timestamp
+	0, // 1: projectservice.v1.GetProjectResponse.project:type_name -> projectservice.v1.Project
+	0, // 2: projectservice.v1.ListProjectsByOwnerResponse.projects:type_name -> projectservice.v1.Project
+	1, // 3: projectservice.v1.ProjectService.GetProject:input_type -> projectservice.v1.GetProjectRequest
+	3, // 4: projectservice.v1.ProjectService.ListProjectsByOwner:input_type -> projectservice.v1.ListProjectsByOwnerRequest
+	5, // 5: projectservice.v1.ProjectService.CheckAccess:input_type -> projectservice.v1.CheckAccessRequest
+	2, // 6: projectservice.v1.ProjectService.GetProject:output_type -> projectservice.v1.GetProjectResponse
+	4, // 7: projectservice.v1.ProjectService.ListProjectsByOwner:output_type -> projectservice.v1.ListProjectsByOwnerResponse
+	6, // 8: projectservice.v1.ProjectService.CheckAccess:output_type -> projectservice.v1.CheckAccessResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_proto_projectservice_project_proto_init() }
+func file_proto_projectservice_project_proto_init() {
+	if File_proto_projectservice_project_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_projectservice_project_proto_rawDesc), len(file_proto_projectservice_project_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_projectservice_project_proto_goTypes,
+		DependencyIndexes: file_proto_projectservice_project_proto_depIdxs,
+		MessageInfos:      file_proto_projectservice_project_proto_msgTypes,
+	}.Build()
+	File_proto_projectservice_project_proto = out.File
+	file_proto_projectservice_project_proto_goTypes = nil
+	file_proto_projectservice_project_proto_depIdxs = nil
+}
@@ -0,0 +1,551 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v3.21.12
+// source: proto/userservice/user.proto
+
+package userservicev1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FirstName     string                 `protobuf:"bytes,2,opt,name=firstName,proto3" json:"firstName,omitempty"`
+	MiddleName    string                 `protobuf:"bytes,3,opt,name=middleName,proto3" json:"middleName,omitempty"`
+	LastName      string                 `protobuf:"bytes,4,opt,name=lastName,proto3" json:"lastName,omitempty"`
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_proto_userservice_user_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *User) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *User) GetFirstName() string {
+	if x != nil {
+		return x.FirstName
+	}
+	return ""
+}
+
+func (x *User) GetMiddleName() string {
+	if x != nil {
+		return x.MiddleName
+	}
+	return ""
+}
+
+func (x *User) GetLastName() string {
+	if x != nil {
+		return x.LastName
+	}
+	return ""
+}
+
+func (x *User) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type GetIdBySessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SessionId     string                 `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdBySessionRequest) Reset() {
+	*x = GetIdBySessionRequest{}
+	mi := &file_proto_userservice_user_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdBySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdBySessionRequest) ProtoMessage() {}
+
+func (x *GetIdBySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdBySessionRequest.ProtoReflect.Descriptor instead.
+func (*GetIdBySessionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetIdBySessionRequest) GetSessionId() string {
+	if x != nil {
+		return x.SessionId
+	}
+	return ""
+}
+
+type GetIdBySessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdBySessionResponse) Reset() {
+	*x = GetIdBySessionResponse{}
+	mi := &file_proto_userservice_user_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdBySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdBySessionResponse) ProtoMessage() {}
+
+func (x *GetIdBySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdBySessionResponse.ProtoReflect.Descriptor instead.
+func (*GetIdBySessionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetIdBySessionResponse) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetIdByTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdByTokenRequest) Reset() {
+	*x = GetIdByTokenRequest{}
+	mi := &file_proto_userservice_user_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdByTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdByTokenRequest) ProtoMessage() {}
+
+func (x *GetIdByTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdByTokenRequest.ProtoReflect.Descriptor instead.
+func (*GetIdByTokenRequest) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetIdByTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type GetIdByTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetIdByTokenResponse) Reset() {
+	*x = GetIdByTokenResponse{}
+	mi := &file_proto_userservice_user_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetIdByTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetIdByTokenResponse) ProtoMessage() {}
+
+func (x *GetIdByTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetIdByTokenResponse.ProtoReflect.Descriptor instead.
+func (*GetIdByTokenResponse) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetIdByTokenResponse) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_proto_userservice_user_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetUserRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_proto_userservice_user_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetUserResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type BatchGetUsersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint32               `protobuf:"varint,1,rep,packed,name=userIds,proto3" json:"userIds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUsersRequest) Reset() {
+	*x = BatchGetUsersRequest{}
+	mi := &file_proto_userservice_user_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersRequest) ProtoMessage() {}
+
+func (x *BatchGetUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchGetUsersRequest) GetUserIds() []uint32 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type BatchGetUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*User                `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetUsersResponse) Reset() {
+	*x = BatchGetUsersResponse{}
+	mi := &file_proto_userservice_user_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetUsersResponse) ProtoMessage() {}
+
+func (x *BatchGetUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_userservice_user_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetUsersResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetUsersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_userservice_user_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchGetUsersResponse) GetUsers() []*User {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+var File_proto_userservice_user_proto protoreflect.FileDescriptor
+
+const file_proto_userservice_user_proto_rawDesc = "" +
+	"\n" +
+	"\x1cproto/userservice/user.proto\x12\x10userserviceproto\"\x86\x01\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1c\n" +
+	"\tfirstName\x18\x02 \x01(\tR\tfirstName\x12\x1e\n" +
+	"\n" +
+	"middleName\x18\x03 \x01(\tR\n" +
+	"middleName\x12\x1a\n" +
+	"\blastName\x18\x04 \x01(\tR\blastName\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\"5\n" +
+	"\x15GetIdBySessionRequest\x12\x1c\n" +
+	"\tsessionId\x18\x01 \x01(\tR\tsessionId\"0\n" +
+	"\x16GetIdBySessionResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\rR\x06userId\"+\n" +
+	"\x13GetIdByTokenRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\".\n" +
+	"\x14GetIdByTokenResponse\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\rR\x06userId\"(\n" +
+	"\x0eGetUserRequest\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\rR\x06userId\"=\n" +
+	"\x0fGetUserResponse\x12*\n" +
+	"\x04user\x18\x01 \x01(\v2\x16.userserviceproto.UserR\x04user\"0\n" +
+	"\x14BatchGetUsersRequest\x12\x18\n" +
+	"\auserIds\x18\x01 \x03(\rR\auserIds\"E\n" +
+	"\x15BatchGetUsersResponse\x12,\n" +
+	"\x05users\x18\x01 \x03(\v2\x16.userserviceproto.UserR\x05users2\x83\x03\n" +
+	"\vUserService\x12c\n" +
+	"\x0eGetIdBySession\x12'.userserviceproto.GetIdBySessionRequest\x1a(.userserviceproto.GetIdBySessionResponse\x12]\n" +
+	"\fGetIdByToken\x12%.userserviceproto.GetIdByTokenRequest\x1a&.userserviceproto.GetIdByTokenResponse\x12N\n" +
+	"\aGetUser\x12 .userserviceproto.GetUserRequest\x1a!.userserviceproto.GetUserResponse\x12`\n" +
+	"\rBatchGetUsers\x12&.userserviceproto.BatchGetUsersRequest\x1a'.userserviceproto.BatchGetUsersResponseB\x12Z\x10./;userservicev1b\x06proto3"
+
+var (
+	file_proto_userservice_user_proto_rawDescOnce sync.Once
+	file_proto_userservice_user_proto_rawDescData []byte
+)
+
+func file_proto_userservice_user_proto_rawDescGZIP() []byte {
+	file_proto_userservice_user_proto_rawDescOnce.Do(func() {
+		file_proto_userservice_user_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_proto_userservice_user_proto_rawDesc), len(file_proto_userservice_user_proto_rawDesc)))
+	})
+	return file_proto_userservice_user_proto_rawDescData
+}
+
+var file_proto_userservice_user_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_proto_userservice_user_proto_goTypes = []any{
+	(*User)(nil),                   // 0: userserviceproto.User
+	(*GetIdBySessionRequest)(nil),  // 1: userserviceproto.GetIdBySessionRequest
+	(*GetIdBySessionResponse)(nil), // 2: userserviceproto.GetIdBySessionResponse
+	(*GetIdByTokenRequest)(nil),    // 3: userserviceproto.GetIdByTokenRequest
+	(*GetIdByTokenResponse)(nil),   // 4: userserviceproto.GetIdByTokenResponse
+	(*GetUserRequest)(nil),         // 5: userserviceproto.GetUserRequest
+	(*GetUserResponse)(nil),        // 6: userserviceproto.GetUserResponse
+	(*BatchGetUsersRequest)(nil),   // 7: userserviceproto.BatchGetUsersRequest
+	(*BatchGetUsersResponse)(nil),  // 8: userserviceproto.BatchGetUsersResponse
+}
+var file_proto_userservice_user_proto_depIdxs = []int32{
+	0, // 0: userserviceproto.GetUserResponse.user:type_name -> userserviceproto.User
+	0, // 1: userserviceproto.BatchGetUsersResponse.users:type_name -> userserviceproto.User
+	1, // 2: userserviceproto.UserService.GetIdBySession:input_type -> userserviceproto.GetIdBySessionRequest
+	3, // 3: userserviceproto.UserService.GetIdByToken:input_type -> userserviceproto.GetIdByTokenRequest
+	5, // 4: userserviceproto.UserService.GetUser:input_type -> userserviceproto.GetUserRequest
+	7, // 5: userserviceproto.UserService.BatchGetUsers:input_type -> userserviceproto.BatchGetUsersRequest
+	2, // 6: userserviceproto.UserService.GetIdBySession:output_type -> userserviceproto.GetIdBySessionResponse
+	4, // 7: userserviceproto.UserService.GetIdByToken:output_type -> userserviceproto.GetIdByTokenResponse
+	6, // 8: userserviceproto.UserService.GetUser:output_type -> userserviceproto.GetUserResponse
+	8, // 9: userserviceproto.UserService.BatchGetUsers:output_type -> userserviceproto.BatchGetUsersResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_proto_userservice_user_proto_init() }
+func file_proto_userservice_user_proto_init() {
+	if File_proto_userservice_user_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_userservice_user_proto_rawDesc), len(file_proto_userservice_user_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   9,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_userservice_user_proto_goTypes,
+		DependencyIndexes: file_proto_userservice_user_proto_depIdxs,
+		MessageInfos:      file_proto_userservice_user_proto_msgTypes,
+	}.Build()
+	File_proto_userservice_user_proto = out.File
+	file_proto_userservice_user_proto_goTypes = nil
+	file_proto_userservice_user_proto_depIdxs = nil
+}
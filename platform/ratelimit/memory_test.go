@@ -0,0 +1,116 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryLimiter_Allow(t *testing.T) {
+	limit := Limit{Requests: 1, Period: time.Second, Burst: 2}
+
+	tests := []struct {
+		testName string
+
+		advance time.Duration
+
+		expAllowed    bool
+		expRemaining  int64
+		expRetryAfter time.Duration
+	}{
+		{
+			testName: "First request",
+
+			expAllowed:   true,
+			expRemaining: 1,
+		}, {
+			testName: "Burst exhausted",
+
+			expAllowed:   true,
+			expRemaining: 0,
+		}, {
+			testName: "Denied",
+
+			expAllowed:    false,
+			expRemaining:  0,
+			expRetryAfter: time.Second,
+		}, {
+			testName: "Partially refilled",
+
+			advance: 500 * time.Millisecond,
+
+			expAllowed:    false,
+			expRemaining:  0,
+			expRetryAfter: 500 * time.Millisecond,
+		}, {
+			testName: "Refilled",
+
+			advance: 500 * time.Millisecond,
+
+			expAllowed:   true,
+			expRemaining: 0,
+		}, {
+			testName: "Refill capped by burst",
+
+			advance: 10 * time.Second,
+
+			expAllowed:   true,
+			expRemaining: 1,
+		},
+	}
+
+	now := time.Now()
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return now }
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			now = now.Add(tt.advance)
+
+			res, err := limiter.Allow(context.Background(), "user:1", limit)
+			require.NoError(t, err)
+			require.Equal(t, tt.expAllowed, res.Allowed)
+			require.Equal(t, int64(2), res.Limit)
+			require.Equal(t, tt.expRemaining, res.Remaining)
+			require.Equal(t, tt.expRetryAfter, res.RetryAfter)
+		})
+	}
+}
+
+func TestMemoryLimiter_SeparateKeys(t *testing.T) {
+	limit := Limit{Requests: 1, Period: time.Minute, Burst: 1}
+	limiter := NewMemoryLimiter()
+	ctx := context.Background()
+
+	res, err := limiter.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	res, err = limiter.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+
+	res, err = limiter.Allow(ctx, "user:2", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+}
+
+func TestMemoryLimiter_Sweep(t *testing.T) {
+	limit := Limit{Requests: 1, Period: time.Second, Burst: 1}
+	now := time.Now()
+	limiter := NewMemoryLimiter()
+	limiter.now = func() time.Time { return now }
+
+	_, err := limiter.Allow(context.Background(), "user:1", limit)
+	require.NoError(t, err)
+	require.Len(t, limiter.buckets, 1)
+
+	now = now.Add(2 * sweepInterval)
+
+	_, err = limiter.Allow(context.Background(), "user:2", limit)
+	require.NoError(t, err)
+	require.Len(t, limiter.buckets, 1)
+	require.Contains(t, limiter.buckets, "user:2")
+}
@@ -0,0 +1,28 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+type Limit struct {
+	Requests int64
+	Period   time.Duration
+	Burst    int64
+}
+
+func (l Limit) Rate() float64 {
+	return float64(l.Requests) / l.Period.Seconds()
+}
+
+type Result struct {
+	Allowed    bool
+	Limit      int64
+	Remaining  int64
+	RetryAfter time.Duration
+	ResetAfter time.Duration
+}
+
+type Limiter interface {
+	Allow(ctx context.Context, key string, limit Limit) (*Result, error)
+}
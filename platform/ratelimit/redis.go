@@ -0,0 +1,70 @@
+package ratelimit
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var tokenBucketScript = redis.NewScript(`
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+if tokens == nil then
+	tokens = burst
+	updated = now
+end
+
+local elapsed = math.max(0, now - updated) / 1000
+tokens = math.min(burst, tokens + elapsed * rate)
+
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+
+redis.call("HSET", KEYS[1], "tokens", tostring(tokens), "updated", tostring(now))
+redis.call("PEXPIRE", KEYS[1], math.ceil((burst - tokens) / rate * 1000) + 1000)
+
+return {allowed, tostring(tokens)}
+`)
+
+type RedisLimiter struct {
+	client *redis.Client
+	prefix string
+	now    func() time.Time
+}
+
+func NewRedisLimiter(client *redis.Client, prefix string) *RedisLimiter {
+	return &RedisLimiter{
+		client: client,
+		prefix: prefix,
+		now:    time.Now,
+	}
+}
+
+func (r *RedisLimiter) Allow(ctx context.Context, key string, limit Limit) (*Result, error) {
+	res, err := tokenBucketScript.Run(ctx, r.client,
+		[]string{r.prefix + ":" + key},
+		strconv.FormatFloat(limit.Rate(), 'f', -1, 64),
+		limit.Burst,
+		r.now().UnixMilli(),
+	).Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	tokens, err := strconv.ParseFloat(res[1].(string), 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResult(limit, res[0].(int64) == 1, tokens), nil
+}
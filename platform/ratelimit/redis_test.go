@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisLimiter_Allow(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	now := time.Now()
+	limiter := NewRedisLimiter(client, "rate_limit")
+	limiter.now = func() time.Time { return now }
+
+	limit := Limit{Requests: 1, Period: time.Second, Burst: 2}
+	ctx := context.Background()
+
+	for i := int64(1); i >= 0; i-- {
+		res, err := limiter.Allow(ctx, "user:1", limit)
+		require.NoError(t, err)
+		require.True(t, res.Allowed)
+		require.Equal(t, i, res.Remaining)
+	}
+	require.True(t, mr.Exists("rate_limit:user:1"))
+
+	res, err := limiter.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.Equal(t, time.Second, res.RetryAfter)
+
+	res, err = limiter.Allow(ctx, "user:2", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+
+	now = now.Add(time.Second)
+
+	res, err = limiter.Allow(ctx, "user:1", limit)
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, int64(0), res.Remaining)
+}
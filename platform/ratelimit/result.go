@@ -0,0 +1,28 @@
+package ratelimit
+
+import (
+	"math"
+	"time"
+)
+
+func NewResult(limit Limit, allowed bool, tokens float64) *Result {
+	rate := limit.Rate()
+	res := &Result{
+		Allowed:    allowed,
+		Limit:      limit.Burst,
+		Remaining:  int64(math.Floor(tokens)),
+		ResetAfter: secondsToDuration((float64(limit.Burst) - tokens) / rate),
+	}
+	if !allowed {
+		res.RetryAfter = secondsToDuration((1 - tokens) / rate)
+	}
+
+	return res
+}
+
+func secondsToDuration(sec float64) time.Duration {
+	if sec <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(sec * float64(time.Second)))
+}
@@ -0,0 +1,80 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestEnsure(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		in      string
+		expKeep bool
+	}{
+		{
+			testName: "Valid id",
+
+			in:      "0f8fad5b-d9cb-469f-a165-70867728950e",
+			expKeep: true,
+		}, {
+			testName: "Empty id",
+
+			in:      "",
+			expKeep: false,
+		}, {
+			testName: "Too long id",
+
+			in:      strings.Repeat("a", 129),
+			expKeep: false,
+		}, {
+			testName: "Log injection",
+
+			in:      "abc\nlevel=ERROR msg=forged",
+			expKeep: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			out := Ensure(tt.in)
+			if tt.expKeep {
+				require.Equal(t, tt.in, out)
+				return
+			}
+			require.NotEqual(t, tt.in, out)
+			require.Len(t, out, 32)
+		})
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	var outgoing metadata.MD
+	invoker := func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		outgoing, _ = metadata.FromOutgoingContext(ctx)
+		return nil
+	}
+
+	ctx := NewContext(context.Background(), "req-1")
+	require.NoError(t, UnaryClientInterceptor()(ctx, "/test", nil, nil, nil, invoker))
+	require.Equal(t, []string{"req-1"}, outgoing.Get(MetadataKey))
+
+	var got string
+	handler := func(ctx context.Context, req any) (any, error) {
+		got, _ = FromContext(ctx)
+		return nil, nil
+	}
+
+	_, err := UnaryServerInterceptor()(metadata.NewIncomingContext(context.Background(), outgoing), nil, nil, handler)
+	require.NoError(t, err)
+	require.Equal(t, "req-1", got)
+
+	_, err = UnaryServerInterceptor()(context.Background(), nil, nil, handler)
+	require.NoError(t, err)
+	require.Len(t, got, 32)
+}
@@ -4,23 +4,29 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
-	userservicev1 "userservice/proto/userservice"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
 type GRPCServer struct {
-	log  *slog.Logger
-	port uint32
-	serv *grpc.Server
+	log    *slog.Logger
+	port   uint32
+	serv   *grpc.Server
+	health *health.Server
 }
 
-func NewGRPCServer(log *slog.Logger, port uint32, handl userservicev1.UserServiceServer, serv *grpc.Server) *GRPCServer {
-	userservicev1.RegisterUserServiceServer(serv, handl)
+// NewGRPCServer expects the service handlers to be registered on serv already,
+// it only adds the health service every backend exposes.
+func NewGRPCServer(log *slog.Logger, port uint32, serv *grpc.Server) *GRPCServer {
+	healthServ := health.NewServer()
+	healthpb.RegisterHealthServer(serv, healthServ)
 	return &GRPCServer{
-		log:  log,
-		port: port,
-		serv: serv,
+		log:    log,
+		port:   port,
+		serv:   serv,
+		health: healthServ,
 	}
 }
 
@@ -41,6 +47,8 @@ func (g *GRPCServer) MustStart() {
 func (g *GRPCServer) Stop() {
 	const op = "grpcserv.Stop"
 	g.log.Info("start grpc server shutdown", slog.String("op", op))
+	// report NOT_SERVING first so health checking clients move away before the drain
+	g.health.Shutdown()
 	g.serv.GracefulStop()
 	g.log.Info("grpc server stopped", slog.String("op", op))
 }
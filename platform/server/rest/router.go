@@ -0,0 +1,17 @@
+package rest
+
+import "github.com/gin-gonic/gin"
+
+// MustNewRouter returns a gin engine that reads the client ip from
+// X-Forwarded-For or X-Real-IP only when the request comes from one of
+// trustedProxies. With an empty list every request is keyed by its socket
+// address, so clients cannot pick their own ip for lockouts and limits.
+func MustNewRouter(trustedProxies []string) *gin.Engine {
+	router := gin.New()
+	// lets handlers pass *gin.Context to slog and keep the request id
+	router.ContextWithFallback = true
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		panic("invalid trusted proxies: " + err.Error())
+	}
+	return router
+}
@@ -0,0 +1,76 @@
+package rest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMustNewRouter_ClientIP(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		testName string
+
+		trustedProxies []string
+		remoteAddr     string
+		forwardedFor   string
+
+		expIP string
+	}{
+		{
+			testName: "No trusted proxies",
+
+			trustedProxies: nil,
+			remoteAddr:     "192.0.2.1:1234",
+			forwardedFor:   "203.0.113.7",
+
+			expIP: "192.0.2.1",
+		}, {
+			testName: "Untrusted proxy",
+
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "192.0.2.1:1234",
+			forwardedFor:   "203.0.113.7",
+
+			expIP: "192.0.2.1",
+		}, {
+			testName: "Trusted proxy",
+
+			trustedProxies: []string{"10.0.0.0/8"},
+			remoteAddr:     "10.0.0.5:1234",
+			forwardedFor:   "203.0.113.7",
+
+			expIP: "203.0.113.7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			router := MustNewRouter(tt.trustedProxies)
+
+			var gotIP string
+			router.GET("/ip", func(ctx *gin.Context) {
+				gotIP = ctx.ClientIP()
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/ip", nil)
+			req.RemoteAddr = tt.remoteAddr
+			req.Header.Set("X-Forwarded-For", tt.forwardedFor)
+			req.Header.Set("X-Real-IP", tt.forwardedFor)
+
+			router.ServeHTTP(httptest.NewRecorder(), req)
+
+			require.Equal(t, tt.expIP, gotIP)
+		})
+	}
+}
+
+func TestMustNewRouter_InvalidProxy(t *testing.T) {
+	require.Panics(t, func() {
+		MustNewRouter([]string{"not an ip"})
+	})
+}
@@ -24,7 +24,7 @@ func (r *RestServer) MustStart() {
 	r.log.Info("starting http server", slog.String("op", op), slog.String("port", r.serv.Addr))
 
 	if err := r.serv.ListenAndServe(); err != nil {
-		if !errors.Is(http.ErrServerClosed, err) {
+		if !errors.Is(err, http.ErrServerClosed) {
 			panic("http server bad start: " + err.Error())
 		}
 	}
@@ -32,7 +32,7 @@ func (r *RestServer) MustStart() {
 
 func (r *RestServer) Stop(ctx context.Context) {
 	const op = "rest.Stop"
-	r.log.Info("start http server shutdown", slog.String("op", op))
+	r.log.InfoContext(ctx, "start http server shutdown", slog.String("op", op))
 	r.serv.Shutdown(ctx)
-	r.log.Info("http server stopped", slog.String("op", op))
+	r.log.InfoContext(ctx, "http server stopped", slog.String("op", op))
 }
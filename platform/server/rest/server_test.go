@@ -0,0 +1,29 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestServer_Stop(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	serv := NewRestServer(log, &http.Server{Addr: "127.0.0.1:0", Handler: http.NotFoundHandler()})
+
+	done := make(chan any)
+	go func() {
+		defer func() { done <- recover() }()
+		serv.MustStart()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	serv.Stop(ctx)
+
+	require.Nil(t, <-done)
+}
@@ -0,0 +1,137 @@
+package sessioncache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"platform/sessionvalidator"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type entry struct {
+	key       string
+	userId    uint32
+	notFound  bool
+	expiresAt time.Time
+}
+
+// Cache keeps recent session lookups in a bounded LRU keyed by the session
+// hash, the same value userservice publishes when a session is removed.
+type Cache struct {
+	next sessionvalidator.SessionValidator
+
+	capacity    int
+	ttl         time.Duration
+	negativeTTL time.Duration
+
+	mu    sync.Mutex
+	items map[string]*list.Element
+	order *list.List
+	gen   uint64
+	now   func() time.Time
+}
+
+func NewCache(next sessionvalidator.SessionValidator, capacity int, ttl, negativeTTL time.Duration) *Cache {
+	return &Cache{
+		next:        next,
+		capacity:    capacity,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		items:       make(map[string]*list.Element, capacity),
+		order:       list.New(),
+		now:         time.Now,
+	}
+}
+
+func (c *Cache) GetIdBySession(ctx context.Context, sessionId string) (uint32, error) {
+	key := HashSession(sessionId)
+
+	e, gen, ok := c.get(key)
+	if ok {
+		if e.notFound {
+			return 0, status.Error(codes.NotFound, "session not found")
+		}
+		return e.userId, nil
+	}
+
+	userId, err := c.next.GetIdBySession(ctx, sessionId)
+	if err != nil {
+		if status.Code(err) == codes.NotFound && c.negativeTTL > 0 {
+			c.put(gen, &entry{key: key, notFound: true, expiresAt: c.now().Add(c.negativeTTL)})
+		}
+		return 0, err
+	}
+
+	c.put(gen, &entry{key: key, userId: userId, expiresAt: c.now().Add(c.ttl)})
+	return userId, nil
+}
+
+func (c *Cache) GetIdByToken(ctx context.Context, token string) (uint32, error) {
+	return c.next.GetIdByToken(ctx, token)
+}
+
+func (c *Cache) Invalidate(sessionHash string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.gen++
+	if el, ok := c.items[sessionHash]; ok {
+		c.remove(el)
+	}
+}
+
+func (c *Cache) get(key string) (*entry, uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return nil, c.gen, false
+	}
+
+	e := el.Value.(*entry)
+	if !c.now().Before(e.expiresAt) {
+		c.remove(el)
+		return nil, c.gen, false
+	}
+
+	c.order.MoveToFront(el)
+	return e, c.gen, true
+}
+
+// put skips the entry when an invalidation arrived while the lookup was in
+// flight, otherwise a just revoked session could be cached for a full ttl.
+func (c *Cache) put(gen uint64, e *entry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gen {
+		return
+	}
+
+	if el, ok := c.items[e.key]; ok {
+		el.Value = e
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[e.key] = c.order.PushFront(e)
+	for c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry).key)
+}
+
+func HashSession(sessionId string) string {
+	sum := sha256.Sum256([]byte(sessionId))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,171 @@
+package sessioncache
+
+import (
+	"context"
+	"errors"
+	cachemocks "platform/sessioncache/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+//go:generate mockgen -source=./../sessionvalidator/session_validator.go -destination=./mocks/mock_session_validator.go -package=cachemocks
+func TestCache_GetIdBySession(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		nextReturn    uint32
+		nextReturnErr error
+		expNextCalls  int
+
+		advance time.Duration
+
+		expOut  uint32
+		expCode codes.Code
+	}{
+		{
+			testName: "Success cached",
+
+			nextReturn:   7,
+			expNextCalls: 1,
+
+			expOut:  7,
+			expCode: codes.OK,
+		}, {
+			testName: "Expired entry",
+
+			nextReturn:   7,
+			expNextCalls: 2,
+
+			advance: time.Minute,
+
+			expOut:  7,
+			expCode: codes.OK,
+		}, {
+			testName: "Not found cached",
+
+			nextReturnErr: status.Error(codes.NotFound, "session not found"),
+			expNextCalls:  1,
+
+			expCode: codes.NotFound,
+		}, {
+			testName: "Not found expired",
+
+			nextReturnErr: status.Error(codes.NotFound, "session not found"),
+			expNextCalls:  2,
+
+			advance: 10 * time.Second,
+
+			expCode: codes.NotFound,
+		}, {
+			testName: "Unavailable not cached",
+
+			nextReturnErr: status.Error(codes.Unavailable, "unavailable"),
+			expNextCalls:  2,
+
+			expCode: codes.Unavailable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			next := cachemocks.NewMockSessionValidator(ctrl)
+			next.EXPECT().GetIdBySession(gomock.Any(), "session").
+				Return(tt.nextReturn, tt.nextReturnErr).Times(tt.expNextCalls)
+
+			now := time.Now()
+			cache := NewCache(next, 10, 30*time.Second, 5*time.Second)
+			cache.now = func() time.Time { return now }
+
+			for range 2 {
+				out, err := cache.GetIdBySession(context.Background(), "session")
+				require.Equal(t, tt.expCode, status.Code(err))
+				require.Equal(t, tt.expOut, out)
+				now = now.Add(tt.advance)
+			}
+		})
+	}
+}
+
+func TestCache_Invalidate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := cachemocks.NewMockSessionValidator(ctrl)
+	next.EXPECT().GetIdBySession(gomock.Any(), "session").Return(uint32(7), nil).Times(1)
+	next.EXPECT().GetIdBySession(gomock.Any(), "session").
+		Return(uint32(0), status.Error(codes.NotFound, "session not found")).Times(1)
+
+	cache := NewCache(next, 10, time.Minute, time.Minute)
+
+	userId, err := cache.GetIdBySession(context.Background(), "session")
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), userId)
+
+	cache.Invalidate(HashSession("session"))
+
+	_, err = cache.GetIdBySession(context.Background(), "session")
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCache_InvalidateDuringLookup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := cachemocks.NewMockSessionValidator(ctrl)
+	cache := NewCache(next, 10, time.Minute, time.Minute)
+
+	next.EXPECT().GetIdBySession(gomock.Any(), "session").
+		DoAndReturn(func(context.Context, string) (uint32, error) {
+			cache.Invalidate(HashSession("session"))
+			return 7, nil
+		}).Times(1)
+	next.EXPECT().GetIdBySession(gomock.Any(), "session").
+		Return(uint32(0), status.Error(codes.NotFound, "session not found")).Times(1)
+
+	_, err := cache.GetIdBySession(context.Background(), "session")
+	require.NoError(t, err)
+
+	_, err = cache.GetIdBySession(context.Background(), "session")
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestCache_Eviction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := cachemocks.NewMockSessionValidator(ctrl)
+	next.EXPECT().GetIdBySession(gomock.Any(), "a").Return(uint32(1), nil).Times(1)
+	next.EXPECT().GetIdBySession(gomock.Any(), "b").Return(uint32(2), nil).Times(2)
+	next.EXPECT().GetIdBySession(gomock.Any(), "c").Return(uint32(3), nil).Times(1)
+
+	cache := NewCache(next, 2, time.Minute, time.Minute)
+
+	// "a" is used again before "c" arrives, so "b" is the one evicted
+	for _, sessionId := range []string{"a", "b", "a", "c", "a", "b"} {
+		_, err := cache.GetIdBySession(context.Background(), sessionId)
+		require.NoError(t, err)
+	}
+}
+
+func TestCache_GetIdByToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	next := cachemocks.NewMockSessionValidator(ctrl)
+	next.EXPECT().GetIdByToken(gomock.Any(), "token").Return(uint32(0), errors.New("boom")).Times(2)
+
+	cache := NewCache(next, 10, time.Minute, time.Minute)
+
+	for range 2 {
+		_, err := cache.GetIdByToken(context.Background(), "token")
+		require.Error(t, err)
+	}
+}
@@ -0,0 +1,5 @@
+package sessionvalidator
+
+type SessionInvalidator interface {
+	Invalidate(sessionHash string)
+}
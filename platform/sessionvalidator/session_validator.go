@@ -4,4 +4,5 @@ import "context"
 
 type SessionValidator interface {
 	GetIdBySession(ctx context.Context, sessionId string) (uint32, error)
+	GetIdByToken(ctx context.Context, token string) (uint32, error)
 }
@@ -4,59 +4,128 @@ import (
 	"context"
 	"database/sql"
 	"log/slog"
+	"platform/grpc/clientmetrics"
+	"platform/grpc/userclient"
+	"platform/invalidation"
+	"platform/logger"
+	"platform/server/grpcserv"
+	"platform/server/rest"
 	"projectservice/internal/config"
-	userserviceclient "projectservice/internal/infrastructure/grpc/userservice"
 	"projectservice/internal/infrastructure/postgres"
-	"projectservice/internal/transport/rest"
+	myredis "projectservice/internal/infrastructure/redis"
+	grpchandler "projectservice/internal/transport/grpc/handler"
+	outboxrelay "projectservice/internal/transport/outbox"
 	resthandler "projectservice/internal/transport/rest/handler"
+	"projectservice/internal/usecase/implementations/changememberrole"
+	"projectservice/internal/usecase/implementations/checkaccess"
 	"projectservice/internal/usecase/implementations/createproject"
 	"projectservice/internal/usecase/implementations/deleteproject"
 	"projectservice/internal/usecase/implementations/getallprojects"
-	"projectservice/pkg/logger"
+	"projectservice/internal/usecase/implementations/getmembers"
+	"projectservice/internal/usecase/implementations/getproject"
+	"projectservice/internal/usecase/implementations/getprojectbyid"
+	"projectservice/internal/usecase/implementations/invitemember"
+	"projectservice/internal/usecase/implementations/publishevents"
+	"projectservice/internal/usecase/implementations/removemember"
+	"projectservice/internal/usecase/implementations/updateproject"
+	ucmetrics "projectservice/internal/usecase/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
 )
 
 type App struct {
-	log    *slog.Logger
-	cfg    *config.Config
-	serv   *rest.RestServer
-	db     *sql.DB
-	client *userserviceclient.UserServiceClient
+	log        *slog.Logger
+	cfg        *config.Config
+	serv       *rest.RestServer
+	grpcServer *grpcserv.GRPCServer
+	relay      *outboxrelay.Relay
+	subscriber *invalidation.Subscriber
+	db         *sql.DB
+	redis      *redis.Client
+	client     *userclient.Client
 }
 
 func NewApp() *App {
 	cfg := config.MustLoad()
-	log := logger.SetupLogger(cfg.LoggerConf.Level)
-	db := mustLoadPostgres(cfg)
+	log := logger.SetupLogger(cfg.LoggerConf.Level, cfg.LoggerConf.Format)
+	reg := prometheus.DefaultRegisterer
+	db := mustLoadPostgres(cfg, reg)
+	redisClient := mustLoadRedis(cfg, reg)
 
-	client := userserviceclient.NewUserServiceClient(log, cfg.ConnectionsConf.UserServConnConf.Host, cfg.ConnectionsConf.UserServConnConf.Port)
+	client := loadUserServiceClient(cfg, log, clientmetrics.NewClientMetrics(reg))
 	postgres := postgres.NewPostgres(db)
+	publisher := myredis.NewRedisPublisher(redisClient, cfg.OutboxConf.Stream)
+	limiter := mustLoadRateLimiter(cfg, redisClient)
+
+	ucMetrics := ucmetrics.NewUsecaseMetrics(reg)
 
-	createProjectUC := createproject.NewCreateProjectUC(log, postgres)
-	deleteProjectUC := deleteproject.NewDeleteProjectUC(log, postgres)
+	createProjectUC := ucMetrics.CreateProject(createproject.NewCreateProjectUC(log, postgres))
+	deleteProjectUC := deleteproject.NewDeleteProjectUC(log, postgres, postgres)
 	getAllProjectsUC := getallprojects.NewGetAllProjectsUC(log, postgres)
+	getProjectByIdUC := getprojectbyid.NewGetProjectByIdUC(log, postgres, postgres)
+	updateProjectUC := updateproject.NewUpdateProjectUC(log, postgres, postgres)
+	getProjectUC := getproject.NewGetProjectUC(log, postgres)
+	checkAccessUC := checkaccess.NewCheckAccessUC(log, postgres, postgres)
+	inviteMemberUC := invitemember.NewInviteMemberUC(log, postgres)
+	getMembersUC := getmembers.NewGetMembersUC(log, postgres)
+	changeMemberRoleUC := changememberrole.NewChangeMemberRoleUC(log, postgres)
+	removeMemberUC := removemember.NewRemoveMemberUC(log, postgres)
+	publishEventsUC := publishevents.NewPublishEventsUC(log, postgres, publisher)
 
-	handl := resthandler.NewHandler(log, createProjectUC, deleteProjectUC, getAllProjectsUC)
+	handl := resthandler.NewHandler(
+		log,
+		createProjectUC,
+		deleteProjectUC,
+		getAllProjectsUC,
+		getProjectByIdUC,
+		updateProjectUC,
+		inviteMemberUC,
+		getMembersUC,
+		changeMemberRoleUC,
+		removeMemberUC,
+	)
+	grpchandl := grpchandler.NewGRPCHandler(log, getProjectUC, getAllProjectsUC, checkAccessUC)
 
-	serv := mustLoadHttpServer(cfg, log, handl, client)
+	sessionValid := loadSessionValidator(cfg, log, client)
+	sessionValid, subscriber := loadSessionCache(cfg, log, sessionValid, redisClient)
+	serv := mustLoadHttpServer(cfg, log, handl, sessionValid, limiter, reg)
+	grpcServer := mustLoadGRPCServer(cfg, log, grpchandl, reg)
+	relay := outboxrelay.NewRelay(log, publishEventsUC, cfg.OutboxConf.PollInterval, cfg.OutboxConf.BatchSize)
 
 	return &App{
-		log:    log,
-		cfg:    cfg,
-		serv:   serv,
-		db:     db,
-		client: client,
+		log:        log,
+		cfg:        cfg,
+		serv:       serv,
+		grpcServer: grpcServer,
+		relay:      relay,
+		subscriber: subscriber,
+		db:         db,
+		redis:      redisClient,
+		client:     client,
 	}
 }
 
 func (a *App) Run() {
-	a.serv.MustStart()
+	go a.relay.Start()
+	if a.subscriber != nil {
+		go a.subscriber.Start()
+	}
+	go a.serv.MustStart()
+	a.grpcServer.MustStart()
 }
 
 func (a *App) Stop() {
 	ctx, cancel := context.WithTimeout(context.Background(), a.cfg.RestConf.ShutdownTimeout)
 	defer cancel()
 	a.serv.Stop(ctx)
+	a.grpcServer.Stop()
+	a.relay.Stop()
+	if a.subscriber != nil {
+		a.subscriber.Stop()
+	}
 
 	a.client.Stop()
 	a.db.Close()
+	a.redis.Close()
 }
@@ -0,0 +1,28 @@
+package app
+
+import (
+	"log/slog"
+	"platform/grpc/interceptor"
+	platformmetrics "platform/metrics"
+	projectservicev1 "platform/proto/projectservice"
+	"platform/requestid"
+	"platform/server/grpcserv"
+	"projectservice/internal/config"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc"
+)
+
+func mustLoadGRPCServer(cfg *config.Config, log *slog.Logger, handl projectservicev1.ProjectServiceServer, reg prometheus.Registerer) *grpcserv.GRPCServer {
+	serv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(
+			requestid.UnaryServerInterceptor(),
+			platformmetrics.UnaryServerInterceptor(reg),
+			interceptor.RecoverInterceptor(log),
+			interceptor.TimeoutInterceptor(log, cfg.GrpcConf.Timeout),
+		),
+	)
+
+	projectservicev1.RegisterProjectServiceServer(serv, handl)
+	return grpcserv.NewGRPCServer(log, cfg.GrpcConf.Port, serv)
+}
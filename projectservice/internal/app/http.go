@@ -4,26 +4,44 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	platformmetrics "platform/metrics"
+	platformmiddleware "platform/middleware"
+	"platform/ratelimit"
+	"platform/server/rest"
+	"platform/sessionvalidator"
 	"projectservice/internal/config"
-	sessionalidator "projectservice/internal/repository/sessionvalidator"
-	"projectservice/internal/transport/rest"
 	resthandler "projectservice/internal/transport/rest/handler"
-	"projectservice/internal/transport/rest/middleware"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func mustLoadHttpServer(cfg *config.Config, log *slog.Logger, handl *resthandler.RestHandler, sessionValid sessionalidator.SessionValidator) *rest.RestServer {
+func mustLoadHttpServer(cfg *config.Config, log *slog.Logger, handl *resthandler.RestHandler, sessionValid sessionvalidator.SessionValidator, limiter ratelimit.Limiter, reg prometheus.Registerer) *rest.RestServer {
 	gin.SetMode(cfg.RestConf.Mode)
-	router := gin.New()
+	router := rest.MustNewRouter(cfg.RestConf.TrustedProxies)
+	// outside Recovery so panics are counted as 500
+	router.Use(platformmetrics.HTTPMiddleware(reg))
 	router.Use(gin.Recovery())
-	router.Use(middleware.GetSessionMiddleware(log))
-	router.Use(middleware.SessionAuthMiddleware(log, sessionValid, cfg.ConnectionsConf.UserServConnConf.ResponseTimeout))
-	router.Use(middleware.TimeoutMiddleware(cfg.RestConf.RequestTimeout))
+	router.Use(platformmiddleware.RequestIDMiddleware())
+	// registered before the auth middlewares so scrapers need no session
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	// keyed by ip only, so requests without a valid session are limited too
+	router.Use(platformmiddleware.IPRateLimitMiddleware(log, limiter, limitFromConfig(cfg.RateLimitConf.IP)))
+	router.Use(platformmiddleware.GetSessionMiddleware(log))
+	router.Use(platformmiddleware.SessionAuthMiddleware(log, sessionValid, cfg.ConnectionsConf.UserServConnConf.ResponseTimeout))
+	router.Use(platformmiddleware.RateLimitMiddleware(log, limiter, loadRateLimitRules(cfg)))
+	router.Use(platformmiddleware.TimeoutMiddleware(cfg.RestConf.RequestTimeout))
 
 	router.POST("/project/create", handl.Create)
 	router.DELETE("/project/delete", handl.Delete)
 	router.GET("/project/getall", handl.GetAll)
+	router.GET("/project/:id", handl.GetById)
+	router.PATCH("/project/:id", handl.Update)
+	router.POST("/project/members/invite", handl.InviteMember)
+	router.GET("/project/members/getall/:project_id", handl.GetMembers)
+	router.PATCH("/project/members/change/role", handl.ChangeMemberRole)
+	router.DELETE("/project/members/remove", handl.RemoveMember)
 
 	serv := &http.Server{
 		Addr:         fmt.Sprintf(":%d", cfg.RestConf.Port),
@@ -0,0 +1,18 @@
+package app
+
+import (
+	"log/slog"
+	"platform/grpc/userclient"
+	jwtvalidator "platform/jwt"
+	"platform/sessionvalidator"
+	"projectservice/internal/config"
+)
+
+func loadSessionValidator(cfg *config.Config, log *slog.Logger, client *userclient.Client) sessionvalidator.SessionValidator {
+	if !cfg.JWTConf.Enabled {
+		return client
+	}
+
+	keys := jwtvalidator.NewKeySet(cfg.JWTConf.JWKSURL, cfg.JWTConf.RefreshInterval, cfg.ConnectionsConf.UserServConnConf.ResponseTimeout)
+	return jwtvalidator.NewValidator(log, client, keys, cfg.JWTConf.Issuer, cfg.JWTConf.Audience)
+}
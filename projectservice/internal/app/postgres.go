@@ -3,12 +3,14 @@ package app
 import (
 	"database/sql"
 	"fmt"
+	platformmetrics "platform/metrics"
 	"projectservice/internal/config"
 
 	_ "github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
 )
 
-func mustLoadPostgres(cfg *config.Config) *sql.DB {
+func mustLoadPostgres(cfg *config.Config, reg prometheus.Registerer) *sql.DB {
 	dsn := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		cfg.PostgresConf.Host,
 		cfg.PostgresConf.Port,
@@ -27,5 +29,7 @@ func mustLoadPostgres(cfg *config.Config) *sql.DB {
 		panic("cannot ping db:" + err.Error())
 	}
 
+	platformmetrics.RegisterDBStats(reg, cfg.PostgresConf.DbName, db)
+
 	return db
 }
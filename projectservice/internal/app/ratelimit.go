@@ -0,0 +1,40 @@
+package app
+
+import (
+	platformmiddleware "platform/middleware"
+	"platform/ratelimit"
+	"projectservice/internal/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func mustLoadRateLimiter(cfg *config.Config, client *redis.Client) ratelimit.Limiter {
+	switch cfg.RateLimitConf.Backend {
+	case config.MemoryRateLimiter:
+		return ratelimit.NewMemoryLimiter()
+	case config.RedisRateLimiter:
+		return ratelimit.NewRedisLimiter(client, cfg.RateLimitConf.Prefix)
+	default:
+		panic("unknown rate limiter backend: " + cfg.RateLimitConf.Backend)
+	}
+}
+
+func loadRateLimitRules(cfg *config.Config) *platformmiddleware.RateLimitRules {
+	rules := &platformmiddleware.RateLimitRules{
+		Default: limitFromConfig(cfg.RateLimitConf.Default),
+		Routes:  make(map[string]ratelimit.Limit, len(cfg.RateLimitConf.Routes)),
+	}
+	for _, route := range cfg.RateLimitConf.Routes {
+		rules.Routes[platformmiddleware.RateLimitRoute(route.Method, route.Path)] = limitFromConfig(route.LimitConfig)
+	}
+
+	return rules
+}
+
+func limitFromConfig(cfg config.LimitConfig) ratelimit.Limit {
+	return ratelimit.Limit{
+		Requests: cfg.Requests,
+		Period:   cfg.Period,
+		Burst:    cfg.Burst,
+	}
+}
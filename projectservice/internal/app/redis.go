@@ -0,0 +1,27 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	platformmetrics "platform/metrics"
+	"projectservice/internal/config"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+)
+
+func mustLoadRedis(cfg *config.Config, reg prometheus.Registerer) *redis.Client {
+	client := redis.NewClient(&redis.Options{
+		Addr:     fmt.Sprintf("%s:%d", cfg.RedisConf.Host, cfg.RedisConf.Port),
+		Password: cfg.RedisConf.Password,
+		DB:       cfg.RedisConf.DB,
+	})
+
+	if err := client.Ping(context.Background()); err.Err() != nil {
+		panic("failed to connect to the redis: " + err.Err().Error())
+	}
+
+	platformmetrics.RegisterRedisStats(reg, client)
+
+	return client
+}
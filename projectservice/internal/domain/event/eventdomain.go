@@ -0,0 +1,46 @@
+package eventdomain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	ProjectDeletedType = "ProjectDeleted"
+)
+
+type Event struct {
+	Id        uint64
+	Type      string
+	Payload   []byte
+	CreatedAt time.Time
+}
+
+type ProjectDeleted struct {
+	ProjectId uint32 `json:"project_id"`
+	OwnerId   uint32 `json:"owner_id"`
+}
+
+func NewProjectDeletedEvent(projectId uint32, ownerId uint32) (*Event, error) {
+	payload, err := json.Marshal(&ProjectDeleted{
+		ProjectId: projectId,
+		OwnerId:   ownerId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		Type:    ProjectDeletedType,
+		Payload: payload,
+	}, nil
+}
+
+func RestoreEvent(id uint64, eventType string, payload []byte, createdAt time.Time) *Event {
+	return &Event{
+		Id:        id,
+		Type:      eventType,
+		Payload:   payload,
+		CreatedAt: createdAt,
+	}
+}
@@ -0,0 +1,9 @@
+package memberdomain
+
+import "errors"
+
+var (
+	ErrInvalidProjectId = errors.New("invalid project id")
+	ErrInvalidUserId    = errors.New("invalid user id")
+	ErrInvalidRole      = errors.New("invalid role")
+)
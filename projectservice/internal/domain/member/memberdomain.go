@@ -0,0 +1,74 @@
+package memberdomain
+
+import "time"
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	RoleViewer Role = "viewer"
+)
+
+type MemberDomain struct {
+	ProjectId uint32
+	UserId    uint32
+	Role      Role
+	CreatedAt time.Time
+}
+
+func NewMemberDomain(projectId, userId uint32, role Role) (*MemberDomain, error) {
+	if projectId == 0 {
+		return nil, ErrInvalidProjectId
+	}
+	if userId == 0 {
+		return nil, ErrInvalidUserId
+	}
+	if err := ValidateRole(role); err != nil {
+		return nil, err
+	}
+
+	return &MemberDomain{
+		ProjectId: projectId,
+		UserId:    userId,
+		Role:      role,
+	}, nil
+}
+
+func RestoreMemberDomain(projectId, userId uint32, role Role, createdAt time.Time) *MemberDomain {
+	return &MemberDomain{
+		ProjectId: projectId,
+		UserId:    userId,
+		Role:      role,
+		CreatedAt: createdAt,
+	}
+}
+
+func ValidateRole(role Role) error {
+	switch role {
+	case RoleOwner, RoleAdmin, RoleMember, RoleViewer:
+		return nil
+	default:
+		return ErrInvalidRole
+	}
+}
+
+func CanManage(actor, target Role) bool {
+	switch actor {
+	case RoleOwner:
+		return target != RoleOwner
+	case RoleAdmin:
+		return target == RoleMember || target == RoleViewer
+	default:
+		return false
+	}
+}
+
+func CanEditProject(role Role) bool {
+	return role == RoleOwner || role == RoleAdmin
+}
+
+func CanDeleteProject(role Role) bool {
+	return role == RoleOwner
+}
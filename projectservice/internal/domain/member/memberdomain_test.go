@@ -0,0 +1,83 @@
+package memberdomain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemberDomain(t *testing.T) {
+	tests := []struct {
+		testName  string
+		projectId uint32
+		userId    uint32
+		role      Role
+		expDomain *MemberDomain
+		expErr    error
+	}{
+		{
+			testName:  "Success",
+			projectId: 1,
+			userId:    2,
+			role:      RoleMember,
+			expDomain: &MemberDomain{
+				ProjectId: 1,
+				UserId:    2,
+				Role:      RoleMember,
+			},
+			expErr: nil,
+		}, {
+			testName:  "Invalid project id",
+			projectId: 0,
+			userId:    2,
+			role:      RoleMember,
+			expDomain: nil,
+			expErr:    ErrInvalidProjectId,
+		}, {
+			testName:  "Invalid user id",
+			projectId: 1,
+			userId:    0,
+			role:      RoleMember,
+			expDomain: nil,
+			expErr:    ErrInvalidUserId,
+		}, {
+			testName:  "Invalid role",
+			projectId: 1,
+			userId:    2,
+			role:      Role("superuser"),
+			expDomain: nil,
+			expErr:    ErrInvalidRole,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			domain, err := NewMemberDomain(tt.projectId, tt.userId, tt.role)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expDomain, domain)
+		})
+	}
+}
+
+func TestCanManage(t *testing.T) {
+	tests := []struct {
+		testName string
+		actor    Role
+		target   Role
+		expCan   bool
+	}{
+		{testName: "Owner manages admin", actor: RoleOwner, target: RoleAdmin, expCan: true},
+		{testName: "Owner manages viewer", actor: RoleOwner, target: RoleViewer, expCan: true},
+		{testName: "Owner can't manage owner", actor: RoleOwner, target: RoleOwner, expCan: false},
+		{testName: "Admin manages member", actor: RoleAdmin, target: RoleMember, expCan: true},
+		{testName: "Admin can't manage admin", actor: RoleAdmin, target: RoleAdmin, expCan: false},
+		{testName: "Member can't manage viewer", actor: RoleMember, target: RoleViewer, expCan: false},
+		{testName: "Viewer can't manage viewer", actor: RoleViewer, target: RoleViewer, expCan: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			require.Equal(t, tt.expCan, CanManage(tt.actor, tt.target))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package pagedomain
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"time"
+)
+
+type Cursor struct {
+	Sort      Sort      `json:"s"`
+	Direction Direction `json:"d"`
+	Name      string    `json:"n,omitempty"`
+	CreatedAt time.Time `json:"c,omitempty"`
+	Id        uint32    `json:"i"`
+}
+
+func NewCursor(sort Sort, direction Direction, id uint32, name string, createdAt time.Time) *Cursor {
+	c := &Cursor{
+		Sort:      sort,
+		Direction: direction,
+		Id:        id,
+	}
+	switch sort {
+	case SortByName:
+		c.Name = name
+	case SortByCreatedAt:
+		c.CreatedAt = createdAt
+	}
+	return c
+}
+
+func (c *Cursor) Encode() string {
+	b, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func DecodeCursor(s string) (*Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &Cursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if c.Id == 0 || validateSort(c.Sort) != nil || validateDirection(c.Direction) != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return c, nil
+}
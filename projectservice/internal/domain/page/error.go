@@ -0,0 +1,11 @@
+package pagedomain
+
+import "errors"
+
+var (
+	ErrInvalidLimit     = errors.New("invalid limit")
+	ErrInvalidCursor    = errors.New("invalid cursor")
+	ErrInvalidSort      = errors.New("invalid sort")
+	ErrInvalidDirection = errors.New("invalid direction")
+	ErrInvalidFilter    = errors.New("invalid name filter")
+)
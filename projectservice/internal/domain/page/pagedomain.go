@@ -0,0 +1,92 @@
+package pagedomain
+
+type Sort string
+
+const (
+	SortByCreatedAt Sort = "created_at"
+	SortByName      Sort = "name"
+)
+
+type Direction string
+
+const (
+	DirectionAsc  Direction = "asc"
+	DirectionDesc Direction = "desc"
+)
+
+const (
+	DefaultLimit uint32 = 20
+	MaxLimit     uint32 = 100
+
+	maxNameFilterLen = 255
+)
+
+type ListParams struct {
+	Limit     uint32
+	Sort      Sort
+	Direction Direction
+	Name      string
+	After     *Cursor
+}
+
+func NewListParams(limit uint32, cursor, sort, direction, name string) (*ListParams, error) {
+	p := &ListParams{
+		Limit:     DefaultLimit,
+		Sort:      SortByCreatedAt,
+		Direction: DirectionAsc,
+		Name:      name,
+	}
+
+	if limit != 0 {
+		if limit > MaxLimit {
+			return nil, ErrInvalidLimit
+		}
+		p.Limit = limit
+	}
+	if sort != "" {
+		p.Sort = Sort(sort)
+		if err := validateSort(p.Sort); err != nil {
+			return nil, err
+		}
+	}
+	if direction != "" {
+		p.Direction = Direction(direction)
+		if err := validateDirection(p.Direction); err != nil {
+			return nil, err
+		}
+	}
+	if len([]rune(name)) > maxNameFilterLen {
+		return nil, ErrInvalidFilter
+	}
+
+	if cursor != "" {
+		c, err := DecodeCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		if c.Sort != p.Sort || c.Direction != p.Direction {
+			return nil, ErrInvalidCursor
+		}
+		p.After = c
+	}
+
+	return p, nil
+}
+
+func validateSort(sort Sort) error {
+	switch sort {
+	case SortByCreatedAt, SortByName:
+		return nil
+	default:
+		return ErrInvalidSort
+	}
+}
+
+func validateDirection(direction Direction) error {
+	switch direction {
+	case DirectionAsc, DirectionDesc:
+		return nil
+	default:
+		return ErrInvalidDirection
+	}
+}
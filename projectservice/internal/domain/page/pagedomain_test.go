@@ -0,0 +1,88 @@
+package pagedomain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewListParams(t *testing.T) {
+	nameCursor := NewCursor(SortByName, DirectionDesc, 7, "B", time.Time{})
+
+	tests := []struct {
+		testName  string
+		limit     uint32
+		cursor    string
+		sort      string
+		direction string
+		name      string
+		expParams *ListParams
+		expErr    error
+	}{
+		{
+			testName:  "Defaults",
+			expParams: &ListParams{Limit: DefaultLimit, Sort: SortByCreatedAt, Direction: DirectionAsc},
+			expErr:    nil,
+		}, {
+			testName:  "With cursor",
+			limit:     5,
+			cursor:    nameCursor.Encode(),
+			sort:      "name",
+			direction: "desc",
+			name:      "proj",
+			expParams: &ListParams{Limit: 5, Sort: SortByName, Direction: DirectionDesc, Name: "proj", After: nameCursor},
+			expErr:    nil,
+		}, {
+			testName:  "Cursor from other sort",
+			cursor:    nameCursor.Encode(),
+			expParams: nil,
+			expErr:    ErrInvalidCursor,
+		}, {
+			testName:  "Malformed cursor",
+			cursor:    "%%%",
+			expParams: nil,
+			expErr:    ErrInvalidCursor,
+		}, {
+			testName:  "Limit too big",
+			limit:     MaxLimit + 1,
+			expParams: nil,
+			expErr:    ErrInvalidLimit,
+		}, {
+			testName:  "Invalid sort",
+			sort:      "owner_id",
+			expParams: nil,
+			expErr:    ErrInvalidSort,
+		}, {
+			testName:  "Invalid direction",
+			direction: "up",
+			expParams: nil,
+			expErr:    ErrInvalidDirection,
+		}, {
+			testName:  "Name filter too long",
+			name:      strings.Repeat("a", 256),
+			expParams: nil,
+			expErr:    ErrInvalidFilter,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			params, err := NewListParams(tt.limit, tt.cursor, tt.sort, tt.direction, tt.name)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expParams, params)
+		})
+	}
+}
+
+func TestCursor_EncodeDecode(t *testing.T) {
+	createdAt := time.Date(2024, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	c := NewCursor(SortByCreatedAt, DirectionAsc, 3, "ignored", createdAt)
+
+	decoded, err := DecodeCursor(c.Encode())
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), decoded.Id)
+	require.Equal(t, "", decoded.Name)
+	require.True(t, createdAt.Equal(decoded.CreatedAt))
+}
@@ -6,6 +6,7 @@ type ProjectDomain struct {
 	Id        uint32
 	OwnerId   uint32
 	Name      string
+	Version   uint32
 	CreatedAt time.Time
 }
 
@@ -23,15 +24,24 @@ func NewProjectDomain(ownerId uint32, name string) (*ProjectDomain, error) {
 	}, nil
 }
 
-func RestoreProjectDomain(id, ownerId uint32, name string, createdAt time.Time) *ProjectDomain {
+func RestoreProjectDomain(id, ownerId uint32, name string, version uint32, createdAt time.Time) *ProjectDomain {
 	return &ProjectDomain{
 		Id:        id,
 		OwnerId:   ownerId,
 		Name:      name,
+		Version:   version,
 		CreatedAt: createdAt,
 	}
 }
 
+func (p *ProjectDomain) Rename(name string) error {
+	if err := validateName(name); err != nil {
+		return err
+	}
+	p.Name = name
+	return nil
+}
+
 func validateOwnerId(ownerId uint32) error {
 	if ownerId == 0 {
 		return ErrInvalidOwnerId
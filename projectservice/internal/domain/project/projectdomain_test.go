@@ -47,3 +47,33 @@ func TestProjectDomain(t *testing.T) {
 		})
 	}
 }
+
+func TestProjectDomain_Rename(t *testing.T) {
+	tests := []struct {
+		testName string
+		name     string
+		expName  string
+		expErr   error
+	}{
+		{
+			testName: "Success",
+			name:     "new",
+			expName:  "new",
+			expErr:   nil,
+		}, {
+			testName: "Invalid name",
+			name:     strings.Repeat("name", 300),
+			expName:  "old",
+			expErr:   ErrInvalidName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			project := &ProjectDomain{Id: 1, OwnerId: 1, Name: "old"}
+			err := project.Rename(tt.name)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expName, project.Name)
+		})
+	}
+}
@@ -1,16 +1,20 @@
 package posmapper
 
 import (
+	memberdomain "projectservice/internal/domain/member"
 	projectdomain "projectservice/internal/domain/project"
 	posmodels "projectservice/internal/infrastructure/postgres/models"
 )
 
 func DomainToModel(pd *projectdomain.ProjectDomain) *posmodels.ProjectPosModel {
-	return posmodels.NewProjectPosModel(pd.OwnerId, pd.Name)
+	pm := posmodels.NewProjectPosModel(pd.OwnerId, pd.Name)
+	pm.Id = pd.Id
+	pm.Version = pd.Version
+	return pm
 }
 
 func ModelToDomain(pm *posmodels.ProjectPosModel) *projectdomain.ProjectDomain {
-	return projectdomain.RestoreProjectDomain(pm.Id, pm.OwnerId, pm.Name, pm.CreatedAt)
+	return projectdomain.RestoreProjectDomain(pm.Id, pm.OwnerId, pm.Name, pm.Version, pm.CreatedAt)
 }
 
 func ModelsToDomain(pm []*posmodels.ProjectPosModel) []*projectdomain.ProjectDomain {
@@ -21,3 +25,15 @@ func ModelsToDomain(pm []*posmodels.ProjectPosModel) []*projectdomain.ProjectDom
 	}
 	return projects
 }
+
+func MemberModelToDomain(mm *posmodels.MemberPosModel) *memberdomain.MemberDomain {
+	return memberdomain.RestoreMemberDomain(mm.ProjectId, mm.UserId, memberdomain.Role(mm.Role), mm.CreatedAt)
+}
+
+func MemberModelsToDomain(mm []*posmodels.MemberPosModel) []*memberdomain.MemberDomain {
+	var members []*memberdomain.MemberDomain
+	for _, val := range mm {
+		members = append(members, MemberModelToDomain(val))
+	}
+	return members
+}
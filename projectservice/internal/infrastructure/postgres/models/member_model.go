@@ -0,0 +1,10 @@
+package posmodels
+
+import "time"
+
+type MemberPosModel struct {
+	ProjectId uint32    `db:"project_id"`
+	UserId    uint32    `db:"user_id"`
+	Role      string    `db:"role"`
+	CreatedAt time.Time `db:"created_at"`
+}
@@ -6,6 +6,7 @@ type ProjectPosModel struct {
 	Id        uint32    `db:"id"`
 	OwnerId   uint32    `db:"owner_id"`
 	Name      string    `db:"name"`
+	Version   uint32    `db:"version"`
 	CreatedAt time.Time `db:"created_at"`
 }
 
@@ -3,10 +3,16 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
+	eventdomain "projectservice/internal/domain/event"
+	memberdomain "projectservice/internal/domain/member"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
 	posmapper "projectservice/internal/infrastructure/postgres/mapper"
 	posmodels "projectservice/internal/infrastructure/postgres/models"
+	"projectservice/internal/repository/member"
 	"projectservice/internal/repository/storage"
+	"time"
 
 	"github.com/lib/pq"
 )
@@ -28,14 +34,18 @@ func NewPostgres(db *sql.DB) *Postgres {
 func (p *Postgres) Save(ctx context.Context, proj *projectdomain.ProjectDomain) (uint32, error) {
 	pm := posmapper.DomainToModel(proj)
 
-	row := p.db.QueryRowContext(ctx, QuerieSave, pm.OwnerId, pm.Name)
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return invalidId, err
+	}
+	defer tx.Rollback()
 
-	var id uint32
+	row := tx.QueryRowContext(ctx, QuerieSave, pm.OwnerId, pm.Name)
 
-	err := row.Scan(
+	var id uint32
+	err = row.Scan(
 		&id,
 	)
-
 	if err != nil {
 		if pgErr, ok := err.(*pq.Error); ok {
 			if pgErr.Code == "23505" {
@@ -45,11 +55,25 @@ func (p *Postgres) Save(ctx context.Context, proj *projectdomain.ProjectDomain)
 		return invalidId, err
 	}
 
+	if _, err := tx.ExecContext(ctx, QuerieAddMember, id, pm.OwnerId, memberdomain.RoleOwner); err != nil {
+		return invalidId, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return invalidId, err
+	}
+
 	return id, nil
 }
 
 func (p *Postgres) Delete(ctx context.Context, ownerId uint32, projectId uint32) error {
-	res, err := p.db.ExecContext(ctx, QuerieDelete, projectId, ownerId)
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, QuerieDelete, projectId, ownerId)
 	if err != nil {
 		return err
 	}
@@ -62,14 +86,26 @@ func (p *Postgres) Delete(ctx context.Context, ownerId uint32, projectId uint32)
 		return storage.ErrNotFound
 	}
 
-	return nil
+	event, err := eventdomain.NewProjectDeletedEvent(projectId, ownerId)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, QuerieInsertOutbox, event.Type, event.Payload); err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
-func (p *Postgres) GetAll(ctx context.Context, ownerId uint32) ([]*projectdomain.ProjectDomain, error) {
-	rows, err := p.db.QueryContext(ctx, QuerieGetAll, ownerId)
+func (p *Postgres) GetAll(ctx context.Context, userId uint32, params *pagedomain.ListParams) ([]*projectdomain.ProjectDomain, *pagedomain.Cursor, error) {
+	query, args := buildGetAllQuery(userId, params)
+
+	rows, err := p.db.QueryContext(ctx, query, args...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
+	defer rows.Close()
 
 	var projects []*posmodels.ProjectPosModel
 	for rows.Next() {
@@ -79,21 +115,219 @@ func (p *Postgres) GetAll(ctx context.Context, ownerId uint32) ([]*projectdomain
 			&project.Id,
 			&project.OwnerId,
 			&project.Name,
+			&project.Version,
 			&project.CreatedAt,
 		)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 
 		projects = append(projects, project)
 	}
 
 	if err := rows.Err(); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if len(projects) == 0 {
-		return nil, storage.ErrNotFound
+		return nil, nil, storage.ErrNotFound
 	}
 
-	return posmapper.ModelsToDomain(projects), nil
+	var next *pagedomain.Cursor
+	if uint32(len(projects)) > params.Limit {
+		projects = projects[:params.Limit]
+		last := projects[len(projects)-1]
+		next = pagedomain.NewCursor(params.Sort, params.Direction, last.Id, last.Name, last.CreatedAt)
+	}
+
+	return posmapper.ModelsToDomain(projects), next, nil
+}
+
+func (p *Postgres) GetById(ctx context.Context, projectId uint32) (*projectdomain.ProjectDomain, error) {
+	row := p.db.QueryRowContext(ctx, QuerieGetById, projectId)
+
+	project := &posmodels.ProjectPosModel{}
+
+	err := row.Scan(
+		&project.Id,
+		&project.OwnerId,
+		&project.Name,
+		&project.Version,
+		&project.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return posmapper.ModelToDomain(project), nil
+}
+
+func (p *Postgres) Update(ctx context.Context, proj *projectdomain.ProjectDomain) (uint32, error) {
+	pm := posmapper.DomainToModel(proj)
+
+	row := p.db.QueryRowContext(ctx, QuerieUpdate, pm.Id, pm.Version, pm.Name)
+
+	var version uint32
+	err := row.Scan(
+		&version,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, storage.ErrVersionConflict
+		}
+		if pgErr, ok := err.(*pq.Error); ok {
+			if pgErr.Code == "23505" {
+				return 0, storage.ErrAlreadyExists
+			}
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (p *Postgres) AddMember(ctx context.Context, m *memberdomain.MemberDomain) error {
+	_, err := p.db.ExecContext(ctx, QuerieAddMember, m.ProjectId, m.UserId, m.Role)
+	if err != nil {
+		if pgErr, ok := err.(*pq.Error); ok {
+			if pgErr.Code == "23505" {
+				return member.ErrAlreadyExists
+			}
+			if pgErr.Code == "23503" {
+				return member.ErrNotFound
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+func (p *Postgres) GetMember(ctx context.Context, projectId, userId uint32) (*memberdomain.MemberDomain, error) {
+	row := p.db.QueryRowContext(ctx, QuerieGetMember, projectId, userId)
+
+	m := &posmodels.MemberPosModel{}
+	err := row.Scan(
+		&m.ProjectId,
+		&m.UserId,
+		&m.Role,
+		&m.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, member.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return posmapper.MemberModelToDomain(m), nil
+}
+
+func (p *Postgres) GetMembers(ctx context.Context, projectId uint32) ([]*memberdomain.MemberDomain, error) {
+	rows, err := p.db.QueryContext(ctx, QuerieGetMembers, projectId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var members []*posmodels.MemberPosModel
+	for rows.Next() {
+		m := &posmodels.MemberPosModel{}
+		err := rows.Scan(
+			&m.ProjectId,
+			&m.UserId,
+			&m.Role,
+			&m.CreatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(members) == 0 {
+		return nil, member.ErrNotFound
+	}
+
+	return posmapper.MemberModelsToDomain(members), nil
+}
+
+func (p *Postgres) UpdateMemberRole(ctx context.Context, projectId, userId uint32, role memberdomain.Role) error {
+	res, err := p.db.ExecContext(ctx, QuerieUpdateMemberRole, projectId, userId, role)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return member.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *Postgres) DeleteMember(ctx context.Context, projectId, userId uint32) error {
+	res, err := p.db.ExecContext(ctx, QuerieDeleteMember, projectId, userId)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return member.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *Postgres) FetchUnpublished(ctx context.Context, limit uint32) ([]*eventdomain.Event, error) {
+	rows, err := p.db.QueryContext(ctx, QuerieFetchUnpublished, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*eventdomain.Event
+	for rows.Next() {
+		var (
+			id        uint64
+			eventType string
+			payload   []byte
+			createdAt time.Time
+		)
+
+		err := rows.Scan(
+			&id,
+			&eventType,
+			&payload,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, eventdomain.RestoreEvent(id, eventType, payload, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
+
+func (p *Postgres) MarkPublished(ctx context.Context, eventId uint64) error {
+	_, err := p.db.ExecContext(ctx, QuerieMarkPublished, eventId)
+	return err
 }
@@ -2,7 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql/driver"
+	eventdomain "projectservice/internal/domain/event"
+	memberdomain "projectservice/internal/domain/member"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
+	"projectservice/internal/repository/member"
 	"projectservice/internal/repository/storage"
 	"regexp"
 	"testing"
@@ -51,15 +56,25 @@ func TestPostgres_Save(t *testing.T) {
 			require.NoError(t, err)
 			defer db.Close()
 
+			mock.ExpectBegin()
 			mock.ExpectQuery(regexp.QuoteMeta(QuerieSave)).
 				WithArgs(tt.proj.OwnerId, tt.proj.Name).
 				WillReturnError(tt.returnErr).
 				WillReturnRows(&tt.returnRows)
+			if tt.expErr == nil {
+				mock.ExpectExec(regexp.QuoteMeta(QuerieAddMember)).
+					WithArgs(tt.expId, tt.proj.OwnerId, "owner").
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
 
 			postgres := NewPostgres(db)
 			id, err := postgres.Save(context.Background(), tt.proj)
 			require.Equal(t, tt.expErr, err)
 			require.Equal(t, tt.expId, id)
+			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
@@ -73,25 +88,33 @@ func TestPostgres_Delete(t *testing.T) {
 		rowAffected int64
 		returnErr   error
 
+		expOutbox  bool
+		expPayload string
+
 		expErr error
 	}{
 		{
 			testName: "Success",
 
 			ownerId:     1,
-			projectId:   1,
+			projectId:   2,
 			rowAffected: 1,
 			returnErr:   nil,
 
+			expOutbox:  true,
+			expPayload: `{"project_id":2,"owner_id":1}`,
+
 			expErr: nil,
 		}, {
 			testName: "Not found",
 
 			ownerId:     1,
-			projectId:   1,
+			projectId:   2,
 			rowAffected: 0,
 			returnErr:   nil,
 
+			expOutbox: false,
+
 			expErr: storage.ErrNotFound,
 		},
 	}
@@ -102,14 +125,24 @@ func TestPostgres_Delete(t *testing.T) {
 			require.NoError(t, err)
 			defer db.Close()
 
+			mock.ExpectBegin()
 			mock.ExpectExec(regexp.QuoteMeta(QuerieDelete)).
 				WithArgs(tt.projectId, tt.ownerId).
 				WillReturnResult(sqlmock.NewResult(1, tt.rowAffected)).
 				WillReturnError(tt.returnErr)
+			if tt.expOutbox {
+				mock.ExpectExec(regexp.QuoteMeta(QuerieInsertOutbox)).
+					WithArgs(eventdomain.ProjectDeletedType, []byte(tt.expPayload)).
+					WillReturnResult(sqlmock.NewResult(1, 1))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
 
 			postgres := NewPostgres(db)
-			err = postgres.Delete(context.Background(), tt.projectId, tt.ownerId)
+			err = postgres.Delete(context.Background(), tt.ownerId, tt.projectId)
 			require.Equal(t, tt.expErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
 		})
 	}
 }
@@ -117,61 +150,85 @@ func TestPostgres_Delete(t *testing.T) {
 func TestPostgres_GetAll(t *testing.T) {
 	timeNow := time.Now()
 
+	defaultParams := &pagedomain.ListParams{Limit: 20, Sort: pagedomain.SortByCreatedAt, Direction: pagedomain.DirectionAsc}
+
 	tests := []struct {
 		testName   string
 		ownerId    uint32
+		params     *pagedomain.ListParams
+		expQuery   string
+		expArgs    []driver.Value
 		returnRows *sqlmock.Rows
 		returnErr  error
 		expOutput  []*projectdomain.ProjectDomain
+		expNext    *pagedomain.Cursor
 		expErr     error
 	}{
 		{
 			testName: "Success",
 			ownerId:  1,
+			params:   defaultParams,
+			expQuery: QuerieGetAll + " ORDER BY p.created_at ASC, p.id ASC LIMIT $2",
+			expArgs:  []driver.Value{1, 21},
 			returnRows: sqlmock.NewRows([]string{
-				"id", "owner_id", "name", "created_at",
-			}).AddRow(1, 1, "A", timeNow),
+				"id", "owner_id", "name", "version", "created_at",
+			}).AddRow(1, 1, "A", 1, timeNow),
 			returnErr: nil,
 			expOutput: []*projectdomain.ProjectDomain{
-				{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
+				{Id: 1, OwnerId: 1, Name: "A", Version: 1, CreatedAt: timeNow},
 			},
-			expErr: nil,
+			expNext: nil,
+			expErr:  nil,
 		}, {
-			testName: "More returned projects",
+			testName: "Has next page",
 			ownerId:  1,
+			params:   &pagedomain.ListParams{Limit: 2, Sort: pagedomain.SortByName, Direction: pagedomain.DirectionAsc},
+			expQuery: QuerieGetAll + " ORDER BY p.name ASC, p.id ASC LIMIT $2",
+			expArgs:  []driver.Value{1, 3},
 			returnRows: sqlmock.NewRows([]string{
-				"id", "owner_id", "name", "created_at",
-			}).AddRow(1, 1, "A", timeNow).
-				AddRow(2, 1, "B", timeNow).
-				AddRow(3, 1, "C", timeNow).
-				AddRow(4, 1, "D", timeNow).
-				AddRow(5, 1, "E", timeNow).
-				AddRow(6, 1, "F", timeNow).
-				AddRow(7, 1, "G", timeNow).
-				AddRow(8, 1, "H", timeNow).
-				AddRow(9, 1, "I", timeNow).
-				AddRow(10, 1, "J", timeNow),
+				"id", "owner_id", "name", "version", "created_at",
+			}).AddRow(1, 1, "A", 1, timeNow).
+				AddRow(2, 1, "B", 1, timeNow).
+				AddRow(3, 1, "C", 1, timeNow),
 			returnErr: nil,
 			expOutput: []*projectdomain.ProjectDomain{
-				{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
-				{Id: 2, OwnerId: 1, Name: "B", CreatedAt: timeNow},
-				{Id: 3, OwnerId: 1, Name: "C", CreatedAt: timeNow},
-				{Id: 4, OwnerId: 1, Name: "D", CreatedAt: timeNow},
-				{Id: 5, OwnerId: 1, Name: "E", CreatedAt: timeNow},
-				{Id: 6, OwnerId: 1, Name: "F", CreatedAt: timeNow},
-				{Id: 7, OwnerId: 1, Name: "G", CreatedAt: timeNow},
-				{Id: 8, OwnerId: 1, Name: "H", CreatedAt: timeNow},
-				{Id: 9, OwnerId: 1, Name: "I", CreatedAt: timeNow},
-				{Id: 10, OwnerId: 1, Name: "J", CreatedAt: timeNow},
+				{Id: 1, OwnerId: 1, Name: "A", Version: 1, CreatedAt: timeNow},
+				{Id: 2, OwnerId: 1, Name: "B", Version: 1, CreatedAt: timeNow},
 			},
-			expErr: nil,
+			expNext: pagedomain.NewCursor(pagedomain.SortByName, pagedomain.DirectionAsc, 2, "B", timeNow),
+			expErr:  nil,
+		}, {
+			testName: "After cursor with filter",
+			ownerId:  1,
+			params: &pagedomain.ListParams{
+				Limit:     2,
+				Sort:      pagedomain.SortByName,
+				Direction: pagedomain.DirectionDesc,
+				Name:      "a_b%",
+				After:     pagedomain.NewCursor(pagedomain.SortByName, pagedomain.DirectionDesc, 5, "X", timeNow),
+			},
+			expQuery: QuerieGetAll + " AND p.name ILIKE $2 AND (p.name, p.id) < ($3, $4) ORDER BY p.name DESC, p.id DESC LIMIT $5",
+			expArgs:  []driver.Value{1, `%a\_b\%%`, "X", 5, 3},
+			returnRows: sqlmock.NewRows([]string{
+				"id", "owner_id", "name", "version", "created_at",
+			}).AddRow(4, 1, "a_b%", 1, timeNow),
+			returnErr: nil,
+			expOutput: []*projectdomain.ProjectDomain{
+				{Id: 4, OwnerId: 1, Name: "a_b%", Version: 1, CreatedAt: timeNow},
+			},
+			expNext: nil,
+			expErr:  nil,
 		}, {
 			testName: "Not found",
 			ownerId:  1,
+			params:   defaultParams,
+			expQuery: QuerieGetAll + " ORDER BY p.created_at ASC, p.id ASC LIMIT $2",
+			expArgs:  []driver.Value{1, 21},
 			returnRows: sqlmock.NewRows([]string{
-				"id", "owner_id", "name", "created_at",
+				"id", "owner_id", "name", "version", "created_at",
 			}),
 			expOutput: nil,
+			expNext:   nil,
 			expErr:    storage.ErrNotFound,
 		},
 	}
@@ -182,16 +239,378 @@ func TestPostgres_GetAll(t *testing.T) {
 			require.NoError(t, err)
 			defer db.Close()
 
-			mock.ExpectQuery(regexp.QuoteMeta(QuerieGetAll)).
-				WithArgs(tt.ownerId).
+			mock.ExpectQuery(regexp.QuoteMeta(tt.expQuery) + "$").
+				WithArgs(tt.expArgs...).
 				WillReturnRows(tt.returnRows).
 				WillReturnError(tt.returnErr)
 
 			postgres := NewPostgres(db)
 
-			projects, err := postgres.GetAll(context.Background(), tt.ownerId)
+			projects, next, err := postgres.GetAll(context.Background(), tt.ownerId, tt.params)
 			require.Equal(t, tt.expErr, err)
 			require.Equal(t, tt.expOutput, projects)
+			require.Equal(t, tt.expNext, next)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestPostgres_GetById(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName   string
+		projectId  uint32
+		returnRows *sqlmock.Rows
+		expOutput  *projectdomain.ProjectDomain
+		expErr     error
+	}{
+		{
+			testName:  "Success",
+			projectId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "owner_id", "name", "version", "created_at",
+			}).AddRow(1, 2, "A", 1, timeNow),
+			expOutput: &projectdomain.ProjectDomain{Id: 1, OwnerId: 2, Name: "A", Version: 1, CreatedAt: timeNow},
+			expErr:    nil,
+		}, {
+			testName:  "Not found",
+			projectId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "owner_id", "name", "version", "created_at",
+			}),
+			expOutput: nil,
+			expErr:    storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieGetById)).
+				WithArgs(tt.projectId).
+				WillReturnRows(tt.returnRows)
+
+			postgres := NewPostgres(db)
+
+			project, err := postgres.GetById(context.Background(), tt.projectId)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, project)
+		})
+	}
+}
+
+func TestPostgres_Update(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		proj *projectdomain.ProjectDomain
+
+		returnRows *sqlmock.Rows
+		returnErr  error
+
+		expVersion uint32
+		expErr     error
+	}{
+		{
+			testName: "Success",
+
+			proj: &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "New", Version: 3},
+
+			returnRows: sqlmock.NewRows([]string{"version"}).AddRow(4),
+			returnErr:  nil,
+
+			expVersion: 4,
+			expErr:     nil,
+		}, {
+			testName: "Version conflict",
+
+			proj: &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "New", Version: 3},
+
+			returnRows: sqlmock.NewRows([]string{"version"}),
+			returnErr:  nil,
+
+			expVersion: 0,
+			expErr:     storage.ErrVersionConflict,
+		}, {
+			testName: "Already exists",
+
+			proj: &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "New", Version: 3},
+
+			returnRows: sqlmock.NewRows([]string{"version"}),
+			returnErr:  &pq.Error{Code: "23505"},
+
+			expVersion: 0,
+			expErr:     storage.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieUpdate)).
+				WithArgs(tt.proj.Id, tt.proj.Version, tt.proj.Name).
+				WillReturnRows(tt.returnRows).
+				WillReturnError(tt.returnErr)
+
+			postgres := NewPostgres(db)
+
+			version, err := postgres.Update(context.Background(), tt.proj)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expVersion, version)
+		})
+	}
+}
+
+func TestPostgres_AddMember(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		member *memberdomain.MemberDomain
+
+		returnErr error
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			member: &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleMember},
+
+			returnErr: nil,
+			expErr:    nil,
+		}, {
+			testName: "Already exists",
+
+			member: &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleMember},
+
+			returnErr: &pq.Error{Code: "23505"},
+			expErr:    member.ErrAlreadyExists,
+		}, {
+			testName: "Project not found",
+
+			member: &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleMember},
+
+			returnErr: &pq.Error{Code: "23503"},
+			expErr:    member.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QuerieAddMember)).
+				WithArgs(tt.member.ProjectId, tt.member.UserId, string(tt.member.Role)).
+				WillReturnResult(sqlmock.NewResult(1, 1)).
+				WillReturnError(tt.returnErr)
+
+			postgres := NewPostgres(db)
+
+			err = postgres.AddMember(context.Background(), tt.member)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestPostgres_GetMember(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName   string
+		projectId  uint32
+		userId     uint32
+		returnRows *sqlmock.Rows
+		expOutput  *memberdomain.MemberDomain
+		expErr     error
+	}{
+		{
+			testName:  "Success",
+			projectId: 1,
+			userId:    2,
+			returnRows: sqlmock.NewRows([]string{
+				"project_id", "user_id", "role", "created_at",
+			}).AddRow(1, 2, "admin", timeNow),
+			expOutput: &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleAdmin, CreatedAt: timeNow},
+			expErr:    nil,
+		}, {
+			testName:  "Not found",
+			projectId: 1,
+			userId:    2,
+			returnRows: sqlmock.NewRows([]string{
+				"project_id", "user_id", "role", "created_at",
+			}),
+			expOutput: nil,
+			expErr:    member.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieGetMember)).
+				WithArgs(tt.projectId, tt.userId).
+				WillReturnRows(tt.returnRows)
+
+			postgres := NewPostgres(db)
+
+			m, err := postgres.GetMember(context.Background(), tt.projectId, tt.userId)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, m)
+		})
+	}
+}
+
+func TestPostgres_GetMembers(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName   string
+		projectId  uint32
+		returnRows *sqlmock.Rows
+		expOutput  []*memberdomain.MemberDomain
+		expErr     error
+	}{
+		{
+			testName:  "Success",
+			projectId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"project_id", "user_id", "role", "created_at",
+			}).AddRow(1, 1, "owner", timeNow).
+				AddRow(1, 2, "viewer", timeNow),
+			expOutput: []*memberdomain.MemberDomain{
+				{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner, CreatedAt: timeNow},
+				{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer, CreatedAt: timeNow},
+			},
+			expErr: nil,
+		}, {
+			testName:  "Not found",
+			projectId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"project_id", "user_id", "role", "created_at",
+			}),
+			expOutput: nil,
+			expErr:    member.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieGetMembers)).
+				WithArgs(tt.projectId).
+				WillReturnRows(tt.returnRows)
+
+			postgres := NewPostgres(db)
+
+			members, err := postgres.GetMembers(context.Background(), tt.projectId)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, members)
 		})
 	}
 }
+
+func TestPostgres_UpdateMemberRole(t *testing.T) {
+	tests := []struct {
+		testName    string
+		rowAffected int64
+		expErr      error
+	}{
+		{
+			testName:    "Success",
+			rowAffected: 1,
+			expErr:      nil,
+		}, {
+			testName:    "Not found",
+			rowAffected: 0,
+			expErr:      member.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QuerieUpdateMemberRole)).
+				WithArgs(uint32(1), uint32(2), "admin").
+				WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+
+			postgres := NewPostgres(db)
+
+			err = postgres.UpdateMemberRole(context.Background(), 1, 2, memberdomain.RoleAdmin)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestPostgres_DeleteMember(t *testing.T) {
+	tests := []struct {
+		testName    string
+		rowAffected int64
+		expErr      error
+	}{
+		{
+			testName:    "Success",
+			rowAffected: 1,
+			expErr:      nil,
+		}, {
+			testName:    "Not found",
+			rowAffected: 0,
+			expErr:      member.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QuerieDeleteMember)).
+				WithArgs(uint32(1), uint32(2)).
+				WillReturnResult(sqlmock.NewResult(1, tt.rowAffected))
+
+			postgres := NewPostgres(db)
+
+			err = postgres.DeleteMember(context.Background(), 1, 2)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestPostgres_FetchUnpublished(t *testing.T) {
+	timeNow := time.Now()
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerieFetchUnpublished)).
+		WithArgs(uint32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "event_type", "payload", "created_at",
+		}).AddRow(1, eventdomain.ProjectDeletedType, []byte(`{"project_id":1,"owner_id":1}`), timeNow))
+
+	postgres := NewPostgres(db)
+
+	events, err := postgres.FetchUnpublished(context.Background(), 10)
+	require.NoError(t, err)
+	require.Equal(t, []*eventdomain.Event{
+		{Id: 1, Type: eventdomain.ProjectDeletedType, Payload: []byte(`{"project_id":1,"owner_id":1}`), CreatedAt: timeNow},
+	}, events)
+}
@@ -1,7 +1,63 @@
 package postgres
 
+import (
+	"fmt"
+	pagedomain "projectservice/internal/domain/page"
+	"strings"
+)
+
 var (
-	QuerieSave   = "INSERT INTO projects(owner_id, name) VALUES($1, $2) RETURNING id"
-	QuerieDelete = "DELETE FROM projects WHERE id = $1 AND owner_id = $2"
-	QuerieGetAll = "SELECT id, owner_id, name, created_at FROM projects WHERE owner_id = $1"
+	QuerieSave    = "INSERT INTO projects(owner_id, name) VALUES($1, $2) RETURNING id"
+	QuerieDelete  = "DELETE FROM projects WHERE id = $1 AND owner_id = $2"
+	QuerieGetAll  = "SELECT p.id, p.owner_id, p.name, p.version, p.created_at FROM projects p JOIN project_members m ON m.project_id = p.id WHERE m.user_id = $1"
+	QuerieGetById = "SELECT id, owner_id, name, version, created_at FROM projects WHERE id = $1"
+	QuerieUpdate  = "UPDATE projects SET name = $3, version = version + 1 WHERE id = $1 AND version = $2 RETURNING version"
+
+	QuerieAddMember        = "INSERT INTO project_members(project_id, user_id, role) VALUES($1, $2, $3)"
+	QuerieGetMember        = "SELECT project_id, user_id, role, created_at FROM project_members WHERE project_id = $1 AND user_id = $2"
+	QuerieGetMembers       = "SELECT project_id, user_id, role, created_at FROM project_members WHERE project_id = $1 ORDER BY created_at, user_id"
+	QuerieUpdateMemberRole = "UPDATE project_members SET role = $3 WHERE project_id = $1 AND user_id = $2"
+	QuerieDeleteMember     = "DELETE FROM project_members WHERE project_id = $1 AND user_id = $2"
+
+	QuerieInsertOutbox     = "INSERT INTO outbox(event_type, payload) VALUES($1, $2)"
+	QuerieFetchUnpublished = "SELECT id, event_type, payload, created_at FROM outbox WHERE published_at IS NULL ORDER BY id LIMIT $1"
+	QuerieMarkPublished    = "UPDATE outbox SET published_at = now() WHERE id = $1"
 )
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func buildGetAllQuery(userId uint32, params *pagedomain.ListParams) (string, []any) {
+	var sb strings.Builder
+	sb.WriteString(QuerieGetAll)
+	args := []any{userId}
+
+	if params.Name != "" {
+		args = append(args, "%"+likeEscaper.Replace(params.Name)+"%")
+		fmt.Fprintf(&sb, " AND p.name ILIKE $%d", len(args))
+	}
+
+	column := "p.created_at"
+	if params.Sort == pagedomain.SortByName {
+		column = "p.name"
+	}
+
+	op, dir := ">", "ASC"
+	if params.Direction == pagedomain.DirectionDesc {
+		op, dir = "<", "DESC"
+	}
+
+	if c := params.After; c != nil {
+		if params.Sort == pagedomain.SortByName {
+			args = append(args, c.Name)
+		} else {
+			args = append(args, c.CreatedAt)
+		}
+		args = append(args, c.Id)
+		fmt.Fprintf(&sb, " AND (%s, p.id) %s ($%d, $%d)", column, op, len(args)-1, len(args))
+	}
+
+	args = append(args, params.Limit+1)
+	fmt.Fprintf(&sb, " ORDER BY %s %s, p.id %s LIMIT $%d", column, dir, dir, len(args))
+
+	return sb.String(), args
+}
@@ -0,0 +1,32 @@
+package myredis
+
+import (
+	"context"
+	eventdomain "projectservice/internal/domain/event"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisPublisher struct {
+	client *redis.Client
+	stream string
+}
+
+func NewRedisPublisher(client *redis.Client, stream string) *RedisPublisher {
+	return &RedisPublisher{
+		client: client,
+		stream: stream,
+	}
+}
+
+func (r *RedisPublisher) Publish(ctx context.Context, event *eventdomain.Event) error {
+	return r.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: r.stream,
+		Values: map[string]any{
+			"event_id":   strconv.FormatUint(event.Id, 10),
+			"event_type": event.Type,
+			"payload":    string(event.Payload),
+		},
+	}).Err()
+}
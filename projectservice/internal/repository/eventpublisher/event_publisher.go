@@ -0,0 +1,10 @@
+package eventpublisher
+
+import (
+	"context"
+	eventdomain "projectservice/internal/domain/event"
+)
+
+type EventPublisher interface {
+	Publish(ctx context.Context, event *eventdomain.Event) error
+}
@@ -0,0 +1,8 @@
+package member
+
+import "errors"
+
+var (
+	ErrAlreadyExists = errors.New("member already exists")
+	ErrNotFound      = errors.New("member not found")
+)
@@ -0,0 +1,14 @@
+package member
+
+import (
+	"context"
+	memberdomain "projectservice/internal/domain/member"
+)
+
+type MemberRepo interface {
+	AddMember(ctx context.Context, m *memberdomain.MemberDomain) error
+	GetMember(ctx context.Context, projectId, userId uint32) (*memberdomain.MemberDomain, error)
+	GetMembers(ctx context.Context, projectId uint32) ([]*memberdomain.MemberDomain, error)
+	UpdateMemberRole(ctx context.Context, projectId, userId uint32, role memberdomain.Role) error
+	DeleteMember(ctx context.Context, projectId, userId uint32) error
+}
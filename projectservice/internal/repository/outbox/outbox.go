@@ -0,0 +1,11 @@
+package outbox
+
+import (
+	"context"
+	eventdomain "projectservice/internal/domain/event"
+)
+
+type OutboxRepo interface {
+	FetchUnpublished(ctx context.Context, limit uint32) ([]*eventdomain.Event, error)
+	MarkPublished(ctx context.Context, eventId uint64) error
+}
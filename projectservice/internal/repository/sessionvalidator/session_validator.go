@@ -1,7 +0,0 @@
-package sessionalidator
-
-import "context"
-
-type SessionValidator interface {
-	GetIdBySession(ctx context.Context, sessionId string) (uint32, error)
-}
@@ -3,6 +3,7 @@ package storage
 import "errors"
 
 var (
-	ErrAlreadyExists = errors.New("entry alredy exists")
-	ErrNotFound      = errors.New("entry not found")
+	ErrAlreadyExists   = errors.New("entry alredy exists")
+	ErrNotFound        = errors.New("entry not found")
+	ErrVersionConflict = errors.New("entry version conflict")
 )
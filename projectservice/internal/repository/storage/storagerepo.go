@@ -2,11 +2,14 @@ package storage
 
 import (
 	"context"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
 )
 
 type StorageRepo interface {
 	Save(ctx context.Context, proj *projectdomain.ProjectDomain) (uint32, error)
 	Delete(ctx context.Context, ownerId uint32, projectId uint32) error
-	GetAll(ctx context.Context, ownerId uint32) ([]*projectdomain.ProjectDomain, error)
+	GetAll(ctx context.Context, userId uint32, params *pagedomain.ListParams) ([]*projectdomain.ProjectDomain, *pagedomain.Cursor, error)
+	GetById(ctx context.Context, projectId uint32) (*projectdomain.ProjectDomain, error)
+	Update(ctx context.Context, proj *projectdomain.ProjectDomain) (uint32, error)
 }
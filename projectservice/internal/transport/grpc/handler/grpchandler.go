@@ -0,0 +1,142 @@
+package grpchandler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	projectservicev1 "platform/proto/projectservice"
+	pagedomain "projectservice/internal/domain/page"
+	projectdomain "projectservice/internal/domain/project"
+	grpcmapper "projectservice/internal/transport/grpc/handler/mapper"
+	checkaccesserr "projectservice/internal/usecase/error/checkaccess"
+	getallerr "projectservice/internal/usecase/error/getallprojects"
+	geterr "projectservice/internal/usecase/error/getproject"
+	"projectservice/internal/usecase/interfaces"
+	checkaccessmodel "projectservice/internal/usecase/models/checkaccess"
+	getallmodel "projectservice/internal/usecase/models/getallprojects"
+	getmodel "projectservice/internal/usecase/models/getproject"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type GRPCHandler struct {
+	log *slog.Logger
+	projectservicev1.UnimplementedProjectServiceServer
+
+	getProjUC     interfaces.GetProjectUsecase
+	getAllProjUC  interfaces.GetAllProjectsUsecase
+	checkAccessUC interfaces.CheckAccessUsecase
+}
+
+func NewGRPCHandler(
+	log *slog.Logger,
+	getProjUC interfaces.GetProjectUsecase,
+	getAllProjUC interfaces.GetAllProjectsUsecase,
+	checkAccessUC interfaces.CheckAccessUsecase,
+) *GRPCHandler {
+	return &GRPCHandler{
+		log:           log,
+		getProjUC:     getProjUC,
+		getAllProjUC:  getAllProjUC,
+		checkAccessUC: checkAccessUC,
+	}
+}
+
+func (g *GRPCHandler) GetProject(ctx context.Context, req *projectservicev1.GetProjectRequest) (*projectservicev1.GetProjectResponse, error) {
+	const op = "grpchandler.GetProject"
+	log := g.log.With(slog.String("op", op), slog.Int("projectId", int(req.ProjectId)))
+
+	log.InfoContext(ctx, "start get project request")
+
+	in := getmodel.NewGetProjectInput(req.ProjectId)
+
+	out, err := g.getProjUC.Execute(ctx, in)
+	if err != nil {
+		if errors.Is(err, geterr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if errors.Is(err, geterr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		log.WarnContext(ctx, "failed to get project", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	log.InfoContext(ctx, "get project request completed successfully")
+
+	return &projectservicev1.GetProjectResponse{
+		Project: grpcmapper.ProjectDomainToProto(out.Project),
+	}, nil
+}
+
+func (g *GRPCHandler) ListProjectsByOwner(ctx context.Context, req *projectservicev1.ListProjectsByOwnerRequest) (*projectservicev1.ListProjectsByOwnerResponse, error) {
+	const op = "grpchandler.ListProjectsByOwner"
+	log := g.log.With(slog.String("op", op), slog.Int("ownerId", int(req.OwnerId)))
+
+	log.InfoContext(ctx, "start list projects by owner request")
+
+	if req.OwnerId == 0 {
+		log.InfoContext(ctx, "invalid owner id")
+		return nil, status.Error(codes.InvalidArgument, "invalid owner id")
+	}
+
+	owned := make([]*projectdomain.ProjectDomain, 0)
+	cursor := ""
+	for {
+		in := getallmodel.NewGetAllProjectsInput(req.OwnerId, pagedomain.MaxLimit, cursor, "", "", "")
+
+		out, err := g.getAllProjUC.Execute(ctx, in)
+		if err != nil && !errors.Is(err, getallerr.ErrProjectsNotFound) {
+			log.WarnContext(ctx, "failed to list projects", slog.String("error", err.Error()))
+			return nil, status.Error(codes.Internal, "internal server error")
+		}
+
+		for _, project := range out.Projects {
+			if project.OwnerId == req.OwnerId {
+				owned = append(owned, project)
+			}
+		}
+
+		if out.NextCursor == "" {
+			break
+		}
+		cursor = out.NextCursor
+	}
+
+	log.InfoContext(ctx, "list projects by owner request completed successfully")
+
+	return &projectservicev1.ListProjectsByOwnerResponse{
+		Projects: grpcmapper.ProjectDomainsToProto(owned),
+	}, nil
+}
+
+func (g *GRPCHandler) CheckAccess(ctx context.Context, req *projectservicev1.CheckAccessRequest) (*projectservicev1.CheckAccessResponse, error) {
+	const op = "grpchandler.CheckAccess"
+	log := g.log.With(slog.String("op", op), slog.Int("userId", int(req.UserId)), slog.Int("projectId", int(req.ProjectId)))
+
+	log.InfoContext(ctx, "start check access request")
+
+	in := checkaccessmodel.NewCheckAccessInput(req.UserId, req.ProjectId)
+
+	out, err := g.checkAccessUC.Execute(ctx, in)
+	if err != nil {
+		if errors.Is(err, checkaccesserr.ErrInvalidUserId) || errors.Is(err, checkaccesserr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid argument", slog.String("error", err.Error()))
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		} else if errors.Is(err, checkaccesserr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, status.Error(codes.NotFound, "project not found")
+		}
+		log.WarnContext(ctx, "failed to check access", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	log.InfoContext(ctx, "check access request completed successfully")
+
+	return &projectservicev1.CheckAccessResponse{
+		HasAccess: out.HasAccess,
+		Role:      out.Role,
+	}, nil
+}
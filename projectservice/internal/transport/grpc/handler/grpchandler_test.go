@@ -0,0 +1,331 @@
+package grpchandler
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	projectservicev1 "platform/proto/projectservice"
+	pagedomain "projectservice/internal/domain/page"
+	projectdomain "projectservice/internal/domain/project"
+	grpchandlmocks "projectservice/internal/transport/grpc/handler/mocks"
+	checkaccesserr "projectservice/internal/usecase/error/checkaccess"
+	getallerr "projectservice/internal/usecase/error/getallprojects"
+	geterr "projectservice/internal/usecase/error/getproject"
+	checkaccessmodel "projectservice/internal/usecase/models/checkaccess"
+	getallmodel "projectservice/internal/usecase/models/getallprojects"
+	getmodel "projectservice/internal/usecase/models/getproject"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate mockgen -source=./../../../usecase/interfaces/check_access.go -destination=mocks/mock_check_access.go -package=grpchandlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/get_project.go -destination=mocks/mock_get_project.go -package=grpchandlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/get_all_projects.go -destination=mocks/mock_get_all_projects.go -package=grpchandlmocks
+
+func TestGRPCHandler_CheckAccess(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		handlReq *projectservicev1.CheckAccessRequest
+
+		checkInput  *checkaccessmodel.CheckAccessInput
+		checkOutput *checkaccessmodel.CheckAccessOutput
+		checkErr    error
+
+		expOutput *projectservicev1.CheckAccessResponse
+		expCode   codes.Code
+	}{
+		{
+			testName: "Has access",
+
+			handlReq: &projectservicev1.CheckAccessRequest{
+				UserId:    1,
+				ProjectId: 1,
+			},
+
+			checkInput:  checkaccessmodel.NewCheckAccessInput(1, 1),
+			checkOutput: checkaccessmodel.NewCheckAccessOutput(true, "viewer"),
+			checkErr:    nil,
+
+			expOutput: &projectservicev1.CheckAccessResponse{
+				HasAccess: true,
+				Role:      "viewer",
+			},
+			expCode: codes.OK,
+		}, {
+			testName: "No access",
+
+			handlReq: &projectservicev1.CheckAccessRequest{
+				UserId:    1,
+				ProjectId: 1,
+			},
+
+			checkInput:  checkaccessmodel.NewCheckAccessInput(1, 1),
+			checkOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+			checkErr:    nil,
+
+			expOutput: &projectservicev1.CheckAccessResponse{
+				HasAccess: false,
+			},
+			expCode: codes.OK,
+		}, {
+			testName: "Invalid project id",
+
+			handlReq: &projectservicev1.CheckAccessRequest{
+				UserId:    1,
+				ProjectId: 0,
+			},
+
+			checkInput:  checkaccessmodel.NewCheckAccessInput(1, 0),
+			checkOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+			checkErr:    checkaccesserr.ErrInvalidProjectId,
+
+			expOutput: nil,
+			expCode:   codes.InvalidArgument,
+		}, {
+			testName: "Project not found",
+
+			handlReq: &projectservicev1.CheckAccessRequest{
+				UserId:    1,
+				ProjectId: 1,
+			},
+
+			checkInput:  checkaccessmodel.NewCheckAccessInput(1, 1),
+			checkOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+			checkErr:    checkaccesserr.ErrProjectNotFound,
+
+			expOutput: nil,
+			expCode:   codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			checkAccessUCMock := grpchandlmocks.NewMockCheckAccessUsecase(ctrl)
+
+			checkAccessUCMock.EXPECT().Execute(gomock.Any(), tt.checkInput).
+				Return(tt.checkOutput, tt.checkErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			grpcHandl := NewGRPCHandler(log, nil, nil, checkAccessUCMock)
+			res, err := grpcHandl.CheckAccess(context.Background(), tt.handlReq)
+			require.Equal(t, tt.expCode, status.Code(err))
+			require.Equal(t, tt.expOutput, res)
+		})
+	}
+}
+
+func TestGRPCHandler_GetProject(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		handlReq *projectservicev1.GetProjectRequest
+
+		getInput  *getmodel.GetProjectInput
+		getOutput *getmodel.GetProjectOutput
+		getErr    error
+
+		expOutput *projectservicev1.GetProjectResponse
+		expCode   codes.Code
+	}{
+		{
+			testName: "Success",
+
+			handlReq: &projectservicev1.GetProjectRequest{
+				ProjectId: 1,
+			},
+
+			getInput:  getmodel.NewGetProjectInput(1),
+			getOutput: getmodel.NewGetProjectOutput(&projectdomain.ProjectDomain{Id: 1, OwnerId: 2, Name: "A", CreatedAt: timeNow}),
+			getErr:    nil,
+
+			expOutput: &projectservicev1.GetProjectResponse{
+				Project: &projectservicev1.Project{
+					Id:        1,
+					OwnerId:   2,
+					Name:      "A",
+					CreatedAt: timestamppb.New(timeNow),
+				},
+			},
+			expCode: codes.OK,
+		}, {
+			testName: "Invalid project id",
+
+			handlReq: &projectservicev1.GetProjectRequest{
+				ProjectId: 0,
+			},
+
+			getInput:  getmodel.NewGetProjectInput(0),
+			getOutput: getmodel.NewGetProjectOutput(nil),
+			getErr:    geterr.ErrInvalidProjectId,
+
+			expOutput: nil,
+			expCode:   codes.InvalidArgument,
+		}, {
+			testName: "Project not found",
+
+			handlReq: &projectservicev1.GetProjectRequest{
+				ProjectId: 1,
+			},
+
+			getInput:  getmodel.NewGetProjectInput(1),
+			getOutput: getmodel.NewGetProjectOutput(nil),
+			getErr:    geterr.ErrProjectNotFound,
+
+			expOutput: nil,
+			expCode:   codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getProjUCMock := grpchandlmocks.NewMockGetProjectUsecase(ctrl)
+
+			getProjUCMock.EXPECT().Execute(gomock.Any(), tt.getInput).
+				Return(tt.getOutput, tt.getErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			grpcHandl := NewGRPCHandler(log, getProjUCMock, nil, nil)
+			res, err := grpcHandl.GetProject(context.Background(), tt.handlReq)
+			require.Equal(t, tt.expCode, status.Code(err))
+			require.Equal(t, tt.expOutput, res)
+		})
+	}
+}
+
+func TestGRPCHandler_ListProjectsByOwner(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		handlReq *projectservicev1.ListProjectsByOwnerRequest
+
+		expGetAll    bool
+		getAllInput  *getallmodel.GetAllProjectsInput
+		getAllOutput *getallmodel.GetAllProjectsOutput
+		getAllErr    error
+
+		expOutput *projectservicev1.ListProjectsByOwnerResponse
+		expCode   codes.Code
+	}{
+		{
+			testName: "Success",
+
+			handlReq: &projectservicev1.ListProjectsByOwnerRequest{
+				OwnerId: 1,
+			},
+
+			expGetAll:   true,
+			getAllInput: getallmodel.NewGetAllProjectsInput(1, pagedomain.MaxLimit, "", "", "", ""),
+			getAllOutput: getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{
+				{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
+				{Id: 2, OwnerId: 1, Name: "B", CreatedAt: timeNow},
+			}, ""),
+			getAllErr: nil,
+
+			expOutput: &projectservicev1.ListProjectsByOwnerResponse{
+				Projects: []*projectservicev1.Project{
+					{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timestamppb.New(timeNow)},
+					{Id: 2, OwnerId: 1, Name: "B", CreatedAt: timestamppb.New(timeNow)},
+				},
+			},
+			expCode: codes.OK,
+		}, {
+			testName: "No projects",
+
+			handlReq: &projectservicev1.ListProjectsByOwnerRequest{
+				OwnerId: 1,
+			},
+
+			expGetAll:    true,
+			getAllInput:  getallmodel.NewGetAllProjectsInput(1, pagedomain.MaxLimit, "", "", "", ""),
+			getAllOutput: getallmodel.NewGetAllProjectsOutput(nil, ""),
+			getAllErr:    getallerr.ErrProjectsNotFound,
+
+			expOutput: &projectservicev1.ListProjectsByOwnerResponse{
+				Projects: []*projectservicev1.Project{},
+			},
+			expCode: codes.OK,
+		}, {
+			testName: "Invalid owner id",
+
+			handlReq: &projectservicev1.ListProjectsByOwnerRequest{
+				OwnerId: 0,
+			},
+
+			expGetAll: false,
+
+			expOutput: nil,
+			expCode:   codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getAllProjUCMock := grpchandlmocks.NewMockGetAllProjectsUsecase(ctrl)
+			if tt.expGetAll {
+				getAllProjUCMock.EXPECT().Execute(gomock.Any(), tt.getAllInput).
+					Return(tt.getAllOutput, tt.getAllErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			grpcHandl := NewGRPCHandler(log, nil, getAllProjUCMock, nil)
+			res, err := grpcHandl.ListProjectsByOwner(context.Background(), tt.handlReq)
+			require.Equal(t, tt.expCode, status.Code(err))
+			require.Equal(t, tt.expOutput, res)
+		})
+	}
+}
+
+func TestGRPCHandler_ListProjectsByOwner_Pages(t *testing.T) {
+	timeNow := time.Now()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	getAllProjUCMock := grpchandlmocks.NewMockGetAllProjectsUsecase(ctrl)
+	gomock.InOrder(
+		getAllProjUCMock.EXPECT().Execute(gomock.Any(), getallmodel.NewGetAllProjectsInput(1, pagedomain.MaxLimit, "", "", "", "")).
+			Return(getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{
+				{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
+				{Id: 2, OwnerId: 2, Name: "B", CreatedAt: timeNow},
+			}, "next"), nil),
+		getAllProjUCMock.EXPECT().Execute(gomock.Any(), getallmodel.NewGetAllProjectsInput(1, pagedomain.MaxLimit, "next", "", "", "")).
+			Return(getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{
+				{Id: 3, OwnerId: 1, Name: "C", CreatedAt: timeNow},
+			}, ""), nil),
+	)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	grpcHandl := NewGRPCHandler(log, nil, getAllProjUCMock, nil)
+	res, err := grpcHandl.ListProjectsByOwner(context.Background(), &projectservicev1.ListProjectsByOwnerRequest{OwnerId: 1})
+	require.NoError(t, err)
+	require.Equal(t, &projectservicev1.ListProjectsByOwnerResponse{
+		Projects: []*projectservicev1.Project{
+			{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timestamppb.New(timeNow)},
+			{Id: 3, OwnerId: 1, Name: "C", CreatedAt: timestamppb.New(timeNow)},
+		},
+	}, res)
+}
@@ -0,0 +1,25 @@
+package grpcmapper
+
+import (
+	projectservicev1 "platform/proto/projectservice"
+	projectdomain "projectservice/internal/domain/project"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func ProjectDomainToProto(pd *projectdomain.ProjectDomain) *projectservicev1.Project {
+	return &projectservicev1.Project{
+		Id:        pd.Id,
+		OwnerId:   pd.OwnerId,
+		Name:      pd.Name,
+		CreatedAt: timestamppb.New(pd.CreatedAt),
+	}
+}
+
+func ProjectDomainsToProto(pd []*projectdomain.ProjectDomain) []*projectservicev1.Project {
+	projects := make([]*projectservicev1.Project, 0, len(pd))
+	for _, val := range pd {
+		projects = append(projects, ProjectDomainToProto(val))
+	}
+	return projects
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/check_access.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/check_access.go -destination=mocks/mock_check_access.go -package=grpchandlmocks
+//
+
+// Package grpchandlmocks is a generated GoMock package.
+package grpchandlmocks
+
+import (
+	context "context"
+	checkaccessmodel "projectservice/internal/usecase/models/checkaccess"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCheckAccessUsecase is a mock of CheckAccessUsecase interface.
+type MockCheckAccessUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCheckAccessUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCheckAccessUsecaseMockRecorder is the mock recorder for MockCheckAccessUsecase.
+type MockCheckAccessUsecaseMockRecorder struct {
+	mock *MockCheckAccessUsecase
+}
+
+// NewMockCheckAccessUsecase creates a new mock instance.
+func NewMockCheckAccessUsecase(ctrl *gomock.Controller) *MockCheckAccessUsecase {
+	mock := &MockCheckAccessUsecase{ctrl: ctrl}
+	mock.recorder = &MockCheckAccessUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCheckAccessUsecase) EXPECT() *MockCheckAccessUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCheckAccessUsecase) Execute(ctx context.Context, in *checkaccessmodel.CheckAccessInput) (*checkaccessmodel.CheckAccessOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*checkaccessmodel.CheckAccessOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCheckAccessUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCheckAccessUsecase)(nil).Execute), ctx, in)
+}
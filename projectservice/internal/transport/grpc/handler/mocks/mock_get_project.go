@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/get_project.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/get_project.go -destination=mocks/mock_get_project.go -package=grpchandlmocks
+//
+
+// Package grpchandlmocks is a generated GoMock package.
+package grpchandlmocks
+
+import (
+	context "context"
+	getmodel "projectservice/internal/usecase/models/getproject"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetProjectUsecase is a mock of GetProjectUsecase interface.
+type MockGetProjectUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetProjectUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetProjectUsecaseMockRecorder is the mock recorder for MockGetProjectUsecase.
+type MockGetProjectUsecaseMockRecorder struct {
+	mock *MockGetProjectUsecase
+}
+
+// NewMockGetProjectUsecase creates a new mock instance.
+func NewMockGetProjectUsecase(ctrl *gomock.Controller) *MockGetProjectUsecase {
+	mock := &MockGetProjectUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetProjectUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetProjectUsecase) EXPECT() *MockGetProjectUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetProjectUsecase) Execute(ctx context.Context, in *getmodel.GetProjectInput) (*getmodel.GetProjectOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*getmodel.GetProjectOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetProjectUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetProjectUsecase)(nil).Execute), ctx, in)
+}
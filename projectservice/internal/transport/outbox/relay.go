@@ -0,0 +1,66 @@
+package outboxrelay
+
+import (
+	"context"
+	"log/slog"
+	"projectservice/internal/usecase/interfaces"
+	publishmodel "projectservice/internal/usecase/models/publishevents"
+	"time"
+)
+
+type Relay struct {
+	log       *slog.Logger
+	publishUC interfaces.PublishEventsUsecase
+	interval  time.Duration
+	batchSize uint32
+	stop      chan struct{}
+	done      chan struct{}
+}
+
+func NewRelay(log *slog.Logger, publishUC interfaces.PublishEventsUsecase, interval time.Duration, batchSize uint32) *Relay {
+	return &Relay{
+		log:       log,
+		publishUC: publishUC,
+		interval:  interval,
+		batchSize: batchSize,
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+}
+
+func (r *Relay) Start() {
+	const op = "outboxrelay.Start"
+	r.log.Info("starting outbox relay", slog.String("op", op), slog.Duration("interval", r.interval))
+	defer close(r.done)
+
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.stop:
+			return
+		case <-ticker.C:
+			r.publish()
+		}
+	}
+}
+
+func (r *Relay) publish() {
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), r.interval)
+		out, err := r.publishUC.Execute(ctx, publishmodel.NewPublishEventsInput(r.batchSize))
+		cancel()
+		if err != nil || out.Published < r.batchSize {
+			return
+		}
+	}
+}
+
+func (r *Relay) Stop() {
+	const op = "outboxrelay.Stop"
+	r.log.Info("start outbox relay shutdown", slog.String("op", op))
+	close(r.stop)
+	<-r.done
+	r.log.Info("outbox relay stopped", slog.String("op", op))
+}
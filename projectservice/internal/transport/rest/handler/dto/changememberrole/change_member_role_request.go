@@ -0,0 +1,7 @@
+package changeroledto
+
+type ChangeMemberRoleRequest struct {
+	ProjectId uint32 `json:"project_id" binding:"required"`
+	UserId    uint32 `json:"user_id" binding:"required"`
+	Role      string `json:"role" binding:"required"`
+}
@@ -0,0 +1,5 @@
+package changeroledto
+
+type ChangeMemberRoleResponse struct {
+	IsChanged bool `json:"is_changed" binding:"required"`
+}
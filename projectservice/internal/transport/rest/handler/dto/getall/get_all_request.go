@@ -0,0 +1,9 @@
+package getalldto
+
+type GetAllRequest struct {
+	Limit     uint32 `form:"limit"`
+	Cursor    string `form:"cursor"`
+	Sort      string `form:"sort"`
+	Direction string `form:"direction"`
+	Name      string `form:"name"`
+}
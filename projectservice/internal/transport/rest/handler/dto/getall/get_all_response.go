@@ -3,5 +3,6 @@ package getalldto
 import projectdomain "projectservice/internal/domain/project"
 
 type GetAllResponse struct {
-	Projects []*projectdomain.ProjectDomain `json:"projects" binding:"required"`
+	Projects   []*projectdomain.ProjectDomain `json:"projects" binding:"required"`
+	NextCursor string                         `json:"next_cursor"`
 }
@@ -0,0 +1,12 @@
+package getbyiddto
+
+import "time"
+
+type GetByIdResponse struct {
+	Id        uint32    `json:"id"`
+	OwnerId   uint32    `json:"owner_id"`
+	Name      string    `json:"name"`
+	Version   uint32    `json:"version"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
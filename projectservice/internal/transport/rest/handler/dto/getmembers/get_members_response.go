@@ -0,0 +1,13 @@
+package getmembersdto
+
+import "time"
+
+type MemberResponse struct {
+	UserId    uint32    `json:"user_id"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type GetMembersResponse struct {
+	Members []*MemberResponse `json:"members" binding:"required"`
+}
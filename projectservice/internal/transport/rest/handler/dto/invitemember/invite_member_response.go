@@ -0,0 +1,5 @@
+package invitedto
+
+type InviteMemberResponse struct {
+	IsInvited bool `json:"is_invited" binding:"required"`
+}
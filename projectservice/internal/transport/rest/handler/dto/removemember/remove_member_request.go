@@ -0,0 +1,6 @@
+package removedto
+
+type RemoveMemberRequest struct {
+	ProjectId uint32 `json:"project_id" binding:"required"`
+	UserId    uint32 `json:"user_id" binding:"required"`
+}
@@ -0,0 +1,5 @@
+package removedto
+
+type RemoveMemberResponse struct {
+	IsRemoved bool `json:"is_removed" binding:"required"`
+}
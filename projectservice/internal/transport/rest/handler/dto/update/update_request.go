@@ -0,0 +1,6 @@
+package updatedto
+
+type UpdateRequest struct {
+	Version uint32  `json:"version" binding:"required"`
+	Name    *string `json:"name" binding:"omitempty,min=1"`
+}
@@ -0,0 +1,6 @@
+package updatedto
+
+type UpdateResponse struct {
+	IsUpdated bool   `json:"is_updated" binding:"required"`
+	Version   uint32 `json:"version" binding:"required"`
+}
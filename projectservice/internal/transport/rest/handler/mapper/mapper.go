@@ -1,12 +1,24 @@
 package handlmapper
 
 import (
+	changeroledto "projectservice/internal/transport/rest/handler/dto/changememberrole"
 	createdto "projectservice/internal/transport/rest/handler/dto/create"
 	deletedto "projectservice/internal/transport/rest/handler/dto/delete"
 	getalldto "projectservice/internal/transport/rest/handler/dto/getall"
+	getbyiddto "projectservice/internal/transport/rest/handler/dto/getbyid"
+	getmembersdto "projectservice/internal/transport/rest/handler/dto/getmembers"
+	invitedto "projectservice/internal/transport/rest/handler/dto/invitemember"
+	removedto "projectservice/internal/transport/rest/handler/dto/removemember"
+	updatedto "projectservice/internal/transport/rest/handler/dto/update"
+	changerolemodel "projectservice/internal/usecase/models/changememberrole"
 	createmodel "projectservice/internal/usecase/models/createproject"
 	deletemodel "projectservice/internal/usecase/models/deleteproject"
 	getallmodel "projectservice/internal/usecase/models/getallprojects"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+	invitemodel "projectservice/internal/usecase/models/invitemember"
+	removemodel "projectservice/internal/usecase/models/removemember"
+	updatemodel "projectservice/internal/usecase/models/updateproject"
 )
 
 func CreateRequestToInput(cr *createdto.CreateRequest, userId uint32) *createmodel.CreateProjectInput {
@@ -29,8 +41,79 @@ func DeleteOutputToResponse(do *deletemodel.DeleteProjectOutput) *deletedto.Dele
 	}
 }
 
+func UpdateRequestToInput(ur *updatedto.UpdateRequest, userId, projectId uint32) *updatemodel.UpdateProjectInput {
+	return updatemodel.NewUpdateProjectInput(userId, projectId, ur.Version, ur.Name)
+}
+
+func UpdateOutputToResponse(uo *updatemodel.UpdateProjectOutput) *updatedto.UpdateResponse {
+	return &updatedto.UpdateResponse{
+		IsUpdated: uo.IsUpdated,
+		Version:   uo.Version,
+	}
+}
+
+func GetAllRequestToInput(gar *getalldto.GetAllRequest, userId uint32) *getallmodel.GetAllProjectsInput {
+	return getallmodel.NewGetAllProjectsInput(userId, gar.Limit, gar.Cursor, gar.Sort, gar.Direction, gar.Name)
+}
+
 func GetAllOutputToResponse(gao *getallmodel.GetAllProjectsOutput) *getalldto.GetAllResponse {
 	return &getalldto.GetAllResponse{
-		Projects: gao.Projects,
+		Projects:   gao.Projects,
+		NextCursor: gao.NextCursor,
+	}
+}
+
+func GetByIdOutputToResponse(gbo *getbyidmodel.GetProjectByIdOutput) *getbyiddto.GetByIdResponse {
+	return &getbyiddto.GetByIdResponse{
+		Id:        gbo.Project.Id,
+		OwnerId:   gbo.Project.OwnerId,
+		Name:      gbo.Project.Name,
+		Version:   gbo.Project.Version,
+		Role:      string(gbo.Role),
+		CreatedAt: gbo.Project.CreatedAt,
+	}
+}
+
+func InviteRequestToInput(ir *invitedto.InviteMemberRequest, userId uint32) *invitemodel.InviteMemberInput {
+	return invitemodel.NewInviteMemberInput(userId, ir.ProjectId, ir.UserId, ir.Role)
+}
+
+func InviteOutputToResponse(io *invitemodel.InviteMemberOutput) *invitedto.InviteMemberResponse {
+	return &invitedto.InviteMemberResponse{
+		IsInvited: io.IsInvited,
+	}
+}
+
+func GetMembersOutputToResponse(gmo *getmembersmodel.GetMembersOutput) *getmembersdto.GetMembersResponse {
+	members := make([]*getmembersdto.MemberResponse, 0, len(gmo.Members))
+	for _, m := range gmo.Members {
+		members = append(members, &getmembersdto.MemberResponse{
+			UserId:    m.UserId,
+			Role:      string(m.Role),
+			CreatedAt: m.CreatedAt,
+		})
+	}
+	return &getmembersdto.GetMembersResponse{
+		Members: members,
+	}
+}
+
+func ChangeRoleRequestToInput(cr *changeroledto.ChangeMemberRoleRequest, userId uint32) *changerolemodel.ChangeMemberRoleInput {
+	return changerolemodel.NewChangeMemberRoleInput(userId, cr.ProjectId, cr.UserId, cr.Role)
+}
+
+func ChangeRoleOutputToResponse(co *changerolemodel.ChangeMemberRoleOutput) *changeroledto.ChangeMemberRoleResponse {
+	return &changeroledto.ChangeMemberRoleResponse{
+		IsChanged: co.IsChanged,
+	}
+}
+
+func RemoveRequestToInput(rr *removedto.RemoveMemberRequest, userId uint32) *removemodel.RemoveMemberInput {
+	return removemodel.NewRemoveMemberInput(userId, rr.ProjectId, rr.UserId)
+}
+
+func RemoveOutputToResponse(ro *removemodel.RemoveMemberOutput) *removedto.RemoveMemberResponse {
+	return &removedto.RemoveMemberResponse{
+		IsRemoved: ro.IsRemoved,
 	}
 }
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/change_member_role.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/change_member_role.go -destination=./mocks/mock_change_member_role.go -package=resthandlmocks
+//
+
+// Package resthandlmocks is a generated GoMock package.
+package resthandlmocks
+
+import (
+	context "context"
+	changerolemodel "projectservice/internal/usecase/models/changememberrole"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChangeMemberRoleUsecase is a mock of ChangeMemberRoleUsecase interface.
+type MockChangeMemberRoleUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeMemberRoleUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChangeMemberRoleUsecaseMockRecorder is the mock recorder for MockChangeMemberRoleUsecase.
+type MockChangeMemberRoleUsecaseMockRecorder struct {
+	mock *MockChangeMemberRoleUsecase
+}
+
+// NewMockChangeMemberRoleUsecase creates a new mock instance.
+func NewMockChangeMemberRoleUsecase(ctrl *gomock.Controller) *MockChangeMemberRoleUsecase {
+	mock := &MockChangeMemberRoleUsecase{ctrl: ctrl}
+	mock.recorder = &MockChangeMemberRoleUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeMemberRoleUsecase) EXPECT() *MockChangeMemberRoleUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockChangeMemberRoleUsecase) Execute(ctx context.Context, in *changerolemodel.ChangeMemberRoleInput) (*changerolemodel.ChangeMemberRoleOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*changerolemodel.ChangeMemberRoleOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockChangeMemberRoleUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChangeMemberRoleUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/get_members.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/get_members.go -destination=./mocks/mock_get_members.go -package=resthandlmocks
+//
+
+// Package resthandlmocks is a generated GoMock package.
+package resthandlmocks
+
+import (
+	context "context"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetMembersUsecase is a mock of GetMembersUsecase interface.
+type MockGetMembersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetMembersUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetMembersUsecaseMockRecorder is the mock recorder for MockGetMembersUsecase.
+type MockGetMembersUsecaseMockRecorder struct {
+	mock *MockGetMembersUsecase
+}
+
+// NewMockGetMembersUsecase creates a new mock instance.
+func NewMockGetMembersUsecase(ctrl *gomock.Controller) *MockGetMembersUsecase {
+	mock := &MockGetMembersUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetMembersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetMembersUsecase) EXPECT() *MockGetMembersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetMembersUsecase) Execute(ctx context.Context, in *getmembersmodel.GetMembersInput) (*getmembersmodel.GetMembersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*getmembersmodel.GetMembersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetMembersUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetMembersUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/get_project_by_id.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/get_project_by_id.go -destination=./mocks/mock_get_project_by_id.go -package=resthandlmocks
+//
+
+// Package resthandlmocks is a generated GoMock package.
+package resthandlmocks
+
+import (
+	context "context"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetProjectByIdUsecase is a mock of GetProjectByIdUsecase interface.
+type MockGetProjectByIdUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetProjectByIdUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetProjectByIdUsecaseMockRecorder is the mock recorder for MockGetProjectByIdUsecase.
+type MockGetProjectByIdUsecaseMockRecorder struct {
+	mock *MockGetProjectByIdUsecase
+}
+
+// NewMockGetProjectByIdUsecase creates a new mock instance.
+func NewMockGetProjectByIdUsecase(ctrl *gomock.Controller) *MockGetProjectByIdUsecase {
+	mock := &MockGetProjectByIdUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetProjectByIdUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetProjectByIdUsecase) EXPECT() *MockGetProjectByIdUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetProjectByIdUsecase) Execute(ctx context.Context, in *getbyidmodel.GetProjectByIdInput) (*getbyidmodel.GetProjectByIdOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*getbyidmodel.GetProjectByIdOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetProjectByIdUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetProjectByIdUsecase)(nil).Execute), ctx, in)
+}
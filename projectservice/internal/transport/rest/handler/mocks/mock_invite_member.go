@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/invite_member.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/invite_member.go -destination=./mocks/mock_invite_member.go -package=resthandlmocks
+//
+
+// Package resthandlmocks is a generated GoMock package.
+package resthandlmocks
+
+import (
+	context "context"
+	invitemodel "projectservice/internal/usecase/models/invitemember"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockInviteMemberUsecase is a mock of InviteMemberUsecase interface.
+type MockInviteMemberUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteMemberUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockInviteMemberUsecaseMockRecorder is the mock recorder for MockInviteMemberUsecase.
+type MockInviteMemberUsecaseMockRecorder struct {
+	mock *MockInviteMemberUsecase
+}
+
+// NewMockInviteMemberUsecase creates a new mock instance.
+func NewMockInviteMemberUsecase(ctrl *gomock.Controller) *MockInviteMemberUsecase {
+	mock := &MockInviteMemberUsecase{ctrl: ctrl}
+	mock.recorder = &MockInviteMemberUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteMemberUsecase) EXPECT() *MockInviteMemberUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockInviteMemberUsecase) Execute(ctx context.Context, in *invitemodel.InviteMemberInput) (*invitemodel.InviteMemberOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*invitemodel.InviteMemberOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockInviteMemberUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockInviteMemberUsecase)(nil).Execute), ctx, in)
+}
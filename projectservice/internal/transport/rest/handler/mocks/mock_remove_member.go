@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/remove_member.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/remove_member.go -destination=./mocks/mock_remove_member.go -package=resthandlmocks
+//
+
+// Package resthandlmocks is a generated GoMock package.
+package resthandlmocks
+
+import (
+	context "context"
+	removemodel "projectservice/internal/usecase/models/removemember"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRemoveMemberUsecase is a mock of RemoveMemberUsecase interface.
+type MockRemoveMemberUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRemoveMemberUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRemoveMemberUsecaseMockRecorder is the mock recorder for MockRemoveMemberUsecase.
+type MockRemoveMemberUsecaseMockRecorder struct {
+	mock *MockRemoveMemberUsecase
+}
+
+// NewMockRemoveMemberUsecase creates a new mock instance.
+func NewMockRemoveMemberUsecase(ctrl *gomock.Controller) *MockRemoveMemberUsecase {
+	mock := &MockRemoveMemberUsecase{ctrl: ctrl}
+	mock.recorder = &MockRemoveMemberUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRemoveMemberUsecase) EXPECT() *MockRemoveMemberUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRemoveMemberUsecase) Execute(ctx context.Context, in *removemodel.RemoveMemberInput) (*removemodel.RemoveMemberOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*removemodel.RemoveMemberOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRemoveMemberUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRemoveMemberUsecase)(nil).Execute), ctx, in)
+}
@@ -4,16 +4,31 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	memberdomain "projectservice/internal/domain/member"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
+	changeroledto "projectservice/internal/transport/rest/handler/dto/changememberrole"
 	createdto "projectservice/internal/transport/rest/handler/dto/create"
 	deletedto "projectservice/internal/transport/rest/handler/dto/delete"
+	getalldto "projectservice/internal/transport/rest/handler/dto/getall"
+	invitedto "projectservice/internal/transport/rest/handler/dto/invitemember"
+	removedto "projectservice/internal/transport/rest/handler/dto/removemember"
+	updatedto "projectservice/internal/transport/rest/handler/dto/update"
 	handlmapper "projectservice/internal/transport/rest/handler/mapper"
 	handlvalidator "projectservice/internal/transport/rest/handler/validator"
+	changeroleerr "projectservice/internal/usecase/error/changememberrole"
 	createerr "projectservice/internal/usecase/error/createproject"
 	deleteerr "projectservice/internal/usecase/error/deleteproject"
 	getallerr "projectservice/internal/usecase/error/getallprojects"
+	getmemberserr "projectservice/internal/usecase/error/getmembers"
+	getbyiderr "projectservice/internal/usecase/error/getprojectbyid"
+	inviteerr "projectservice/internal/usecase/error/invitemember"
+	removeerr "projectservice/internal/usecase/error/removemember"
+	updateerr "projectservice/internal/usecase/error/updateproject"
 	"projectservice/internal/usecase/interfaces"
-	getallmodel "projectservice/internal/usecase/models/getallprojects"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+	"strconv"
 
 	"github.com/gin-gonic/gin"
 )
@@ -21,9 +36,16 @@ import (
 type RestHandler struct {
 	log *slog.Logger
 
-	createProjUC interfaces.CreateProjectUsecase
-	deleteProjUC interfaces.DeleteProjectUsecase
-	getAllProjUC interfaces.GetAllProjectsUsecase
+	createProjUC  interfaces.CreateProjectUsecase
+	deleteProjUC  interfaces.DeleteProjectUsecase
+	getAllProjUC  interfaces.GetAllProjectsUsecase
+	getByIdProjUC interfaces.GetProjectByIdUsecase
+	updateProjUC  interfaces.UpdateProjectUsecase
+
+	inviteMemberUC interfaces.InviteMemberUsecase
+	getMembersUC   interfaces.GetMembersUsecase
+	changeRoleUC   interfaces.ChangeMemberRoleUsecase
+	removeMemberUC interfaces.RemoveMemberUsecase
 }
 
 func NewHandler(
@@ -31,12 +53,24 @@ func NewHandler(
 	createProjUC interfaces.CreateProjectUsecase,
 	deleteProjUC interfaces.DeleteProjectUsecase,
 	getAllProjUC interfaces.GetAllProjectsUsecase,
+	getByIdProjUC interfaces.GetProjectByIdUsecase,
+	updateProjUC interfaces.UpdateProjectUsecase,
+	inviteMemberUC interfaces.InviteMemberUsecase,
+	getMembersUC interfaces.GetMembersUsecase,
+	changeRoleUC interfaces.ChangeMemberRoleUsecase,
+	removeMemberUC interfaces.RemoveMemberUsecase,
 ) *RestHandler {
 	return &RestHandler{
-		log:          log,
-		createProjUC: createProjUC,
-		deleteProjUC: deleteProjUC,
-		getAllProjUC: getAllProjUC,
+		log:            log,
+		createProjUC:   createProjUC,
+		deleteProjUC:   deleteProjUC,
+		getAllProjUC:   getAllProjUC,
+		getByIdProjUC:  getByIdProjUC,
+		updateProjUC:   updateProjUC,
+		inviteMemberUC: inviteMemberUC,
+		getMembersUC:   getMembersUC,
+		changeRoleUC:   changeRoleUC,
+		removeMemberUC: removeMemberUC,
 	}
 }
 
@@ -45,7 +79,7 @@ func (h *RestHandler) Create(ctx *gin.Context) {
 
 	userId := getUserId(ctx)
 	if userId == 0 {
-		h.log.Error("failed to get userId", slog.String("op", op))
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "internal server error",
 		})
@@ -53,12 +87,12 @@ func (h *RestHandler) Create(ctx *gin.Context) {
 
 	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
 
-	log.Info("starting create request")
+	log.InfoContext(ctx, "starting create request")
 
 	var req *createdto.CreateRequest
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		log.Warn("error with request data", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
 		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"errors": errMap,
@@ -76,22 +110,22 @@ func (h *RestHandler) Create(ctx *gin.Context) {
 	out, err := h.createProjUC.Execute(ctx.Request.Context(), in)
 	if err != nil {
 		if errors.Is(err, projectdomain.ErrInvalidName) {
-			log.Info("invalid name")
+			log.InfoContext(ctx, "invalid name")
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 		} else if errors.Is(err, projectdomain.ErrInvalidOwnerId) {
-			log.Info("invalid owner id")
+			log.InfoContext(ctx, "invalid owner id")
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 		} else if errors.Is(err, createerr.ErrAlreadyExists) {
-			log.Info("project already exists")
+			log.InfoContext(ctx, "project already exists")
 			ctx.JSON(http.StatusConflict, gin.H{
 				"error": err.Error(),
 			})
 		} else {
-			log.Warn("cannot create new project", slog.String("error", err.Error()))
+			log.WarnContext(ctx, "cannot create new project", slog.String("error", err.Error()))
 			ctx.JSON(http.StatusInternalServerError, gin.H{
 				"error": "internal server error",
 			})
@@ -99,7 +133,7 @@ func (h *RestHandler) Create(ctx *gin.Context) {
 		return
 	}
 
-	log.Info("create request completed successfully")
+	log.InfoContext(ctx, "create request completed successfully")
 
 	res := handlmapper.CreateOutputToResponse(out)
 	ctx.JSON(http.StatusOK, res)
@@ -110,7 +144,7 @@ func (h *RestHandler) Delete(ctx *gin.Context) {
 
 	userId := getUserId(ctx)
 	if userId == 0 {
-		h.log.Error("failed to get userId", slog.String("op", op))
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "internal server error",
 		})
@@ -118,12 +152,12 @@ func (h *RestHandler) Delete(ctx *gin.Context) {
 
 	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
 
-	log.Info("starting delete request")
+	log.InfoContext(ctx, "starting delete request")
 
 	var req *deletedto.DeleteRequest
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		log.Warn("error with request data", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
 		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"errors": errMap,
@@ -140,18 +174,23 @@ func (h *RestHandler) Delete(ctx *gin.Context) {
 
 	out, err := h.deleteProjUC.Execute(ctx, in)
 	if err != nil {
-		if errors.Is(err, projectdomain.ErrInvalidName) {
-			log.Info("invalid project id")
+		if errors.Is(err, deleteerr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 		} else if errors.Is(err, deleteerr.ErrProjectNotFound) {
-			log.Info("project not found")
+			log.InfoContext(ctx, "project not found")
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
 			})
+		} else if errors.Is(err, deleteerr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
 		} else {
-			log.Warn("cannot delete project", slog.String("error", err.Error()))
+			log.WarnContext(ctx, "cannot delete project", slog.String("error", err.Error()))
 			ctx.JSON(http.StatusInternalServerError, gin.H{
 				"error": "internal server error",
 			})
@@ -159,18 +198,173 @@ func (h *RestHandler) Delete(ctx *gin.Context) {
 		return
 	}
 
-	log.Info("delete request completed successfully")
+	log.InfoContext(ctx, "delete request completed successfully")
 
 	res := handlmapper.DeleteOutputToResponse(out)
 	ctx.JSON(http.StatusOK, res)
 }
 
+func (h *RestHandler) GetById(ctx *gin.Context) {
+	const op = "resthandler.GetById"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting get by id request")
+
+	projectId, ok := getParamId(ctx, "id")
+	if !ok {
+		log.InfoContext(ctx, "invalid project id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": getbyiderr.ErrInvalidProjectId.Error(),
+		})
+		return
+	}
+
+	in := getbyidmodel.NewGetProjectByIdInput(userId, projectId)
+
+	out, err := h.getByIdProjUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, getbyiderr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getbyiderr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot get project", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "get by id request completed successfully")
+
+	res := handlmapper.GetByIdOutputToResponse(out)
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (h *RestHandler) Update(ctx *gin.Context) {
+	const op = "resthandler.Update"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting update request")
+
+	projectId, ok := getParamId(ctx, "id")
+	if !ok {
+		log.InfoContext(ctx, "invalid project id")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": updateerr.ErrInvalidProjectId.Error(),
+		})
+		return
+	}
+
+	var req *updatedto.UpdateRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.UpdateRequestToInput(req, userId, projectId)
+
+	out, err := h.updateProjUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, projectdomain.ErrInvalidName) {
+			log.InfoContext(ctx, "invalid name")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrInvalidVersion) {
+			log.InfoContext(ctx, "invalid version")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrNothingToUpdate) {
+			log.InfoContext(ctx, "nothing to update")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrVersionConflict) {
+			log.InfoContext(ctx, "version conflict")
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error":   err.Error(),
+				"version": out.Version,
+			})
+		} else if errors.Is(err, updateerr.ErrAlreadyExists) {
+			log.InfoContext(ctx, "project already exists")
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot update project", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "update request completed successfully")
+
+	res := handlmapper.UpdateOutputToResponse(out)
+	ctx.JSON(http.StatusOK, res)
+}
+
 func (h *RestHandler) GetAll(ctx *gin.Context) {
 	const op = "resthandler.GetAll"
 
 	userId := getUserId(ctx)
 	if userId == 0 {
-		h.log.Error("failed to get userId", slog.String("op", op))
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
 		ctx.JSON(http.StatusInternalServerError, gin.H{
 			"error": "internal server error",
 		})
@@ -178,19 +372,38 @@ func (h *RestHandler) GetAll(ctx *gin.Context) {
 
 	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
 
-	log.Info("starting get all request")
+	log.InfoContext(ctx, "starting get all request")
+
+	var req getalldto.GetAllRequest
 
-	in := getallmodel.NewGetAllProjectsInput(userId)
+	if err := ctx.ShouldBindQuery(&req); err != nil {
+		log.WarnContext(ctx, "error with query params", slog.String("error", err.Error()))
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": "bad query params",
+		})
+		return
+	}
+
+	in := handlmapper.GetAllRequestToInput(&req, userId)
 
 	out, err := h.getAllProjUC.Execute(ctx, in)
 	if err != nil {
-		if errors.Is(err, getallerr.ErrProjectsNotFound) {
-			log.Info("projects not found")
+		if errors.Is(err, pagedomain.ErrInvalidLimit) ||
+			errors.Is(err, pagedomain.ErrInvalidCursor) ||
+			errors.Is(err, pagedomain.ErrInvalidSort) ||
+			errors.Is(err, pagedomain.ErrInvalidDirection) ||
+			errors.Is(err, pagedomain.ErrInvalidFilter) {
+			log.InfoContext(ctx, "invalid list params", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getallerr.ErrProjectsNotFound) {
+			log.InfoContext(ctx, "projects not found")
 			ctx.JSON(http.StatusNotFound, gin.H{
 				"error": err.Error(),
 			})
 		} else {
-			log.Warn("cannot get projects", slog.String("error", err.Error()))
+			log.WarnContext(ctx, "cannot get projects", slog.String("error", err.Error()))
 			ctx.JSON(http.StatusInternalServerError, gin.H{
 				"error": "internal server error",
 			})
@@ -198,12 +411,317 @@ func (h *RestHandler) GetAll(ctx *gin.Context) {
 		return
 	}
 
-	log.Info("get all request completed successfully")
+	log.InfoContext(ctx, "get all request completed successfully")
 
 	res := handlmapper.GetAllOutputToResponse(out)
 	ctx.JSON(http.StatusOK, res)
 }
 
+func (h *RestHandler) InviteMember(ctx *gin.Context) {
+	const op = "resthandler.InviteMember"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting invite member request")
+
+	var req *invitedto.InviteMemberRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.InviteRequestToInput(req, userId)
+
+	out, err := h.inviteMemberUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, memberdomain.ErrInvalidRole) {
+			log.InfoContext(ctx, "invalid role")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, memberdomain.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, memberdomain.ErrInvalidUserId) {
+			log.InfoContext(ctx, "invalid user id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, inviteerr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, inviteerr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, inviteerr.ErrMemberAlreadyExists) {
+			log.InfoContext(ctx, "member already exists")
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot invite member", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "invite member request completed successfully")
+
+	res := handlmapper.InviteOutputToResponse(out)
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (h *RestHandler) GetMembers(ctx *gin.Context) {
+	const op = "resthandler.GetMembers"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting get members request")
+
+	projectId, ok := getParamId(ctx, "project_id")
+	if !ok {
+		log.InfoContext(ctx, "invalid project id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": getmemberserr.ErrInvalidProjectId.Error(),
+		})
+		return
+	}
+
+	in := getmembersmodel.NewGetMembersInput(userId, projectId)
+
+	out, err := h.getMembersUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, getmemberserr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getmemberserr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot get members", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "get members request completed successfully")
+
+	res := handlmapper.GetMembersOutputToResponse(out)
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (h *RestHandler) ChangeMemberRole(ctx *gin.Context) {
+	const op = "resthandler.ChangeMemberRole"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting change member role request")
+
+	var req *changeroledto.ChangeMemberRoleRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ChangeRoleRequestToInput(req, userId)
+
+	out, err := h.changeRoleUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, memberdomain.ErrInvalidRole) {
+			log.InfoContext(ctx, "invalid role")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changeroleerr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changeroleerr.ErrInvalidMemberId) {
+			log.InfoContext(ctx, "invalid member id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changeroleerr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changeroleerr.ErrMemberNotFound) {
+			log.InfoContext(ctx, "member not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changeroleerr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot change member role", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "change member role request completed successfully")
+
+	res := handlmapper.ChangeRoleOutputToResponse(out)
+	ctx.JSON(http.StatusOK, res)
+}
+
+func (h *RestHandler) RemoveMember(ctx *gin.Context) {
+	const op = "resthandler.RemoveMember"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting remove member request")
+
+	var req *removedto.RemoveMemberRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.RemoveRequestToInput(req, userId)
+
+	out, err := h.removeMemberUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, removeerr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, removeerr.ErrInvalidMemberId) {
+			log.InfoContext(ctx, "invalid member id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, removeerr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, removeerr.ErrMemberNotFound) {
+			log.InfoContext(ctx, "member not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, removeerr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, removeerr.ErrCannotRemoveOwner) {
+			log.InfoContext(ctx, "owner cannot be removed")
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot remove member", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "remove member request completed successfully")
+
+	res := handlmapper.RemoveOutputToResponse(out)
+	ctx.JSON(http.StatusOK, res)
+}
+
+func getParamId(ctx *gin.Context, key string) (uint32, bool) {
+	id, err := strconv.ParseUint(ctx.Param(key), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(id), true
+}
+
 func getUserId(ctx *gin.Context) uint32 {
 	if val, exists := ctx.Get("userId"); exists {
 		return val.(uint32)
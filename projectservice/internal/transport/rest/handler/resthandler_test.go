@@ -7,15 +7,31 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"platform/middleware"
+	memberdomain "projectservice/internal/domain/member"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
+	getbyiddto "projectservice/internal/transport/rest/handler/dto/getbyid"
+	getmembersdto "projectservice/internal/transport/rest/handler/dto/getmembers"
 	resthandlmocks "projectservice/internal/transport/rest/handler/mocks"
-	"projectservice/internal/transport/rest/middleware"
+	changeroleerr "projectservice/internal/usecase/error/changememberrole"
 	createerr "projectservice/internal/usecase/error/createproject"
 	deleteerr "projectservice/internal/usecase/error/deleteproject"
 	getallerr "projectservice/internal/usecase/error/getallprojects"
+	getmemberserr "projectservice/internal/usecase/error/getmembers"
+	getbyiderr "projectservice/internal/usecase/error/getprojectbyid"
+	inviteerr "projectservice/internal/usecase/error/invitemember"
+	removeerr "projectservice/internal/usecase/error/removemember"
+	updateerr "projectservice/internal/usecase/error/updateproject"
+	changerolemodel "projectservice/internal/usecase/models/changememberrole"
 	createmodel "projectservice/internal/usecase/models/createproject"
 	deletemodel "projectservice/internal/usecase/models/deleteproject"
 	getallmodel "projectservice/internal/usecase/models/getallprojects"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+	invitemodel "projectservice/internal/usecase/models/invitemember"
+	removemodel "projectservice/internal/usecase/models/removemember"
+	updatemodel "projectservice/internal/usecase/models/updateproject"
 	"strings"
 	"testing"
 	"time"
@@ -139,7 +155,7 @@ func TestRestHandler_Create(t *testing.T) {
 			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
 				Return(tt.userId, nil)
 
-			handl := NewHandler(log, createUCMock, nil, nil)
+			handl := NewHandler(log, createUCMock, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			router := gin.New()
 			router.Use(middleware.GetSessionMiddleware(log))
@@ -248,6 +264,25 @@ func TestRestHandler_Delete(t *testing.T) {
 
 			expRespBody:   false,
 			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expDelete:         true,
+			deleteUCInput:     deletemodel.NewDeleteProjectInput(1, 1),
+			deleteUCOutput:    deletemodel.NewDeleteProjectOutput(false),
+			deleteUCReturnErr: deleteerr.ErrAccessDenied,
+
+			clientReturnErr: nil,
+
+			body: map[string]uint32{
+				"project_id": 1,
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusForbidden,
 		},
 	}
 
@@ -264,7 +299,7 @@ func TestRestHandler_Delete(t *testing.T) {
 					Return(tt.deleteUCOutput, tt.deleteUCReturnErr)
 			}
 
-			handl := NewHandler(log, nil, deleteUCMock, nil)
+			handl := NewHandler(log, nil, deleteUCMock, nil, nil, nil, nil, nil, nil, nil)
 
 			client := resthandlmocks.NewMockSessionValidator(ctrl)
 
@@ -304,7 +339,7 @@ func TestRestHandler_Delete(t *testing.T) {
 }
 
 func TestRestHandler_GetAll(t *testing.T) {
-	timeNow := time.Now().Round(0)
+	timeNow := time.Now().UTC().Round(0)
 
 	tests := []struct {
 		testName string
@@ -312,11 +347,15 @@ func TestRestHandler_GetAll(t *testing.T) {
 		userId    uint32
 		sessionId string
 
+		query string
+
+		expUC       bool
 		ucInput     *getallmodel.GetAllProjectsInput
 		ucOutput    *getallmodel.GetAllProjectsOutput
 		ucReturnErr error
 
 		expBody       []*projectdomain.ProjectDomain
+		expNextCursor string
 		expStatusCode int
 	}{
 		{
@@ -325,24 +364,78 @@ func TestRestHandler_GetAll(t *testing.T) {
 			userId:    1,
 			sessionId: "sessionId",
 
-			ucInput: getallmodel.NewGetAllProjectsInput(1),
+			query: "",
+
+			expUC:   true,
+			ucInput: getallmodel.NewGetAllProjectsInput(1, 0, "", "", "", ""),
 			ucOutput: getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{
 				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
-			}),
+			}, ""),
 			ucReturnErr: nil,
 
 			expBody: []*projectdomain.ProjectDomain{
 				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
 			},
+			expNextCursor: "",
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "With params",
+
+			userId:    1,
+			sessionId: "sessionId",
+
+			query: "?limit=1&cursor=abc&sort=name&direction=desc&name=A",
+
+			expUC:   true,
+			ucInput: getallmodel.NewGetAllProjectsInput(1, 1, "abc", "name", "desc", "A"),
+			ucOutput: getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{
+				&projectdomain.ProjectDomain{Id: 2, OwnerId: 1, Name: "AB", CreatedAt: timeNow},
+			}, "next"),
+			ucReturnErr: nil,
+
+			expBody: []*projectdomain.ProjectDomain{
+				&projectdomain.ProjectDomain{Id: 2, OwnerId: 1, Name: "AB", CreatedAt: timeNow},
+			},
+			expNextCursor: "next",
 			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid limit",
+
+			userId:    1,
+			sessionId: "sessionId",
+
+			query: "?limit=abc",
+
+			expUC: false,
+
+			expBody:       nil,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Invalid cursor",
+
+			userId:    1,
+			sessionId: "sessionId",
+
+			query: "?cursor=abc",
+
+			expUC:       true,
+			ucInput:     getallmodel.NewGetAllProjectsInput(1, 0, "abc", "", "", ""),
+			ucOutput:    getallmodel.NewGetAllProjectsOutput(nil, ""),
+			ucReturnErr: pagedomain.ErrInvalidCursor,
+
+			expBody:       nil,
+			expStatusCode: http.StatusBadRequest,
 		}, {
 			testName: "Project not found",
 
 			userId:    1,
 			sessionId: "sessionId",
 
-			ucInput:     getallmodel.NewGetAllProjectsInput(1),
-			ucOutput:    getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{nil}),
+			query: "",
+
+			expUC:       true,
+			ucInput:     getallmodel.NewGetAllProjectsInput(1, 0, "", "", "", ""),
+			ucOutput:    getallmodel.NewGetAllProjectsOutput([]*projectdomain.ProjectDomain{nil}, ""),
 			ucReturnErr: getallerr.ErrProjectsNotFound,
 
 			expBody:       nil,
@@ -358,10 +451,12 @@ func TestRestHandler_GetAll(t *testing.T) {
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
 			getAllMock := resthandlmocks.NewMockGetAllProjectsUsecase(ctrl)
-			getAllMock.EXPECT().Execute(gomock.Any(), tt.ucInput).
-				Return(tt.ucOutput, tt.ucReturnErr)
+			if tt.expUC {
+				getAllMock.EXPECT().Execute(gomock.Any(), tt.ucInput).
+					Return(tt.ucOutput, tt.ucReturnErr)
+			}
 
-			handl := NewHandler(log, nil, nil, getAllMock)
+			handl := NewHandler(log, nil, nil, getAllMock, nil, nil, nil, nil, nil, nil)
 
 			client := resthandlmocks.NewMockSessionValidator(ctrl)
 			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
@@ -372,7 +467,7 @@ func TestRestHandler_GetAll(t *testing.T) {
 			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
 			router.GET("/test", handl.GetAll)
 
-			req, err := http.NewRequest(http.MethodGet, "/test", nil)
+			req, err := http.NewRequest(http.MethodGet, "/test"+tt.query, nil)
 			require.NoError(t, err)
 
 			c := &http.Cookie{
@@ -387,11 +482,874 @@ func TestRestHandler_GetAll(t *testing.T) {
 			router.ServeHTTP(w, req)
 
 			var respBody struct {
-				Projects []*projectdomain.ProjectDomain `json:"projects"`
+				Projects   []*projectdomain.ProjectDomain `json:"projects"`
+				NextCursor string                         `json:"next_cursor"`
 			}
 
 			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
 			require.Equal(t, tt.expBody, respBody.Projects)
+			require.Equal(t, tt.expNextCursor, respBody.NextCursor)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/invite_member.go -destination=./mocks/mock_invite_member.go -package=resthandlmocks
+func TestRestHandler_InviteMember(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		sessionId string
+		userId    uint32
+
+		expInvite       bool
+		inviteInput     *invitemodel.InviteMemberInput
+		inviteOutput    *invitemodel.InviteMemberOutput
+		inviteReturnErr error
+
+		body map[string]any
+
+		expRespBody   bool
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expInvite:       true,
+			inviteInput:     invitemodel.NewInviteMemberInput(1, 1, 2, "member"),
+			inviteOutput:    invitemodel.NewInviteMemberOutput(true),
+			inviteReturnErr: nil,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "member",
+			},
+
+			expRespBody:   true,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Missing role",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expInvite: false,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Invalid role",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expInvite:       true,
+			inviteInput:     invitemodel.NewInviteMemberInput(1, 1, 2, "superuser"),
+			inviteOutput:    invitemodel.NewInviteMemberOutput(false),
+			inviteReturnErr: memberdomain.ErrInvalidRole,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "superuser",
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Access denied",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expInvite:       true,
+			inviteInput:     invitemodel.NewInviteMemberInput(1, 1, 2, "admin"),
+			inviteOutput:    invitemodel.NewInviteMemberOutput(false),
+			inviteReturnErr: inviteerr.ErrAccessDenied,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "admin",
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusForbidden,
+		}, {
+			testName: "Already exists",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expInvite:       true,
+			inviteInput:     invitemodel.NewInviteMemberInput(1, 1, 2, "member"),
+			inviteOutput:    invitemodel.NewInviteMemberOutput(false),
+			inviteReturnErr: inviteerr.ErrMemberAlreadyExists,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "member",
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			inviteUCMock := resthandlmocks.NewMockInviteMemberUsecase(ctrl)
+			if tt.expInvite {
+				inviteUCMock.EXPECT().Execute(gomock.Any(), tt.inviteInput).
+					Return(tt.inviteOutput, tt.inviteReturnErr)
+			}
+
+			handl := NewHandler(log, nil, nil, nil, nil, nil, inviteUCMock, nil, nil, nil)
+
+			client := resthandlmocks.NewMockSessionValidator(ctrl)
+			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
+				Return(tt.userId, nil)
+
+			router := gin.New()
+			router.Use(middleware.GetSessionMiddleware(log))
+			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
+			router.POST("/test", handl.InviteMember)
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPost, "/test", bytes.NewReader(b))
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: tt.sessionId,
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsInvited bool `json:"is_invited"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expRespBody, respBody.IsInvited)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/get_members.go -destination=./mocks/mock_get_members.go -package=resthandlmocks
+func TestRestHandler_GetMembers(t *testing.T) {
+	timeNow := time.Now().UTC().Round(0)
+
+	tests := []struct {
+		testName string
+
+		sessionId string
+		userId    uint32
+
+		projectIdParam string
+
+		expGetMembers       bool
+		getMembersInput     *getmembersmodel.GetMembersInput
+		getMembersOutput    *getmembersmodel.GetMembersOutput
+		getMembersReturnErr error
+
+		expBody       []*getmembersdto.MemberResponse
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expGetMembers:   true,
+			getMembersInput: getmembersmodel.NewGetMembersInput(1, 1),
+			getMembersOutput: getmembersmodel.NewGetMembersOutput([]*memberdomain.MemberDomain{
+				{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner, CreatedAt: timeNow},
+				{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer, CreatedAt: timeNow},
+			}),
+			getMembersReturnErr: nil,
+
+			expBody: []*getmembersdto.MemberResponse{
+				{UserId: 1, Role: "owner", CreatedAt: timeNow},
+				{UserId: 2, Role: "viewer", CreatedAt: timeNow},
+			},
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid project id",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "abc",
+
+			expGetMembers: false,
+
+			expBody:       nil,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Project not found",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expGetMembers:       true,
+			getMembersInput:     getmembersmodel.NewGetMembersInput(1, 1),
+			getMembersOutput:    getmembersmodel.NewGetMembersOutput(nil),
+			getMembersReturnErr: getmemberserr.ErrProjectNotFound,
+
+			expBody:       nil,
+			expStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			getMembersUCMock := resthandlmocks.NewMockGetMembersUsecase(ctrl)
+			if tt.expGetMembers {
+				getMembersUCMock.EXPECT().Execute(gomock.Any(), tt.getMembersInput).
+					Return(tt.getMembersOutput, tt.getMembersReturnErr)
+			}
+
+			handl := NewHandler(log, nil, nil, nil, nil, nil, nil, getMembersUCMock, nil, nil)
+
+			client := resthandlmocks.NewMockSessionValidator(ctrl)
+			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
+				Return(tt.userId, nil)
+
+			router := gin.New()
+			router.Use(middleware.GetSessionMiddleware(log))
+			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
+			router.GET("/test/:project_id", handl.GetMembers)
+
+			req, err := http.NewRequest(http.MethodGet, "/test/"+tt.projectIdParam, nil)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: tt.sessionId,
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				Members []*getmembersdto.MemberResponse `json:"members"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expBody, respBody.Members)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/change_member_role.go -destination=./mocks/mock_change_member_role.go -package=resthandlmocks
+func TestRestHandler_ChangeMemberRole(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		sessionId string
+		userId    uint32
+
+		expChange       bool
+		changeInput     *changerolemodel.ChangeMemberRoleInput
+		changeOutput    *changerolemodel.ChangeMemberRoleOutput
+		changeReturnErr error
+
+		body map[string]any
+
+		expRespBody   bool
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expChange:       true,
+			changeInput:     changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "admin"),
+			changeOutput:    changerolemodel.NewChangeMemberRoleOutput(true),
+			changeReturnErr: nil,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "admin",
+			},
+
+			expRespBody:   true,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Member not found",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expChange:       true,
+			changeInput:     changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "admin"),
+			changeOutput:    changerolemodel.NewChangeMemberRoleOutput(false),
+			changeReturnErr: changeroleerr.ErrMemberNotFound,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "admin",
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expChange:       true,
+			changeInput:     changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "admin"),
+			changeOutput:    changerolemodel.NewChangeMemberRoleOutput(false),
+			changeReturnErr: changeroleerr.ErrAccessDenied,
+
+			body: map[string]any{
+				"project_id": 1,
+				"user_id":    2,
+				"role":       "admin",
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			changeUCMock := resthandlmocks.NewMockChangeMemberRoleUsecase(ctrl)
+			if tt.expChange {
+				changeUCMock.EXPECT().Execute(gomock.Any(), tt.changeInput).
+					Return(tt.changeOutput, tt.changeReturnErr)
+			}
+
+			handl := NewHandler(log, nil, nil, nil, nil, nil, nil, nil, changeUCMock, nil)
+
+			client := resthandlmocks.NewMockSessionValidator(ctrl)
+			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
+				Return(tt.userId, nil)
+
+			router := gin.New()
+			router.Use(middleware.GetSessionMiddleware(log))
+			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
+			router.PATCH("/test", handl.ChangeMemberRole)
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPatch, "/test", bytes.NewReader(b))
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: tt.sessionId,
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsChanged bool `json:"is_changed"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expRespBody, respBody.IsChanged)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/remove_member.go -destination=./mocks/mock_remove_member.go -package=resthandlmocks
+func TestRestHandler_RemoveMember(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		sessionId string
+		userId    uint32
+
+		expRemove       bool
+		removeInput     *removemodel.RemoveMemberInput
+		removeOutput    *removemodel.RemoveMemberOutput
+		removeReturnErr error
+
+		body map[string]uint32
+
+		expRespBody   bool
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expRemove:       true,
+			removeInput:     removemodel.NewRemoveMemberInput(1, 1, 2),
+			removeOutput:    removemodel.NewRemoveMemberOutput(true),
+			removeReturnErr: nil,
+
+			body: map[string]uint32{
+				"project_id": 1,
+				"user_id":    2,
+			},
+
+			expRespBody:   true,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Cannot remove owner",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expRemove:       true,
+			removeInput:     removemodel.NewRemoveMemberInput(1, 1, 1),
+			removeOutput:    removemodel.NewRemoveMemberOutput(false),
+			removeReturnErr: removeerr.ErrCannotRemoveOwner,
+
+			body: map[string]uint32{
+				"project_id": 1,
+				"user_id":    1,
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusConflict,
+		}, {
+			testName: "Project not found",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			expRemove:       true,
+			removeInput:     removemodel.NewRemoveMemberInput(1, 1, 2),
+			removeOutput:    removemodel.NewRemoveMemberOutput(false),
+			removeReturnErr: removeerr.ErrProjectNotFound,
+
+			body: map[string]uint32{
+				"project_id": 1,
+				"user_id":    2,
+			},
+
+			expRespBody:   false,
+			expStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			removeUCMock := resthandlmocks.NewMockRemoveMemberUsecase(ctrl)
+			if tt.expRemove {
+				removeUCMock.EXPECT().Execute(gomock.Any(), tt.removeInput).
+					Return(tt.removeOutput, tt.removeReturnErr)
+			}
+
+			handl := NewHandler(log, nil, nil, nil, nil, nil, nil, nil, nil, removeUCMock)
+
+			client := resthandlmocks.NewMockSessionValidator(ctrl)
+			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
+				Return(tt.userId, nil)
+
+			router := gin.New()
+			router.Use(middleware.GetSessionMiddleware(log))
+			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
+			router.DELETE("/test", handl.RemoveMember)
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodDelete, "/test", bytes.NewReader(b))
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: tt.sessionId,
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsRemoved bool `json:"is_removed"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expRespBody, respBody.IsRemoved)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/update_project.go -destination=./mocks/mock_update_project.go -package=resthandlmocks
+func TestRestHandler_Update(t *testing.T) {
+	newName := "New"
+
+	tests := []struct {
+		testName string
+
+		sessionId string
+		userId    uint32
+
+		projectIdParam string
+
+		expUpdate       bool
+		updateInput     *updatemodel.UpdateProjectInput
+		updateOutput    *updatemodel.UpdateProjectOutput
+		updateReturnErr error
+
+		body map[string]any
+
+		expRespBody    bool
+		expRespVersion uint32
+		expStatusCode  int
+	}{
+		{
+			testName: "Success",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expUpdate:       true,
+			updateInput:     updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+			updateOutput:    updatemodel.NewUpdateProjectOutput(true, 4),
+			updateReturnErr: nil,
+
+			body: map[string]any{
+				"version": 3,
+				"name":    "New",
+			},
+
+			expRespBody:    true,
+			expRespVersion: 4,
+			expStatusCode:  http.StatusOK,
+		}, {
+			testName: "Invalid project id",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "abc",
+
+			expUpdate: false,
+
+			body: map[string]any{
+				"version": 3,
+				"name":    "New",
+			},
+
+			expRespBody:    false,
+			expRespVersion: 0,
+			expStatusCode:  http.StatusBadRequest,
+		}, {
+			testName: "Missing version",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expUpdate: false,
+
+			body: map[string]any{
+				"name": "New",
+			},
+
+			expRespBody:    false,
+			expRespVersion: 0,
+			expStatusCode:  http.StatusBadRequest,
+		}, {
+			testName: "Empty name",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expUpdate: false,
+
+			body: map[string]any{
+				"version": 3,
+				"name":    "",
+			},
+
+			expRespBody:    false,
+			expRespVersion: 0,
+			expStatusCode:  http.StatusBadRequest,
+		}, {
+			testName: "Version conflict",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expUpdate:       true,
+			updateInput:     updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+			updateOutput:    updatemodel.NewUpdateProjectOutput(false, 5),
+			updateReturnErr: updateerr.ErrVersionConflict,
+
+			body: map[string]any{
+				"version": 3,
+				"name":    "New",
+			},
+
+			expRespBody:    false,
+			expRespVersion: 5,
+			expStatusCode:  http.StatusConflict,
+		}, {
+			testName: "Already exists",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expUpdate:       true,
+			updateInput:     updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+			updateOutput:    updatemodel.NewUpdateProjectOutput(false, 0),
+			updateReturnErr: updateerr.ErrAlreadyExists,
+
+			body: map[string]any{
+				"version": 3,
+				"name":    "New",
+			},
+
+			expRespBody:    false,
+			expRespVersion: 0,
+			expStatusCode:  http.StatusConflict,
+		}, {
+			testName: "Access denied",
+
+			sessionId: "sessionId",
+			userId:    1,
+
+			projectIdParam: "1",
+
+			expUpdate:       true,
+			updateInput:     updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+			updateOutput:    updatemodel.NewUpdateProjectOutput(false, 0),
+			updateReturnErr: updateerr.ErrAccessDenied,
+
+			body: map[string]any{
+				"version": 3,
+				"name":    "New",
+			},
+
+			expRespBody:    false,
+			expRespVersion: 0,
+			expStatusCode:  http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			updateUCMock := resthandlmocks.NewMockUpdateProjectUsecase(ctrl)
+			if tt.expUpdate {
+				updateUCMock.EXPECT().Execute(gomock.Any(), tt.updateInput).
+					Return(tt.updateOutput, tt.updateReturnErr)
+			}
+
+			handl := NewHandler(log, nil, nil, nil, nil, updateUCMock, nil, nil, nil, nil)
+
+			client := resthandlmocks.NewMockSessionValidator(ctrl)
+			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
+				Return(tt.userId, nil)
+
+			router := gin.New()
+			router.Use(middleware.GetSessionMiddleware(log))
+			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
+			router.PATCH("/test/:id", handl.Update)
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPatch, "/test/"+tt.projectIdParam, bytes.NewReader(b))
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: tt.sessionId,
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsUpdated bool   `json:"is_updated"`
+				Version   uint32 `json:"version"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expRespBody, respBody.IsUpdated)
+			require.Equal(t, tt.expRespVersion, respBody.Version)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/get_project_by_id.go -destination=./mocks/mock_get_project_by_id.go -package=resthandlmocks
+func TestRestHandler_GetById(t *testing.T) {
+	timeNow := time.Now().UTC().Round(0)
+
+	tests := []struct {
+		testName string
+
+		sessionId string
+		userId    uint32
+
+		projectIdParam string
+
+		expGetById       bool
+		getByIdInput     *getbyidmodel.GetProjectByIdInput
+		getByIdOutput    *getbyidmodel.GetProjectByIdOutput
+		getByIdReturnErr error
+
+		expBody       *getbyiddto.GetByIdResponse
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			sessionId: "sessionId",
+			userId:    2,
+
+			projectIdParam: "1",
+
+			expGetById:   true,
+			getByIdInput: getbyidmodel.NewGetProjectByIdInput(2, 1),
+			getByIdOutput: getbyidmodel.NewGetProjectByIdOutput(
+				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", Version: 2, CreatedAt: timeNow},
+				memberdomain.RoleMember,
+			),
+			getByIdReturnErr: nil,
+
+			expBody:       &getbyiddto.GetByIdResponse{Id: 1, OwnerId: 1, Name: "A", Version: 2, Role: "member", CreatedAt: timeNow},
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid project id",
+
+			sessionId: "sessionId",
+			userId:    2,
+
+			projectIdParam: "abc",
+
+			expGetById: false,
+
+			expBody:       &getbyiddto.GetByIdResponse{},
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Not found",
+
+			sessionId: "sessionId",
+			userId:    2,
+
+			projectIdParam: "1",
+
+			expGetById:       true,
+			getByIdInput:     getbyidmodel.NewGetProjectByIdInput(2, 1),
+			getByIdOutput:    getbyidmodel.NewGetProjectByIdOutput(nil, ""),
+			getByIdReturnErr: getbyiderr.ErrProjectNotFound,
+
+			expBody:       &getbyiddto.GetByIdResponse{},
+			expStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			getByIdUCMock := resthandlmocks.NewMockGetProjectByIdUsecase(ctrl)
+			if tt.expGetById {
+				getByIdUCMock.EXPECT().Execute(gomock.Any(), tt.getByIdInput).
+					Return(tt.getByIdOutput, tt.getByIdReturnErr)
+			}
+
+			handl := NewHandler(log, nil, nil, nil, getByIdUCMock, nil, nil, nil, nil, nil)
+
+			client := resthandlmocks.NewMockSessionValidator(ctrl)
+			client.EXPECT().GetIdBySession(gomock.Any(), tt.sessionId).
+				Return(tt.userId, nil)
+
+			router := gin.New()
+			router.Use(middleware.GetSessionMiddleware(log))
+			router.Use(middleware.SessionAuthMiddleware(log, client, 10*time.Second))
+			router.GET("/test/:id", handl.GetById)
+
+			req, err := http.NewRequest(http.MethodGet, "/test/"+tt.projectIdParam, nil)
+			require.NoError(t, err)
+
+			req.AddCookie(&http.Cookie{
+				Name:  "sessionId",
+				Value: tt.sessionId,
+			})
+
+			w := httptest.NewRecorder()
+
+			router.ServeHTTP(w, req)
+
+			respBody := &getbyiddto.GetByIdResponse{}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(respBody))
+			require.Equal(t, tt.expBody, respBody)
 			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
 		})
 	}
@@ -0,0 +1,11 @@
+package changeroleerr
+
+import "errors"
+
+var (
+	ErrProjectNotFound  = errors.New("project not found")
+	ErrMemberNotFound   = errors.New("member not found")
+	ErrInvalidProjectId = errors.New("invalid project id")
+	ErrInvalidMemberId  = errors.New("invalid member id")
+	ErrAccessDenied     = errors.New("access denied")
+)
@@ -0,0 +1,9 @@
+package checkaccesserr
+
+import "errors"
+
+var (
+	ErrProjectNotFound  = errors.New("project not found")
+	ErrInvalidProjectId = errors.New("invalid project id")
+	ErrInvalidUserId    = errors.New("invalid user id")
+)
@@ -5,4 +5,5 @@ import "errors"
 var (
 	ErrProjectNotFound  = errors.New("project not found")
 	ErrInvalidProjectId = errors.New("invalid project id")
+	ErrAccessDenied     = errors.New("access denied")
 )
@@ -0,0 +1,8 @@
+package geterr
+
+import "errors"
+
+var (
+	ErrProjectNotFound  = errors.New("project not found")
+	ErrInvalidProjectId = errors.New("invalid project id")
+)
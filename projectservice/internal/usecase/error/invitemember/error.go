@@ -0,0 +1,9 @@
+package inviteerr
+
+import "errors"
+
+var (
+	ErrProjectNotFound     = errors.New("project not found")
+	ErrAccessDenied        = errors.New("access denied")
+	ErrMemberAlreadyExists = errors.New("member already exists")
+)
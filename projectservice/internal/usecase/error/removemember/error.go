@@ -0,0 +1,12 @@
+package removeerr
+
+import "errors"
+
+var (
+	ErrProjectNotFound   = errors.New("project not found")
+	ErrMemberNotFound    = errors.New("member not found")
+	ErrInvalidProjectId  = errors.New("invalid project id")
+	ErrInvalidMemberId   = errors.New("invalid member id")
+	ErrAccessDenied      = errors.New("access denied")
+	ErrCannotRemoveOwner = errors.New("project owner cannot be removed")
+)
@@ -0,0 +1,13 @@
+package updateerr
+
+import "errors"
+
+var (
+	ErrProjectNotFound  = errors.New("project not found")
+	ErrInvalidProjectId = errors.New("invalid project id")
+	ErrInvalidVersion   = errors.New("invalid version")
+	ErrNothingToUpdate  = errors.New("nothing to update")
+	ErrAccessDenied     = errors.New("access denied")
+	ErrVersionConflict  = errors.New("project was modified concurrently")
+	ErrAlreadyExists    = errors.New("project with this name already exists")
+)
@@ -0,0 +1,82 @@
+package changememberrole
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	changeroleerr "projectservice/internal/usecase/error/changememberrole"
+	changerolemodel "projectservice/internal/usecase/models/changememberrole"
+)
+
+type ChangeMemberRoleUC struct {
+	log *slog.Logger
+
+	members member.MemberRepo
+}
+
+func NewChangeMemberRoleUC(log *slog.Logger, members member.MemberRepo) *ChangeMemberRoleUC {
+	return &ChangeMemberRoleUC{
+		log:     log,
+		members: members,
+	}
+}
+
+func (c *ChangeMemberRoleUC) Execute(ctx context.Context, in *changerolemodel.ChangeMemberRoleInput) (*changerolemodel.ChangeMemberRoleOutput, error) {
+	const op = "changememberrole.Execute"
+	log := c.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("projectId", int(in.ProjectId)), slog.Int("memberId", int(in.MemberId)))
+
+	log.InfoContext(ctx, "starting change member role")
+
+	if in.ProjectId == 0 {
+		return changerolemodel.NewChangeMemberRoleOutput(false), changeroleerr.ErrInvalidProjectId
+	}
+	if in.MemberId == 0 {
+		return changerolemodel.NewChangeMemberRoleOutput(false), changeroleerr.ErrInvalidMemberId
+	}
+
+	role := memberdomain.Role(in.Role)
+	if err := memberdomain.ValidateRole(role); err != nil {
+		log.InfoContext(ctx, "invalid role", slog.String("role", in.Role))
+		return changerolemodel.NewChangeMemberRoleOutput(false), err
+	}
+
+	actor, err := c.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return changerolemodel.NewChangeMemberRoleOutput(false), changeroleerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return changerolemodel.NewChangeMemberRoleOutput(false), err
+	}
+
+	target, err := c.members.GetMember(ctx, in.ProjectId, in.MemberId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "member not found")
+			return changerolemodel.NewChangeMemberRoleOutput(false), changeroleerr.ErrMemberNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return changerolemodel.NewChangeMemberRoleOutput(false), err
+	}
+
+	if !memberdomain.CanManage(actor.Role, target.Role) || !memberdomain.CanManage(actor.Role, role) {
+		log.InfoContext(ctx, "access denied", slog.String("role", string(actor.Role)))
+		return changerolemodel.NewChangeMemberRoleOutput(false), changeroleerr.ErrAccessDenied
+	}
+
+	if err := c.members.UpdateMemberRole(ctx, in.ProjectId, in.MemberId, role); err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "member not found")
+			return changerolemodel.NewChangeMemberRoleOutput(false), changeroleerr.ErrMemberNotFound
+		}
+		log.WarnContext(ctx, "error update member role", slog.String("error", err.Error()))
+		return changerolemodel.NewChangeMemberRoleOutput(false), err
+	}
+
+	log.InfoContext(ctx, "member role changed")
+
+	return changerolemodel.NewChangeMemberRoleOutput(true), nil
+}
@@ -0,0 +1,147 @@
+package changememberrole
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	changeroleerr "projectservice/internal/usecase/error/changememberrole"
+	changerolemocks "projectservice/internal/usecase/implementations/changememberrole/mocks"
+	changerolemodel "projectservice/internal/usecase/models/changememberrole"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=changerolemocks
+func TestChangeMemberRole(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expActor       bool
+		actorReturn    *memberdomain.MemberDomain
+		actorReturnErr error
+
+		expTarget       bool
+		targetReturn    *memberdomain.MemberDomain
+		targetReturnErr error
+
+		expUpdate       bool
+		updateReturnErr error
+
+		in *changerolemodel.ChangeMemberRoleInput
+
+		expErr    error
+		expOutput *changerolemodel.ChangeMemberRoleOutput
+	}{
+		{
+			testName: "Success",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleMember},
+			targetReturnErr: nil,
+
+			expUpdate:       true,
+			updateReturnErr: nil,
+
+			in: changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "admin"),
+
+			expErr:    nil,
+			expOutput: changerolemodel.NewChangeMemberRoleOutput(true),
+		}, {
+			testName: "Invalid member id",
+
+			in: changerolemodel.NewChangeMemberRoleInput(1, 1, 0, "admin"),
+
+			expErr:    changeroleerr.ErrInvalidMemberId,
+			expOutput: changerolemodel.NewChangeMemberRoleOutput(false),
+		}, {
+			testName: "Invalid role",
+
+			in: changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "superuser"),
+
+			expErr:    memberdomain.ErrInvalidRole,
+			expOutput: changerolemodel.NewChangeMemberRoleOutput(false),
+		}, {
+			testName: "Member not found",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    nil,
+			targetReturnErr: member.ErrNotFound,
+
+			in: changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "admin"),
+
+			expErr:    changeroleerr.ErrMemberNotFound,
+			expOutput: changerolemodel.NewChangeMemberRoleOutput(false),
+		}, {
+			testName: "Admin promotes to owner",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleAdmin},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleMember},
+			targetReturnErr: nil,
+
+			in: changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "owner"),
+
+			expErr:    changeroleerr.ErrAccessDenied,
+			expOutput: changerolemodel.NewChangeMemberRoleOutput(false),
+		}, {
+			testName: "Viewer changes role",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleViewer},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleMember},
+			targetReturnErr: nil,
+
+			in: changerolemodel.NewChangeMemberRoleInput(1, 1, 2, "viewer"),
+
+			expErr:    changeroleerr.ErrAccessDenied,
+			expOutput: changerolemodel.NewChangeMemberRoleOutput(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			memberMock := changerolemocks.NewMockMemberRepo(ctrl)
+			if tt.expActor {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.UserId).
+					Return(tt.actorReturn, tt.actorReturnErr)
+			}
+			if tt.expTarget {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.MemberId).
+					Return(tt.targetReturn, tt.targetReturnErr)
+			}
+			if tt.expUpdate {
+				memberMock.EXPECT().UpdateMemberRole(gomock.Any(), tt.in.ProjectId, tt.in.MemberId, memberdomain.Role(tt.in.Role)).
+					Return(tt.updateReturnErr)
+			}
+
+			changeRoleUC := NewChangeMemberRoleUC(log, memberMock)
+
+			out, err := changeRoleUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
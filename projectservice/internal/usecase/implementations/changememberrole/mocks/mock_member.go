@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/member/memberrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=changerolemocks
+//
+
+// Package changerolemocks is a generated GoMock package.
+package changerolemocks
+
+import (
+	context "context"
+	memberdomain "projectservice/internal/domain/member"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockMemberRepo is a mock of MemberRepo interface.
+type MockMemberRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockMemberRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockMemberRepoMockRecorder is the mock recorder for MockMemberRepo.
+type MockMemberRepoMockRecorder struct {
+	mock *MockMemberRepo
+}
+
+// NewMockMemberRepo creates a new mock instance.
+func NewMockMemberRepo(ctrl *gomock.Controller) *MockMemberRepo {
+	mock := &MockMemberRepo{ctrl: ctrl}
+	mock.recorder = &MockMemberRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMemberRepo) EXPECT() *MockMemberRepoMockRecorder {
+	return m.recorder
+}
+
+// AddMember mocks base method.
+func (m_2 *MockMemberRepo) AddMember(ctx context.Context, m *memberdomain.MemberDomain) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "AddMember", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMember indicates an expected call of AddMember.
+func (mr *MockMemberRepoMockRecorder) AddMember(ctx, m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMember", reflect.TypeOf((*MockMemberRepo)(nil).AddMember), ctx, m)
+}
+
+// DeleteMember mocks base method.
+func (m *MockMemberRepo) DeleteMember(ctx context.Context, projectId, userId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMember", ctx, projectId, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMember indicates an expected call of DeleteMember.
+func (mr *MockMemberRepoMockRecorder) DeleteMember(ctx, projectId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMember", reflect.TypeOf((*MockMemberRepo)(nil).DeleteMember), ctx, projectId, userId)
+}
+
+// GetMember mocks base method.
+func (m *MockMemberRepo) GetMember(ctx context.Context, projectId, userId uint32) (*memberdomain.MemberDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMember", ctx, projectId, userId)
+	ret0, _ := ret[0].(*memberdomain.MemberDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMember indicates an expected call of GetMember.
+func (mr *MockMemberRepoMockRecorder) GetMember(ctx, projectId, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMember", reflect.TypeOf((*MockMemberRepo)(nil).GetMember), ctx, projectId, userId)
+}
+
+// GetMembers mocks base method.
+func (m *MockMemberRepo) GetMembers(ctx context.Context, projectId uint32) ([]*memberdomain.MemberDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMembers", ctx, projectId)
+	ret0, _ := ret[0].([]*memberdomain.MemberDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMembers indicates an expected call of GetMembers.
+func (mr *MockMemberRepoMockRecorder) GetMembers(ctx, projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMembers", reflect.TypeOf((*MockMemberRepo)(nil).GetMembers), ctx, projectId)
+}
+
+// UpdateMemberRole mocks base method.
+func (m *MockMemberRepo) UpdateMemberRole(ctx context.Context, projectId, userId uint32, role memberdomain.Role) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMemberRole", ctx, projectId, userId, role)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMemberRole indicates an expected call of UpdateMemberRole.
+func (mr *MockMemberRepoMockRecorder) UpdateMemberRole(ctx, projectId, userId, role any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMemberRole", reflect.TypeOf((*MockMemberRepo)(nil).UpdateMemberRole), ctx, projectId, userId, role)
+}
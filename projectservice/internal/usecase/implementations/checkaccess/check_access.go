@@ -0,0 +1,65 @@
+package checkaccess
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"projectservice/internal/repository/member"
+	"projectservice/internal/repository/storage"
+	checkaccesserr "projectservice/internal/usecase/error/checkaccess"
+	checkaccessmodel "projectservice/internal/usecase/models/checkaccess"
+)
+
+type CheckAccessUC struct {
+	log *slog.Logger
+
+	stor    storage.StorageRepo
+	members member.MemberRepo
+}
+
+func NewCheckAccessUC(log *slog.Logger, stor storage.StorageRepo, members member.MemberRepo) *CheckAccessUC {
+	return &CheckAccessUC{
+		log:     log,
+		stor:    stor,
+		members: members,
+	}
+}
+
+func (c *CheckAccessUC) Execute(ctx context.Context, in *checkaccessmodel.CheckAccessInput) (*checkaccessmodel.CheckAccessOutput, error) {
+	const op = "checkaccess.Execute"
+
+	log := c.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)), slog.Int("userId", int(in.UserId)))
+
+	log.InfoContext(ctx, "starting check access")
+
+	if in.UserId == 0 {
+		return checkaccessmodel.NewCheckAccessOutput(false, ""), checkaccesserr.ErrInvalidUserId
+	}
+	if in.ProjectId == 0 {
+		return checkaccessmodel.NewCheckAccessOutput(false, ""), checkaccesserr.ErrInvalidProjectId
+	}
+
+	_, err := c.stor.GetById(ctx, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return checkaccessmodel.NewCheckAccessOutput(false, ""), checkaccesserr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get project", slog.String("error", err.Error()))
+		return checkaccessmodel.NewCheckAccessOutput(false, ""), err
+	}
+
+	md, err := c.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "access checked", slog.Bool("hasAccess", false))
+			return checkaccessmodel.NewCheckAccessOutput(false, ""), nil
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return checkaccessmodel.NewCheckAccessOutput(false, ""), err
+	}
+
+	log.InfoContext(ctx, "access checked", slog.Bool("hasAccess", true), slog.String("role", string(md.Role)))
+
+	return checkaccessmodel.NewCheckAccessOutput(true, string(md.Role)), nil
+}
@@ -0,0 +1,153 @@
+package checkaccess
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	projectdomain "projectservice/internal/domain/project"
+	"projectservice/internal/repository/member"
+	"projectservice/internal/repository/storage"
+	checkaccesserr "projectservice/internal/usecase/error/checkaccess"
+	checkaccessmocks "projectservice/internal/usecase/implementations/checkaccess/mocks"
+	checkaccessmodel "projectservice/internal/usecase/models/checkaccess"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=checkaccessmocks
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=checkaccessmocks
+func TestCheckAccess(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expStorage       bool
+		storageInput     uint32
+		storageReturn    *projectdomain.ProjectDomain
+		storageReturnErr error
+
+		expMember       bool
+		memberReturn    *memberdomain.MemberDomain
+		memberReturnErr error
+
+		checkInput *checkaccessmodel.CheckAccessInput
+
+		expErr    error
+		expOutput *checkaccessmodel.CheckAccessOutput
+	}{
+		{
+			testName: "Owner",
+
+			expStorage:       true,
+			storageInput:     1,
+			storageReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A"},
+			storageReturnErr: nil,
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
+			checkInput: checkaccessmodel.NewCheckAccessInput(1, 1),
+
+			expErr:    nil,
+			expOutput: checkaccessmodel.NewCheckAccessOutput(true, "owner"),
+		}, {
+			testName: "Viewer",
+
+			expStorage:       true,
+			storageInput:     1,
+			storageReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 2, Name: "A"},
+			storageReturnErr: nil,
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleViewer},
+			memberReturnErr: nil,
+
+			checkInput: checkaccessmodel.NewCheckAccessInput(1, 1),
+
+			expErr:    nil,
+			expOutput: checkaccessmodel.NewCheckAccessOutput(true, "viewer"),
+		}, {
+			testName: "Not member",
+
+			expStorage:       true,
+			storageInput:     1,
+			storageReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 2, Name: "A"},
+			storageReturnErr: nil,
+
+			expMember:       true,
+			memberReturn:    nil,
+			memberReturnErr: member.ErrNotFound,
+
+			checkInput: checkaccessmodel.NewCheckAccessInput(1, 1),
+
+			expErr:    nil,
+			expOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+		}, {
+			testName: "Invalid user id",
+
+			expStorage: false,
+
+			expMember: false,
+
+			checkInput: checkaccessmodel.NewCheckAccessInput(0, 1),
+
+			expErr:    checkaccesserr.ErrInvalidUserId,
+			expOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+		}, {
+			testName: "Invalid project id",
+
+			expStorage: false,
+
+			expMember: false,
+
+			checkInput: checkaccessmodel.NewCheckAccessInput(1, 0),
+
+			expErr:    checkaccesserr.ErrInvalidProjectId,
+			expOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+		}, {
+			testName: "Not found",
+
+			expStorage:       true,
+			storageInput:     1,
+			storageReturn:    nil,
+			storageReturnErr: storage.ErrNotFound,
+
+			expMember: false,
+
+			checkInput: checkaccessmodel.NewCheckAccessInput(1, 1),
+
+			expErr:    checkaccesserr.ErrProjectNotFound,
+			expOutput: checkaccessmodel.NewCheckAccessOutput(false, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			storageMock := checkaccessmocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storageMock.EXPECT().GetById(gomock.Any(), tt.storageInput).
+					Return(tt.storageReturn, tt.storageReturnErr)
+			}
+
+			memberMock := checkaccessmocks.NewMockMemberRepo(ctrl)
+			if tt.expMember {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.checkInput.ProjectId, tt.checkInput.UserId).
+					Return(tt.memberReturn, tt.memberReturnErr)
+			}
+
+			checkAccessUC := NewCheckAccessUC(log, storageMock, memberMock)
+
+			out, err := checkAccessUC.Execute(context.Background(), tt.checkInput)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -32,25 +32,25 @@ func (c *CreateProjectUC) Execute(ctx context.Context, in *createmodel.CreatePro
 
 	log := c.log.With(slog.String("op", op), slog.Int("id", int(in.OwnerId)))
 
-	log.Info("starting create project")
+	log.InfoContext(ctx, "starting create project")
 
 	proj, err := projectdomain.NewProjectDomain(in.OwnerId, in.Name)
 	if err != nil {
-		log.Info("can't create a project", slog.String("error", err.Error()))
+		log.InfoContext(ctx, "can't create a project", slog.String("error", err.Error()))
 		return createmodel.NewCreateProjectOutput(invalidId), err
 	}
 
 	id, err := c.stor.Save(ctx, proj)
 	if err != nil {
 		if errors.Is(err, storage.ErrAlreadyExists) {
-			log.Info("project already exists")
+			log.InfoContext(ctx, "project already exists")
 			return createmodel.NewCreateProjectOutput(invalidId), createerr.ErrAlreadyExists
 		}
-		log.Warn("error save project", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error save project", slog.String("error", err.Error()))
 		return createmodel.NewCreateProjectOutput(invalidId), err
 	}
 
-	log.Info("project created")
+	log.InfoContext(ctx, "project created")
 
 	return createmodel.NewCreateProjectOutput(id), nil
 }
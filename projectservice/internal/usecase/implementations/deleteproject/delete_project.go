@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
 	"projectservice/internal/repository/storage"
 	deleteerr "projectservice/internal/usecase/error/deleteproject"
 	deletemodel "projectservice/internal/usecase/models/deleteproject"
@@ -12,38 +14,55 @@ import (
 type DeleteProjectUC struct {
 	log *slog.Logger
 
-	stor storage.StorageRepo
+	stor    storage.StorageRepo
+	members member.MemberRepo
 }
 
-func NewDeleteProjectUC(log *slog.Logger, stor storage.StorageRepo) *DeleteProjectUC {
+func NewDeleteProjectUC(log *slog.Logger, stor storage.StorageRepo, members member.MemberRepo) *DeleteProjectUC {
 	return &DeleteProjectUC{
-		log:  log,
-		stor: stor,
+		log:     log,
+		stor:    stor,
+		members: members,
 	}
 }
 
 func (d *DeleteProjectUC) Execute(ctx context.Context, in *deletemodel.DeleteProjectInput) (*deletemodel.DeleteProjectOutput, error) {
 	const op = "deleteproject.Execute"
 
-	log := d.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)), slog.Int("ownerId", int(in.OwnerId)))
+	log := d.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)), slog.Int("userId", int(in.UserId)))
 
-	log.Info("starting delete project")
+	log.InfoContext(ctx, "starting delete project")
 
 	if in.ProjectId == 0 {
 		return deletemodel.NewDeleteProjectOutput(false), deleteerr.ErrInvalidProjectId
 	}
 
-	err := d.stor.Delete(ctx, in.OwnerId, in.ProjectId)
+	actor, err := d.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return deletemodel.NewDeleteProjectOutput(false), deleteerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return deletemodel.NewDeleteProjectOutput(false), err
+	}
+
+	if !memberdomain.CanDeleteProject(actor.Role) {
+		log.InfoContext(ctx, "access denied", slog.String("role", string(actor.Role)))
+		return deletemodel.NewDeleteProjectOutput(false), deleteerr.ErrAccessDenied
+	}
+
+	err = d.stor.Delete(ctx, in.UserId, in.ProjectId)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
-			log.Info("project not found")
+			log.InfoContext(ctx, "project not found")
 			return deletemodel.NewDeleteProjectOutput(false), deleteerr.ErrProjectNotFound
 		}
-		log.Warn("error delete project", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error delete project", slog.String("error", err.Error()))
 		return deletemodel.NewDeleteProjectOutput(false), err
 	}
 
-	log.Info("project deleted")
+	log.InfoContext(ctx, "project deleted")
 
 	return deletemodel.NewDeleteProjectOutput(true), nil
 }
@@ -4,6 +4,8 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
 	"projectservice/internal/repository/storage"
 	deleteerr "projectservice/internal/usecase/error/deleteproject"
 	deletemocks "projectservice/internal/usecase/implementations/deleteproject/mocks"
@@ -15,10 +17,15 @@ import (
 )
 
 //go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=deletemocks
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=deletemocks
 func TestDeleteProject(t *testing.T) {
 	tests := []struct {
 		testName string
 
+		expMember       bool
+		memberReturn    *memberdomain.MemberDomain
+		memberReturnErr error
+
 		expStorage          bool
 		storageInputProjId  uint32
 		storageInputOwnerId uint32
@@ -32,6 +39,10 @@ func TestDeleteProject(t *testing.T) {
 		{
 			testName: "Success",
 
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
 			expStorage:          true,
 			storageInputProjId:  1,
 			storageInputOwnerId: 1,
@@ -44,18 +55,47 @@ func TestDeleteProject(t *testing.T) {
 		}, {
 			testName: "Invalid project id",
 
-			expStorage:          false,
-			storageInputProjId:  0,
-			storageInputOwnerId: 1,
-			storageReturnErr:    storage.ErrNotFound,
+			expMember: false,
+
+			expStorage: false,
 
 			deleteInput: deletemodel.NewDeleteProjectInput(1, 0),
 
 			expErr:    deleteerr.ErrInvalidProjectId,
 			expOutput: deletemodel.NewDeleteProjectOutput(false),
+		}, {
+			testName: "Not member",
+
+			expMember:       true,
+			memberReturn:    nil,
+			memberReturnErr: member.ErrNotFound,
+
+			expStorage: false,
+
+			deleteInput: deletemodel.NewDeleteProjectInput(1, 1),
+
+			expErr:    deleteerr.ErrProjectNotFound,
+			expOutput: deletemodel.NewDeleteProjectOutput(false),
+		}, {
+			testName: "Access denied",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleAdmin},
+			memberReturnErr: nil,
+
+			expStorage: false,
+
+			deleteInput: deletemodel.NewDeleteProjectInput(1, 1),
+
+			expErr:    deleteerr.ErrAccessDenied,
+			expOutput: deletemodel.NewDeleteProjectOutput(false),
 		}, {
 			testName: "Not found",
 
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
 			expStorage:          true,
 			storageInputProjId:  1,
 			storageInputOwnerId: 1,
@@ -75,13 +115,19 @@ func TestDeleteProject(t *testing.T) {
 
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
+			memberMock := deletemocks.NewMockMemberRepo(ctrl)
+			if tt.expMember {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.deleteInput.ProjectId, tt.deleteInput.UserId).
+					Return(tt.memberReturn, tt.memberReturnErr)
+			}
+
 			storageMock := deletemocks.NewMockStorageRepo(ctrl)
 			if tt.expStorage {
 				storageMock.EXPECT().Delete(gomock.Any(), tt.storageInputOwnerId, tt.storageInputProjId).
 					Return(tt.storageReturnErr)
 			}
 
-			deleteUC := NewDeleteProjectUC(log, storageMock)
+			deleteUC := NewDeleteProjectUC(log, storageMock, memberMock)
 
 			out, err := deleteUC.Execute(context.Background(), tt.deleteInput)
 			require.Equal(t, tt.expErr, err)
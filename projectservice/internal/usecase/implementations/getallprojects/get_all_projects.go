@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	pagedomain "projectservice/internal/domain/page"
 	"projectservice/internal/repository/storage"
 	getallerr "projectservice/internal/usecase/error/getallprojects"
 	getallmodel "projectservice/internal/usecase/models/getallprojects"
@@ -25,21 +26,32 @@ func NewGetAllProjectsUC(log *slog.Logger, stor storage.StorageRepo) *GetAllProj
 func (g *GetAllProjectsUC) Execute(ctx context.Context, in *getallmodel.GetAllProjectsInput) (*getallmodel.GetAllProjectsOutput, error) {
 	const op = "getallprojects.Execute"
 
-	log := g.log.With(slog.String("op", op), slog.Int("userId", int(in.OwnerId)))
+	log := g.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)))
 
-	log.Info("starting get all projects request")
+	log.InfoContext(ctx, "starting get all projects request")
 
-	projects, err := g.stor.GetAll(ctx, in.OwnerId)
+	params, err := pagedomain.NewListParams(in.Limit, in.Cursor, in.Sort, in.Direction, in.Name)
+	if err != nil {
+		log.InfoContext(ctx, "invalid list params", slog.String("error", err.Error()))
+		return getallmodel.NewGetAllProjectsOutput(nil, ""), err
+	}
+
+	projects, next, err := g.stor.GetAll(ctx, in.UserId, params)
 	if err != nil {
 		if errors.Is(err, storage.ErrNotFound) {
-			log.Info("projects not found")
-			return getallmodel.NewGetAllProjectsOutput(nil), getallerr.ErrProjectsNotFound
+			log.InfoContext(ctx, "projects not found")
+			return getallmodel.NewGetAllProjectsOutput(nil, ""), getallerr.ErrProjectsNotFound
 		}
-		log.Warn("cannot get projects", slog.String("error", err.Error()))
-		return getallmodel.NewGetAllProjectsOutput(nil), err
+		log.WarnContext(ctx, "cannot get projects", slog.String("error", err.Error()))
+		return getallmodel.NewGetAllProjectsOutput(nil, ""), err
+	}
+
+	var nextCursor string
+	if next != nil {
+		nextCursor = next.Encode()
 	}
 
-	log.Info("projects received")
+	log.InfoContext(ctx, "projects received", slog.Int("count", len(projects)), slog.Bool("hasMore", next != nil))
 
-	return getallmodel.NewGetAllProjectsOutput(projects), nil
+	return getallmodel.NewGetAllProjectsOutput(projects, nextCursor), nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
 	"projectservice/internal/repository/storage"
 	getallerr "projectservice/internal/usecase/error/getallprojects"
@@ -19,12 +20,16 @@ import (
 //go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getallmocks
 func TestGetAllProjects(t *testing.T) {
 	timeNow := time.Now()
+	nextCursor := pagedomain.NewCursor(pagedomain.SortByName, pagedomain.DirectionAsc, 1, "A", timeNow)
 
 	tests := []struct {
 		testName string
 
+		expStorage       bool
 		storageInput     uint32
+		storageParams    *pagedomain.ListParams
 		storageOutput    []*projectdomain.ProjectDomain
+		storageNext      *pagedomain.Cursor
 		storageReturnErr error
 
 		in *getallmodel.GetAllProjectsInput
@@ -35,26 +40,84 @@ func TestGetAllProjects(t *testing.T) {
 		{
 			testName: "Success",
 
+			expStorage:   true,
 			storageInput: 1,
+			storageParams: &pagedomain.ListParams{
+				Limit:     pagedomain.DefaultLimit,
+				Sort:      pagedomain.SortByCreatedAt,
+				Direction: pagedomain.DirectionAsc,
+			},
 			storageOutput: []*projectdomain.ProjectDomain{
 				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
 			},
+			storageNext:      nil,
 			storageReturnErr: nil,
 
-			in: getallmodel.NewGetAllProjectsInput(1),
+			in: getallmodel.NewGetAllProjectsInput(1, 0, "", "", "", ""),
 
 			expErr: nil,
 			expOut: &getallmodel.GetAllProjectsOutput{Projects: []*projectdomain.ProjectDomain{
 				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
 			}},
+		}, {
+			testName: "Has next page",
+
+			expStorage:   true,
+			storageInput: 1,
+			storageParams: &pagedomain.ListParams{
+				Limit:     1,
+				Sort:      pagedomain.SortByName,
+				Direction: pagedomain.DirectionAsc,
+				Name:      "A",
+			},
+			storageOutput: []*projectdomain.ProjectDomain{
+				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
+			},
+			storageNext:      nextCursor,
+			storageReturnErr: nil,
+
+			in: getallmodel.NewGetAllProjectsInput(1, 1, "", "name", "asc", "A"),
+
+			expErr: nil,
+			expOut: &getallmodel.GetAllProjectsOutput{
+				Projects: []*projectdomain.ProjectDomain{
+					&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", CreatedAt: timeNow},
+				},
+				NextCursor: nextCursor.Encode(),
+			},
+		}, {
+			testName: "Invalid cursor",
+
+			expStorage: false,
+
+			in: getallmodel.NewGetAllProjectsInput(1, 0, "not-a-cursor", "", "", ""),
+
+			expErr: pagedomain.ErrInvalidCursor,
+			expOut: &getallmodel.GetAllProjectsOutput{Projects: nil},
+		}, {
+			testName: "Invalid sort",
+
+			expStorage: false,
+
+			in: getallmodel.NewGetAllProjectsInput(1, 0, "", "owner", "", ""),
+
+			expErr: pagedomain.ErrInvalidSort,
+			expOut: &getallmodel.GetAllProjectsOutput{Projects: nil},
 		}, {
 			testName: "Not found",
 
-			storageInput:     1,
+			expStorage:   true,
+			storageInput: 1,
+			storageParams: &pagedomain.ListParams{
+				Limit:     pagedomain.DefaultLimit,
+				Sort:      pagedomain.SortByCreatedAt,
+				Direction: pagedomain.DirectionAsc,
+			},
 			storageOutput:    nil,
+			storageNext:      nil,
 			storageReturnErr: storage.ErrNotFound,
 
-			in: getallmodel.NewGetAllProjectsInput(1),
+			in: getallmodel.NewGetAllProjectsInput(1, 0, "", "", "", ""),
 
 			expErr: getallerr.ErrProjectsNotFound,
 			expOut: &getallmodel.GetAllProjectsOutput{Projects: nil},
@@ -69,8 +132,10 @@ func TestGetAllProjects(t *testing.T) {
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
 			storageMock := getallmocks.NewMockStorageRepo(ctrl)
-			storageMock.EXPECT().GetAll(gomock.Any(), tt.storageInput).
-				Return(tt.storageOutput, tt.storageReturnErr)
+			if tt.expStorage {
+				storageMock.EXPECT().GetAll(gomock.Any(), tt.storageInput, tt.storageParams).
+					Return(tt.storageOutput, tt.storageNext, tt.storageReturnErr)
+			}
 
 			handl := NewGetAllProjectsUC(log, storageMock)
 
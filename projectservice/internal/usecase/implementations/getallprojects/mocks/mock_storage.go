@@ -11,6 +11,7 @@ package getallmocks
 
 import (
 	context "context"
+	pagedomain "projectservice/internal/domain/page"
 	projectdomain "projectservice/internal/domain/project"
 	reflect "reflect"
 
@@ -56,18 +57,34 @@ func (mr *MockStorageRepoMockRecorder) Delete(ctx, ownerId, projectId any) *gomo
 }
 
 // GetAll mocks base method.
-func (m *MockStorageRepo) GetAll(ctx context.Context, ownerId uint32) ([]*projectdomain.ProjectDomain, error) {
+func (m *MockStorageRepo) GetAll(ctx context.Context, userId uint32, params *pagedomain.ListParams) ([]*projectdomain.ProjectDomain, *pagedomain.Cursor, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx, ownerId)
+	ret := m.ctrl.Call(m, "GetAll", ctx, userId, params)
 	ret0, _ := ret[0].([]*projectdomain.ProjectDomain)
+	ret1, _ := ret[1].(*pagedomain.Cursor)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStorageRepoMockRecorder) GetAll(ctx, userId, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStorageRepo)(nil).GetAll), ctx, userId, params)
+}
+
+// GetById mocks base method.
+func (m *MockStorageRepo) GetById(ctx context.Context, projectId uint32) (*projectdomain.ProjectDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, projectId)
+	ret0, _ := ret[0].(*projectdomain.ProjectDomain)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAll indicates an expected call of GetAll.
-func (mr *MockStorageRepoMockRecorder) GetAll(ctx, ownerId any) *gomock.Call {
+// GetById indicates an expected call of GetById.
+func (mr *MockStorageRepoMockRecorder) GetById(ctx, projectId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStorageRepo)(nil).GetAll), ctx, ownerId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockStorageRepo)(nil).GetById), ctx, projectId)
 }
 
 // Save mocks base method.
@@ -84,3 +101,18 @@ func (mr *MockStorageRepoMockRecorder) Save(ctx, proj any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStorageRepo)(nil).Save), ctx, proj)
 }
+
+// Update mocks base method.
+func (m *MockStorageRepo) Update(ctx context.Context, proj *projectdomain.ProjectDomain) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, proj)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStorageRepoMockRecorder) Update(ctx, proj any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStorageRepo)(nil).Update), ctx, proj)
+}
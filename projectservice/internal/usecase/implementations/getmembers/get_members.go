@@ -0,0 +1,57 @@
+package getmembers
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"projectservice/internal/repository/member"
+	getmemberserr "projectservice/internal/usecase/error/getmembers"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+)
+
+type GetMembersUC struct {
+	log *slog.Logger
+
+	members member.MemberRepo
+}
+
+func NewGetMembersUC(log *slog.Logger, members member.MemberRepo) *GetMembersUC {
+	return &GetMembersUC{
+		log:     log,
+		members: members,
+	}
+}
+
+func (g *GetMembersUC) Execute(ctx context.Context, in *getmembersmodel.GetMembersInput) (*getmembersmodel.GetMembersOutput, error) {
+	const op = "getmembers.Execute"
+	log := g.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("projectId", int(in.ProjectId)))
+
+	log.InfoContext(ctx, "starting get members")
+
+	if in.ProjectId == 0 {
+		return getmembersmodel.NewGetMembersOutput(nil), getmemberserr.ErrInvalidProjectId
+	}
+
+	if _, err := g.members.GetMember(ctx, in.ProjectId, in.UserId); err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return getmembersmodel.NewGetMembersOutput(nil), getmemberserr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return getmembersmodel.NewGetMembersOutput(nil), err
+	}
+
+	members, err := g.members.GetMembers(ctx, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return getmembersmodel.NewGetMembersOutput(nil), getmemberserr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get members", slog.String("error", err.Error()))
+		return getmembersmodel.NewGetMembersOutput(nil), err
+	}
+
+	log.InfoContext(ctx, "members received")
+
+	return getmembersmodel.NewGetMembersOutput(members), nil
+}
@@ -0,0 +1,108 @@
+package getmembers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	getmemberserr "projectservice/internal/usecase/error/getmembers"
+	getmembersmocks "projectservice/internal/usecase/implementations/getmembers/mocks"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=getmembersmocks
+func TestGetMembers(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expActor       bool
+		actorReturnErr error
+
+		expMembers       bool
+		membersReturn    []*memberdomain.MemberDomain
+		membersReturnErr error
+
+		in *getmembersmodel.GetMembersInput
+
+		expErr    error
+		expOutput *getmembersmodel.GetMembersOutput
+	}{
+		{
+			testName: "Success",
+
+			expActor:       true,
+			actorReturnErr: nil,
+
+			expMembers: true,
+			membersReturn: []*memberdomain.MemberDomain{
+				{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner, CreatedAt: timeNow},
+				{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer, CreatedAt: timeNow},
+			},
+			membersReturnErr: nil,
+
+			in: getmembersmodel.NewGetMembersInput(2, 1),
+
+			expErr: nil,
+			expOutput: getmembersmodel.NewGetMembersOutput([]*memberdomain.MemberDomain{
+				{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner, CreatedAt: timeNow},
+				{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer, CreatedAt: timeNow},
+			}),
+		}, {
+			testName: "Invalid project id",
+
+			expActor: false,
+
+			expMembers: false,
+
+			in: getmembersmodel.NewGetMembersInput(1, 0),
+
+			expErr:    getmemberserr.ErrInvalidProjectId,
+			expOutput: getmembersmodel.NewGetMembersOutput(nil),
+		}, {
+			testName: "Not member",
+
+			expActor:       true,
+			actorReturnErr: member.ErrNotFound,
+
+			expMembers: false,
+
+			in: getmembersmodel.NewGetMembersInput(1, 1),
+
+			expErr:    getmemberserr.ErrProjectNotFound,
+			expOutput: getmembersmodel.NewGetMembersOutput(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			memberMock := getmembersmocks.NewMockMemberRepo(ctrl)
+			if tt.expActor {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.UserId).
+					Return(&memberdomain.MemberDomain{ProjectId: tt.in.ProjectId, UserId: tt.in.UserId}, tt.actorReturnErr)
+			}
+			if tt.expMembers {
+				memberMock.EXPECT().GetMembers(gomock.Any(), tt.in.ProjectId).
+					Return(tt.membersReturn, tt.membersReturnErr)
+			}
+
+			getMembersUC := NewGetMembersUC(log, memberMock)
+
+			out, err := getMembersUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package getproject
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"projectservice/internal/repository/storage"
+	geterr "projectservice/internal/usecase/error/getproject"
+	getmodel "projectservice/internal/usecase/models/getproject"
+)
+
+type GetProjectUC struct {
+	log *slog.Logger
+
+	stor storage.StorageRepo
+}
+
+func NewGetProjectUC(log *slog.Logger, stor storage.StorageRepo) *GetProjectUC {
+	return &GetProjectUC{
+		log:  log,
+		stor: stor,
+	}
+}
+
+func (g *GetProjectUC) Execute(ctx context.Context, in *getmodel.GetProjectInput) (*getmodel.GetProjectOutput, error) {
+	const op = "getproject.Execute"
+
+	log := g.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)))
+
+	log.InfoContext(ctx, "starting get project")
+
+	if in.ProjectId == 0 {
+		return getmodel.NewGetProjectOutput(nil), geterr.ErrInvalidProjectId
+	}
+
+	project, err := g.stor.GetById(ctx, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return getmodel.NewGetProjectOutput(nil), geterr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "cannot get project", slog.String("error", err.Error()))
+		return getmodel.NewGetProjectOutput(nil), err
+	}
+
+	log.InfoContext(ctx, "project received")
+
+	return getmodel.NewGetProjectOutput(project), nil
+}
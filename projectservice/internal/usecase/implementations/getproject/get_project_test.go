@@ -0,0 +1,89 @@
+package getproject
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	projectdomain "projectservice/internal/domain/project"
+	"projectservice/internal/repository/storage"
+	geterr "projectservice/internal/usecase/error/getproject"
+	getmocks "projectservice/internal/usecase/implementations/getproject/mocks"
+	getmodel "projectservice/internal/usecase/models/getproject"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getmocks
+func TestGetProject(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expStorage       bool
+		storageInput     uint32
+		storageReturn    *projectdomain.ProjectDomain
+		storageReturnErr error
+
+		getInput *getmodel.GetProjectInput
+
+		expErr    error
+		expOutput *getmodel.GetProjectOutput
+	}{
+		{
+			testName: "Success",
+
+			expStorage:       true,
+			storageInput:     1,
+			storageReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A"},
+			storageReturnErr: nil,
+
+			getInput: getmodel.NewGetProjectInput(1),
+
+			expErr:    nil,
+			expOutput: getmodel.NewGetProjectOutput(&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A"}),
+		}, {
+			testName: "Invalid project id",
+
+			expStorage: false,
+
+			getInput: getmodel.NewGetProjectInput(0),
+
+			expErr:    geterr.ErrInvalidProjectId,
+			expOutput: getmodel.NewGetProjectOutput(nil),
+		}, {
+			testName: "Not found",
+
+			expStorage:       true,
+			storageInput:     1,
+			storageReturn:    nil,
+			storageReturnErr: storage.ErrNotFound,
+
+			getInput: getmodel.NewGetProjectInput(1),
+
+			expErr:    geterr.ErrProjectNotFound,
+			expOutput: getmodel.NewGetProjectOutput(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			storageMock := getmocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storageMock.EXPECT().GetById(gomock.Any(), tt.storageInput).
+					Return(tt.storageReturn, tt.storageReturnErr)
+			}
+
+			getUC := NewGetProjectUC(log, storageMock)
+
+			out, err := getUC.Execute(context.Background(), tt.getInput)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
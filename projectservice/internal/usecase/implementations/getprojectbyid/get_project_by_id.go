@@ -0,0 +1,62 @@
+package getprojectbyid
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"projectservice/internal/repository/member"
+	"projectservice/internal/repository/storage"
+	getbyiderr "projectservice/internal/usecase/error/getprojectbyid"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+)
+
+type GetProjectByIdUC struct {
+	log *slog.Logger
+
+	stor    storage.StorageRepo
+	members member.MemberRepo
+}
+
+func NewGetProjectByIdUC(log *slog.Logger, stor storage.StorageRepo, members member.MemberRepo) *GetProjectByIdUC {
+	return &GetProjectByIdUC{
+		log:     log,
+		stor:    stor,
+		members: members,
+	}
+}
+
+func (g *GetProjectByIdUC) Execute(ctx context.Context, in *getbyidmodel.GetProjectByIdInput) (*getbyidmodel.GetProjectByIdOutput, error) {
+	const op = "getprojectbyid.Execute"
+
+	log := g.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)), slog.Int("userId", int(in.UserId)))
+
+	log.InfoContext(ctx, "starting get project by id")
+
+	if in.ProjectId == 0 {
+		return getbyidmodel.NewGetProjectByIdOutput(nil, ""), getbyiderr.ErrInvalidProjectId
+	}
+
+	actor, err := g.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return getbyidmodel.NewGetProjectByIdOutput(nil, ""), getbyiderr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return getbyidmodel.NewGetProjectByIdOutput(nil, ""), err
+	}
+
+	project, err := g.stor.GetById(ctx, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return getbyidmodel.NewGetProjectByIdOutput(nil, ""), getbyiderr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get project", slog.String("error", err.Error()))
+		return getbyidmodel.NewGetProjectByIdOutput(nil, ""), err
+	}
+
+	log.InfoContext(ctx, "project received")
+
+	return getbyidmodel.NewGetProjectByIdOutput(project, actor.Role), nil
+}
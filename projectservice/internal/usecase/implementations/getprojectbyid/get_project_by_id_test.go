@@ -0,0 +1,122 @@
+package getprojectbyid
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	projectdomain "projectservice/internal/domain/project"
+	"projectservice/internal/repository/member"
+	"projectservice/internal/repository/storage"
+	getbyiderr "projectservice/internal/usecase/error/getprojectbyid"
+	getbyidmocks "projectservice/internal/usecase/implementations/getprojectbyid/mocks"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getbyidmocks
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=getbyidmocks
+func TestGetProjectById(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expMember       bool
+		memberReturn    *memberdomain.MemberDomain
+		memberReturnErr error
+
+		expStorage       bool
+		storageReturn    *projectdomain.ProjectDomain
+		storageReturnErr error
+
+		in *getbyidmodel.GetProjectByIdInput
+
+		expErr    error
+		expOutput *getbyidmodel.GetProjectByIdOutput
+	}{
+		{
+			testName: "Success",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer},
+			memberReturnErr: nil,
+
+			expStorage:       true,
+			storageReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", Version: 1, CreatedAt: timeNow},
+			storageReturnErr: nil,
+
+			in: getbyidmodel.NewGetProjectByIdInput(2, 1),
+
+			expErr: nil,
+			expOutput: getbyidmodel.NewGetProjectByIdOutput(
+				&projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "A", Version: 1, CreatedAt: timeNow},
+				memberdomain.RoleViewer,
+			),
+		}, {
+			testName: "Invalid project id",
+
+			in: getbyidmodel.NewGetProjectByIdInput(1, 0),
+
+			expErr:    getbyiderr.ErrInvalidProjectId,
+			expOutput: getbyidmodel.NewGetProjectByIdOutput(nil, ""),
+		}, {
+			testName: "Not member",
+
+			expMember:       true,
+			memberReturn:    nil,
+			memberReturnErr: member.ErrNotFound,
+
+			in: getbyidmodel.NewGetProjectByIdInput(2, 1),
+
+			expErr:    getbyiderr.ErrProjectNotFound,
+			expOutput: getbyidmodel.NewGetProjectByIdOutput(nil, ""),
+		}, {
+			testName: "Not found",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
+			expStorage:       true,
+			storageReturn:    nil,
+			storageReturnErr: storage.ErrNotFound,
+
+			in: getbyidmodel.NewGetProjectByIdInput(2, 1),
+
+			expErr:    getbyiderr.ErrProjectNotFound,
+			expOutput: getbyidmodel.NewGetProjectByIdOutput(nil, ""),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			memberMock := getbyidmocks.NewMockMemberRepo(ctrl)
+			if tt.expMember {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.UserId).
+					Return(tt.memberReturn, tt.memberReturnErr)
+			}
+
+			storageMock := getbyidmocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storageMock.EXPECT().GetById(gomock.Any(), tt.in.ProjectId).
+					Return(tt.storageReturn, tt.storageReturnErr)
+			}
+
+			getByIdUC := NewGetProjectByIdUC(log, storageMock, memberMock)
+
+			out, err := getByIdUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package invitemember
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	inviteerr "projectservice/internal/usecase/error/invitemember"
+	invitemodel "projectservice/internal/usecase/models/invitemember"
+)
+
+type InviteMemberUC struct {
+	log *slog.Logger
+
+	members member.MemberRepo
+}
+
+func NewInviteMemberUC(log *slog.Logger, members member.MemberRepo) *InviteMemberUC {
+	return &InviteMemberUC{
+		log:     log,
+		members: members,
+	}
+}
+
+func (i *InviteMemberUC) Execute(ctx context.Context, in *invitemodel.InviteMemberInput) (*invitemodel.InviteMemberOutput, error) {
+	const op = "invitemember.Execute"
+	log := i.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("projectId", int(in.ProjectId)), slog.Int("memberId", int(in.MemberId)))
+
+	log.InfoContext(ctx, "starting invite member")
+
+	newMember, err := memberdomain.NewMemberDomain(in.ProjectId, in.MemberId, memberdomain.Role(in.Role))
+	if err != nil {
+		log.InfoContext(ctx, "can't create a member", slog.String("error", err.Error()))
+		return invitemodel.NewInviteMemberOutput(false), err
+	}
+
+	actor, err := i.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return invitemodel.NewInviteMemberOutput(false), inviteerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return invitemodel.NewInviteMemberOutput(false), err
+	}
+
+	if !memberdomain.CanManage(actor.Role, newMember.Role) {
+		log.InfoContext(ctx, "access denied", slog.String("role", string(actor.Role)))
+		return invitemodel.NewInviteMemberOutput(false), inviteerr.ErrAccessDenied
+	}
+
+	if err := i.members.AddMember(ctx, newMember); err != nil {
+		if errors.Is(err, member.ErrAlreadyExists) {
+			log.InfoContext(ctx, "member already exists")
+			return invitemodel.NewInviteMemberOutput(false), inviteerr.ErrMemberAlreadyExists
+		} else if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return invitemodel.NewInviteMemberOutput(false), inviteerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error add member", slog.String("error", err.Error()))
+		return invitemodel.NewInviteMemberOutput(false), err
+	}
+
+	log.InfoContext(ctx, "member invited")
+
+	return invitemodel.NewInviteMemberOutput(true), nil
+}
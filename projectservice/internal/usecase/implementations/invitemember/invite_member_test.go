@@ -0,0 +1,139 @@
+package invitemember
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	inviteerr "projectservice/internal/usecase/error/invitemember"
+	invitemocks "projectservice/internal/usecase/implementations/invitemember/mocks"
+	invitemodel "projectservice/internal/usecase/models/invitemember"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=invitemocks
+func TestInviteMember(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expActor       bool
+		actorReturn    *memberdomain.MemberDomain
+		actorReturnErr error
+
+		expAdd       bool
+		addReturnErr error
+
+		in *invitemodel.InviteMemberInput
+
+		expErr    error
+		expOutput *invitemodel.InviteMemberOutput
+	}{
+		{
+			testName: "Success",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			actorReturnErr: nil,
+
+			expAdd:       true,
+			addReturnErr: nil,
+
+			in: invitemodel.NewInviteMemberInput(1, 1, 2, "admin"),
+
+			expErr:    nil,
+			expOutput: invitemodel.NewInviteMemberOutput(true),
+		}, {
+			testName: "Invalid role",
+
+			expActor: false,
+
+			expAdd: false,
+
+			in: invitemodel.NewInviteMemberInput(1, 1, 2, "superuser"),
+
+			expErr:    memberdomain.ErrInvalidRole,
+			expOutput: invitemodel.NewInviteMemberOutput(false),
+		}, {
+			testName: "Invalid project id",
+
+			expActor: false,
+
+			expAdd: false,
+
+			in: invitemodel.NewInviteMemberInput(1, 0, 2, "member"),
+
+			expErr:    memberdomain.ErrInvalidProjectId,
+			expOutput: invitemodel.NewInviteMemberOutput(false),
+		}, {
+			testName: "Not member",
+
+			expActor:       true,
+			actorReturn:    nil,
+			actorReturnErr: member.ErrNotFound,
+
+			expAdd: false,
+
+			in: invitemodel.NewInviteMemberInput(1, 1, 2, "member"),
+
+			expErr:    inviteerr.ErrProjectNotFound,
+			expOutput: invitemodel.NewInviteMemberOutput(false),
+		}, {
+			testName: "Admin invites admin",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleAdmin},
+			actorReturnErr: nil,
+
+			expAdd: false,
+
+			in: invitemodel.NewInviteMemberInput(1, 1, 2, "admin"),
+
+			expErr:    inviteerr.ErrAccessDenied,
+			expOutput: invitemodel.NewInviteMemberOutput(false),
+		}, {
+			testName: "Already exists",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleAdmin},
+			actorReturnErr: nil,
+
+			expAdd:       true,
+			addReturnErr: member.ErrAlreadyExists,
+
+			in: invitemodel.NewInviteMemberInput(1, 1, 2, "viewer"),
+
+			expErr:    inviteerr.ErrMemberAlreadyExists,
+			expOutput: invitemodel.NewInviteMemberOutput(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			memberMock := invitemocks.NewMockMemberRepo(ctrl)
+			if tt.expActor {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.UserId).
+					Return(tt.actorReturn, tt.actorReturnErr)
+			}
+			if tt.expAdd {
+				memberMock.EXPECT().AddMember(gomock.Any(), gomock.Cond(func(m *memberdomain.MemberDomain) bool {
+					return m.ProjectId == tt.in.ProjectId && m.UserId == tt.in.MemberId && string(m.Role) == tt.in.Role
+				})).Return(tt.addReturnErr)
+			}
+
+			inviteUC := NewInviteMemberUC(log, memberMock)
+
+			out, err := inviteUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
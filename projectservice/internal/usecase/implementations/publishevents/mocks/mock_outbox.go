@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/outbox/outbox.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/outbox/outbox.go -destination=./mocks/mock_outbox.go -package=publishmocks
+//
+
+// Package publishmocks is a generated GoMock package.
+package publishmocks
+
+import (
+	context "context"
+	eventdomain "projectservice/internal/domain/event"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockOutboxRepo is a mock of OutboxRepo interface.
+type MockOutboxRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockOutboxRepoMockRecorder is the mock recorder for MockOutboxRepo.
+type MockOutboxRepoMockRecorder struct {
+	mock *MockOutboxRepo
+}
+
+// NewMockOutboxRepo creates a new mock instance.
+func NewMockOutboxRepo(ctrl *gomock.Controller) *MockOutboxRepo {
+	mock := &MockOutboxRepo{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepo) EXPECT() *MockOutboxRepoMockRecorder {
+	return m.recorder
+}
+
+// FetchUnpublished mocks base method.
+func (m *MockOutboxRepo) FetchUnpublished(ctx context.Context, limit uint32) ([]*eventdomain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FetchUnpublished", ctx, limit)
+	ret0, _ := ret[0].([]*eventdomain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FetchUnpublished indicates an expected call of FetchUnpublished.
+func (mr *MockOutboxRepoMockRecorder) FetchUnpublished(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchUnpublished", reflect.TypeOf((*MockOutboxRepo)(nil).FetchUnpublished), ctx, limit)
+}
+
+// MarkPublished mocks base method.
+func (m *MockOutboxRepo) MarkPublished(ctx context.Context, eventId uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPublished", ctx, eventId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkPublished indicates an expected call of MarkPublished.
+func (mr *MockOutboxRepoMockRecorder) MarkPublished(ctx, eventId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPublished", reflect.TypeOf((*MockOutboxRepo)(nil).MarkPublished), ctx, eventId)
+}
@@ -0,0 +1,57 @@
+package publishevents
+
+import (
+	"context"
+	"log/slog"
+	"projectservice/internal/repository/eventpublisher"
+	"projectservice/internal/repository/outbox"
+	publishmodel "projectservice/internal/usecase/models/publishevents"
+)
+
+type PublishEventsUC struct {
+	log *slog.Logger
+
+	outbox    outbox.OutboxRepo
+	publisher eventpublisher.EventPublisher
+}
+
+func NewPublishEventsUC(log *slog.Logger, outbox outbox.OutboxRepo, publisher eventpublisher.EventPublisher) *PublishEventsUC {
+	return &PublishEventsUC{
+		log:       log,
+		outbox:    outbox,
+		publisher: publisher,
+	}
+}
+
+func (p *PublishEventsUC) Execute(ctx context.Context, in *publishmodel.PublishEventsInput) (*publishmodel.PublishEventsOutput, error) {
+	const op = "publishevents.Execute"
+
+	log := p.log.With(slog.String("op", op))
+
+	events, err := p.outbox.FetchUnpublished(ctx, in.Limit)
+	if err != nil {
+		log.WarnContext(ctx, "cannot fetch unpublished events", slog.String("error", err.Error()))
+		return publishmodel.NewPublishEventsOutput(0), err
+	}
+
+	var published uint32
+	for _, event := range events {
+		if err := p.publisher.Publish(ctx, event); err != nil {
+			log.WarnContext(ctx, "cannot publish event", slog.Uint64("eventId", event.Id), slog.String("error", err.Error()))
+			return publishmodel.NewPublishEventsOutput(published), err
+		}
+
+		if err := p.outbox.MarkPublished(ctx, event.Id); err != nil {
+			log.WarnContext(ctx, "cannot mark event as published", slog.Uint64("eventId", event.Id), slog.String("error", err.Error()))
+			return publishmodel.NewPublishEventsOutput(published), err
+		}
+
+		published++
+	}
+
+	if published > 0 {
+		log.InfoContext(ctx, "events published", slog.Int("count", int(published)))
+	}
+
+	return publishmodel.NewPublishEventsOutput(published), nil
+}
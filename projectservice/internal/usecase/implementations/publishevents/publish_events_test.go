@@ -0,0 +1,104 @@
+package publishevents
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	eventdomain "projectservice/internal/domain/event"
+	publishmocks "projectservice/internal/usecase/implementations/publishevents/mocks"
+	publishmodel "projectservice/internal/usecase/models/publishevents"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/outbox/outbox.go -destination=./mocks/mock_outbox.go -package=publishmocks
+//go:generate mockgen -source=./../../../repository/eventpublisher/event_publisher.go -destination=./mocks/mock_event_publisher.go -package=publishmocks
+func TestPublishEvents(t *testing.T) {
+	publishErr := errors.New("publish error")
+
+	events := []*eventdomain.Event{
+		{Id: 1, Type: eventdomain.ProjectDeletedType, Payload: []byte(`{"project_id":1,"owner_id":1}`)},
+		{Id: 2, Type: eventdomain.ProjectDeletedType, Payload: []byte(`{"project_id":2,"owner_id":1}`)},
+	}
+
+	tests := []struct {
+		testName string
+
+		fetchReturn    []*eventdomain.Event
+		fetchReturnErr error
+
+		publishErrOnId uint64
+
+		expMarked []uint64
+
+		expErr    error
+		expOutput *publishmodel.PublishEventsOutput
+	}{
+		{
+			testName: "Success",
+
+			fetchReturn:    events,
+			fetchReturnErr: nil,
+
+			expMarked: []uint64{1, 2},
+
+			expErr:    nil,
+			expOutput: publishmodel.NewPublishEventsOutput(2),
+		}, {
+			testName: "Nothing to publish",
+
+			fetchReturn:    nil,
+			fetchReturnErr: nil,
+
+			expErr:    nil,
+			expOutput: publishmodel.NewPublishEventsOutput(0),
+		}, {
+			testName: "Publish error stops batch",
+
+			fetchReturn:    events,
+			fetchReturnErr: nil,
+
+			publishErrOnId: 2,
+
+			expMarked: []uint64{1},
+
+			expErr:    publishErr,
+			expOutput: publishmodel.NewPublishEventsOutput(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			outboxMock := publishmocks.NewMockOutboxRepo(ctrl)
+			publisherMock := publishmocks.NewMockEventPublisher(ctrl)
+
+			outboxMock.EXPECT().FetchUnpublished(gomock.Any(), uint32(10)).
+				Return(tt.fetchReturn, tt.fetchReturnErr)
+
+			for _, event := range tt.fetchReturn {
+				if tt.publishErrOnId == event.Id {
+					publisherMock.EXPECT().Publish(gomock.Any(), event).Return(publishErr)
+					break
+				}
+				publisherMock.EXPECT().Publish(gomock.Any(), event).Return(nil)
+			}
+			for _, id := range tt.expMarked {
+				outboxMock.EXPECT().MarkPublished(gomock.Any(), id).Return(nil)
+			}
+
+			publishUC := NewPublishEventsUC(log, outboxMock, publisherMock)
+
+			out, err := publishUC.Execute(context.Background(), publishmodel.NewPublishEventsInput(10))
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package removemember
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	removeerr "projectservice/internal/usecase/error/removemember"
+	removemodel "projectservice/internal/usecase/models/removemember"
+)
+
+type RemoveMemberUC struct {
+	log *slog.Logger
+
+	members member.MemberRepo
+}
+
+func NewRemoveMemberUC(log *slog.Logger, members member.MemberRepo) *RemoveMemberUC {
+	return &RemoveMemberUC{
+		log:     log,
+		members: members,
+	}
+}
+
+func (r *RemoveMemberUC) Execute(ctx context.Context, in *removemodel.RemoveMemberInput) (*removemodel.RemoveMemberOutput, error) {
+	const op = "removemember.Execute"
+	log := r.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("projectId", int(in.ProjectId)), slog.Int("memberId", int(in.MemberId)))
+
+	log.InfoContext(ctx, "starting remove member")
+
+	if in.ProjectId == 0 {
+		return removemodel.NewRemoveMemberOutput(false), removeerr.ErrInvalidProjectId
+	}
+	if in.MemberId == 0 {
+		return removemodel.NewRemoveMemberOutput(false), removeerr.ErrInvalidMemberId
+	}
+
+	actor, err := r.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return removemodel.NewRemoveMemberOutput(false), removeerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return removemodel.NewRemoveMemberOutput(false), err
+	}
+
+	if in.MemberId == in.UserId {
+		if actor.Role == memberdomain.RoleOwner {
+			log.InfoContext(ctx, "owner cannot leave the project")
+			return removemodel.NewRemoveMemberOutput(false), removeerr.ErrCannotRemoveOwner
+		}
+	} else {
+		target, err := r.members.GetMember(ctx, in.ProjectId, in.MemberId)
+		if err != nil {
+			if errors.Is(err, member.ErrNotFound) {
+				log.InfoContext(ctx, "member not found")
+				return removemodel.NewRemoveMemberOutput(false), removeerr.ErrMemberNotFound
+			}
+			log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+			return removemodel.NewRemoveMemberOutput(false), err
+		}
+
+		if target.Role == memberdomain.RoleOwner {
+			log.InfoContext(ctx, "owner cannot be removed")
+			return removemodel.NewRemoveMemberOutput(false), removeerr.ErrCannotRemoveOwner
+		}
+		if !memberdomain.CanManage(actor.Role, target.Role) {
+			log.InfoContext(ctx, "access denied", slog.String("role", string(actor.Role)))
+			return removemodel.NewRemoveMemberOutput(false), removeerr.ErrAccessDenied
+		}
+	}
+
+	if err := r.members.DeleteMember(ctx, in.ProjectId, in.MemberId); err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "member not found")
+			return removemodel.NewRemoveMemberOutput(false), removeerr.ErrMemberNotFound
+		}
+		log.WarnContext(ctx, "error delete member", slog.String("error", err.Error()))
+		return removemodel.NewRemoveMemberOutput(false), err
+	}
+
+	log.InfoContext(ctx, "member removed")
+
+	return removemodel.NewRemoveMemberOutput(true), nil
+}
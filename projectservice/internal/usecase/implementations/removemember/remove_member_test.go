@@ -0,0 +1,161 @@
+package removemember
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	removeerr "projectservice/internal/usecase/error/removemember"
+	removemocks "projectservice/internal/usecase/implementations/removemember/mocks"
+	removemodel "projectservice/internal/usecase/models/removemember"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=removemocks
+func TestRemoveMember(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expActor       bool
+		actorReturn    *memberdomain.MemberDomain
+		actorReturnErr error
+
+		expTarget       bool
+		targetReturn    *memberdomain.MemberDomain
+		targetReturnErr error
+
+		expDelete       bool
+		deleteReturnErr error
+
+		in *removemodel.RemoveMemberInput
+
+		expErr    error
+		expOutput *removemodel.RemoveMemberOutput
+	}{
+		{
+			testName: "Success",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleAdmin},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer},
+			targetReturnErr: nil,
+
+			expDelete:       true,
+			deleteReturnErr: nil,
+
+			in: removemodel.NewRemoveMemberInput(1, 1, 2),
+
+			expErr:    nil,
+			expOutput: removemodel.NewRemoveMemberOutput(true),
+		}, {
+			testName: "Leave project",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer},
+			actorReturnErr: nil,
+
+			expDelete:       true,
+			deleteReturnErr: nil,
+
+			in: removemodel.NewRemoveMemberInput(2, 1, 2),
+
+			expErr:    nil,
+			expOutput: removemodel.NewRemoveMemberOutput(true),
+		}, {
+			testName: "Owner leaves project",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			actorReturnErr: nil,
+
+			in: removemodel.NewRemoveMemberInput(1, 1, 1),
+
+			expErr:    removeerr.ErrCannotRemoveOwner,
+			expOutput: removemodel.NewRemoveMemberOutput(false),
+		}, {
+			testName: "Remove owner",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleAdmin},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			targetReturnErr: nil,
+
+			in: removemodel.NewRemoveMemberInput(2, 1, 1),
+
+			expErr:    removeerr.ErrCannotRemoveOwner,
+			expOutput: removemodel.NewRemoveMemberOutput(false),
+		}, {
+			testName: "Access denied",
+
+			expActor:       true,
+			actorReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleMember},
+			actorReturnErr: nil,
+
+			expTarget:       true,
+			targetReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 2, Role: memberdomain.RoleViewer},
+			targetReturnErr: nil,
+
+			in: removemodel.NewRemoveMemberInput(1, 1, 2),
+
+			expErr:    removeerr.ErrAccessDenied,
+			expOutput: removemodel.NewRemoveMemberOutput(false),
+		}, {
+			testName: "Not member",
+
+			expActor:       true,
+			actorReturn:    nil,
+			actorReturnErr: member.ErrNotFound,
+
+			in: removemodel.NewRemoveMemberInput(1, 1, 2),
+
+			expErr:    removeerr.ErrProjectNotFound,
+			expOutput: removemodel.NewRemoveMemberOutput(false),
+		}, {
+			testName: "Invalid member id",
+
+			in: removemodel.NewRemoveMemberInput(1, 1, 0),
+
+			expErr:    removeerr.ErrInvalidMemberId,
+			expOutput: removemodel.NewRemoveMemberOutput(false),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			memberMock := removemocks.NewMockMemberRepo(ctrl)
+			if tt.expActor {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.UserId).
+					Return(tt.actorReturn, tt.actorReturnErr)
+			}
+			if tt.expTarget {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.MemberId).
+					Return(tt.targetReturn, tt.targetReturnErr)
+			}
+			if tt.expDelete {
+				memberMock.EXPECT().DeleteMember(gomock.Any(), tt.in.ProjectId, tt.in.MemberId).
+					Return(tt.deleteReturnErr)
+			}
+
+			removeUC := NewRemoveMemberUC(log, memberMock)
+
+			out, err := removeUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package updateproject
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	"projectservice/internal/repository/member"
+	"projectservice/internal/repository/storage"
+	updateerr "projectservice/internal/usecase/error/updateproject"
+	updatemodel "projectservice/internal/usecase/models/updateproject"
+)
+
+type UpdateProjectUC struct {
+	log *slog.Logger
+
+	stor    storage.StorageRepo
+	members member.MemberRepo
+}
+
+func NewUpdateProjectUC(log *slog.Logger, stor storage.StorageRepo, members member.MemberRepo) *UpdateProjectUC {
+	return &UpdateProjectUC{
+		log:     log,
+		stor:    stor,
+		members: members,
+	}
+}
+
+func (u *UpdateProjectUC) Execute(ctx context.Context, in *updatemodel.UpdateProjectInput) (*updatemodel.UpdateProjectOutput, error) {
+	const op = "updateproject.Execute"
+
+	log := u.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)), slog.Int("userId", int(in.UserId)))
+
+	log.InfoContext(ctx, "starting update project")
+
+	if in.ProjectId == 0 {
+		return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrInvalidProjectId
+	}
+	if in.Version == 0 {
+		return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrInvalidVersion
+	}
+	if in.Name == nil {
+		return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrNothingToUpdate
+	}
+
+	actor, err := u.members.GetMember(ctx, in.ProjectId, in.UserId)
+	if err != nil {
+		if errors.Is(err, member.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get member", slog.String("error", err.Error()))
+		return updatemodel.NewUpdateProjectOutput(false, 0), err
+	}
+
+	if !memberdomain.CanEditProject(actor.Role) {
+		log.InfoContext(ctx, "access denied", slog.String("role", string(actor.Role)))
+		return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrAccessDenied
+	}
+
+	project, err := u.stor.GetById(ctx, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrProjectNotFound
+		}
+		log.WarnContext(ctx, "error get project", slog.String("error", err.Error()))
+		return updatemodel.NewUpdateProjectOutput(false, 0), err
+	}
+
+	if project.Version != in.Version {
+		log.InfoContext(ctx, "version conflict", slog.Int("expected", int(in.Version)), slog.Int("actual", int(project.Version)))
+		return updatemodel.NewUpdateProjectOutput(false, project.Version), updateerr.ErrVersionConflict
+	}
+
+	if err := project.Rename(*in.Name); err != nil {
+		log.InfoContext(ctx, "invalid name")
+		return updatemodel.NewUpdateProjectOutput(false, 0), err
+	}
+
+	version, err := u.stor.Update(ctx, project)
+	if err != nil {
+		if errors.Is(err, storage.ErrVersionConflict) {
+			log.InfoContext(ctx, "version conflict")
+			return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrVersionConflict
+		} else if errors.Is(err, storage.ErrAlreadyExists) {
+			log.InfoContext(ctx, "project already exists")
+			return updatemodel.NewUpdateProjectOutput(false, 0), updateerr.ErrAlreadyExists
+		}
+		log.WarnContext(ctx, "error update project", slog.String("error", err.Error()))
+		return updatemodel.NewUpdateProjectOutput(false, 0), err
+	}
+
+	log.InfoContext(ctx, "project updated", slog.Int("version", int(version)))
+
+	return updatemodel.NewUpdateProjectOutput(true, version), nil
+}
@@ -0,0 +1,207 @@
+package updateproject
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	memberdomain "projectservice/internal/domain/member"
+	projectdomain "projectservice/internal/domain/project"
+	"projectservice/internal/repository/member"
+	"projectservice/internal/repository/storage"
+	updateerr "projectservice/internal/usecase/error/updateproject"
+	updatemocks "projectservice/internal/usecase/implementations/updateproject/mocks"
+	updatemodel "projectservice/internal/usecase/models/updateproject"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=updatemocks
+//go:generate mockgen -source=./../../../repository/member/memberrepo.go -destination=./mocks/mock_member.go -package=updatemocks
+func TestUpdateProject(t *testing.T) {
+	newName := "New"
+	longName := strings.Repeat("Name", 300)
+
+	tests := []struct {
+		testName string
+
+		expMember       bool
+		memberReturn    *memberdomain.MemberDomain
+		memberReturnErr error
+
+		expGetById       bool
+		getByIdReturn    *projectdomain.ProjectDomain
+		getByIdReturnErr error
+
+		expUpdate       bool
+		updateInput     *projectdomain.ProjectDomain
+		updateReturn    uint32
+		updateReturnErr error
+
+		in *updatemodel.UpdateProjectInput
+
+		expErr    error
+		expOutput *updatemodel.UpdateProjectOutput
+	}{
+		{
+			testName: "Success",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleAdmin},
+			memberReturnErr: nil,
+
+			expGetById:       true,
+			getByIdReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 2, Name: "Old", Version: 3},
+			getByIdReturnErr: nil,
+
+			expUpdate:       true,
+			updateInput:     &projectdomain.ProjectDomain{Id: 1, OwnerId: 2, Name: "New", Version: 3},
+			updateReturn:    4,
+			updateReturnErr: nil,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+
+			expErr:    nil,
+			expOutput: updatemodel.NewUpdateProjectOutput(true, 4),
+		}, {
+			testName: "Nothing to update",
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, nil),
+
+			expErr:    updateerr.ErrNothingToUpdate,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		}, {
+			testName: "Invalid version",
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 0, &newName),
+
+			expErr:    updateerr.ErrInvalidVersion,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		}, {
+			testName: "Not member",
+
+			expMember:       true,
+			memberReturn:    nil,
+			memberReturnErr: member.ErrNotFound,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+
+			expErr:    updateerr.ErrProjectNotFound,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		}, {
+			testName: "Access denied",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleMember},
+			memberReturnErr: nil,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+
+			expErr:    updateerr.ErrAccessDenied,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		}, {
+			testName: "Stale version",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
+			expGetById:       true,
+			getByIdReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "Old", Version: 5},
+			getByIdReturnErr: nil,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+
+			expErr:    updateerr.ErrVersionConflict,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 5),
+		}, {
+			testName: "Invalid name",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
+			expGetById:       true,
+			getByIdReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "Old", Version: 3},
+			getByIdReturnErr: nil,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &longName),
+
+			expErr:    projectdomain.ErrInvalidName,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		}, {
+			testName: "Concurrent update",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
+			expGetById:       true,
+			getByIdReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "Old", Version: 3},
+			getByIdReturnErr: nil,
+
+			expUpdate:       true,
+			updateInput:     &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "New", Version: 3},
+			updateReturn:    0,
+			updateReturnErr: storage.ErrVersionConflict,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+
+			expErr:    updateerr.ErrVersionConflict,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		}, {
+			testName: "Already exists",
+
+			expMember:       true,
+			memberReturn:    &memberdomain.MemberDomain{ProjectId: 1, UserId: 1, Role: memberdomain.RoleOwner},
+			memberReturnErr: nil,
+
+			expGetById:       true,
+			getByIdReturn:    &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "Old", Version: 3},
+			getByIdReturnErr: nil,
+
+			expUpdate:       true,
+			updateInput:     &projectdomain.ProjectDomain{Id: 1, OwnerId: 1, Name: "New", Version: 3},
+			updateReturn:    0,
+			updateReturnErr: storage.ErrAlreadyExists,
+
+			in: updatemodel.NewUpdateProjectInput(1, 1, 3, &newName),
+
+			expErr:    updateerr.ErrAlreadyExists,
+			expOutput: updatemodel.NewUpdateProjectOutput(false, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			memberMock := updatemocks.NewMockMemberRepo(ctrl)
+			if tt.expMember {
+				memberMock.EXPECT().GetMember(gomock.Any(), tt.in.ProjectId, tt.in.UserId).
+					Return(tt.memberReturn, tt.memberReturnErr)
+			}
+
+			storageMock := updatemocks.NewMockStorageRepo(ctrl)
+			if tt.expGetById {
+				storageMock.EXPECT().GetById(gomock.Any(), tt.in.ProjectId).
+					Return(tt.getByIdReturn, tt.getByIdReturnErr)
+			}
+			if tt.expUpdate {
+				storageMock.EXPECT().Update(gomock.Any(), tt.updateInput).
+					Return(tt.updateReturn, tt.updateReturnErr)
+			}
+
+			updateUC := NewUpdateProjectUC(log, storageMock, memberMock)
+
+			out, err := updateUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	changerolemodel "projectservice/internal/usecase/models/changememberrole"
+)
+
+type ChangeMemberRoleUsecase interface {
+	Execute(ctx context.Context, in *changerolemodel.ChangeMemberRoleInput) (*changerolemodel.ChangeMemberRoleOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	checkaccessmodel "projectservice/internal/usecase/models/checkaccess"
+)
+
+type CheckAccessUsecase interface {
+	Execute(ctx context.Context, in *checkaccessmodel.CheckAccessInput) (*checkaccessmodel.CheckAccessOutput, error)
+}
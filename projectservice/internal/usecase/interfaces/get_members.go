@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	getmembersmodel "projectservice/internal/usecase/models/getmembers"
+)
+
+type GetMembersUsecase interface {
+	Execute(ctx context.Context, in *getmembersmodel.GetMembersInput) (*getmembersmodel.GetMembersOutput, error)
+}
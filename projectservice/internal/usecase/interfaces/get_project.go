@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	getmodel "projectservice/internal/usecase/models/getproject"
+)
+
+type GetProjectUsecase interface {
+	Execute(ctx context.Context, in *getmodel.GetProjectInput) (*getmodel.GetProjectOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	getbyidmodel "projectservice/internal/usecase/models/getprojectbyid"
+)
+
+type GetProjectByIdUsecase interface {
+	Execute(ctx context.Context, in *getbyidmodel.GetProjectByIdInput) (*getbyidmodel.GetProjectByIdOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	invitemodel "projectservice/internal/usecase/models/invitemember"
+)
+
+type InviteMemberUsecase interface {
+	Execute(ctx context.Context, in *invitemodel.InviteMemberInput) (*invitemodel.InviteMemberOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	publishmodel "projectservice/internal/usecase/models/publishevents"
+)
+
+type PublishEventsUsecase interface {
+	Execute(ctx context.Context, in *publishmodel.PublishEventsInput) (*publishmodel.PublishEventsOutput, error)
+}
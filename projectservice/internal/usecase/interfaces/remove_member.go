@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	removemodel "projectservice/internal/usecase/models/removemember"
+)
+
+type RemoveMemberUsecase interface {
+	Execute(ctx context.Context, in *removemodel.RemoveMemberInput) (*removemodel.RemoveMemberOutput, error)
+}
@@ -0,0 +1,41 @@
+package ucmetrics
+
+import (
+	"context"
+	"projectservice/internal/usecase/interfaces"
+	createmodel "projectservice/internal/usecase/models/createproject"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type UsecaseMetrics struct {
+	projectsCreated prometheus.Counter
+}
+
+func NewUsecaseMetrics(reg prometheus.Registerer) *UsecaseMetrics {
+	m := &UsecaseMetrics{
+		projectsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "projects_created_total",
+			Help: "Projects created successfully.",
+		}),
+	}
+	reg.MustRegister(m.projectsCreated)
+	return m
+}
+
+func (m *UsecaseMetrics) CreateProject(uc interfaces.CreateProjectUsecase) interfaces.CreateProjectUsecase {
+	return &createProjectUC{uc: uc, created: m.projectsCreated}
+}
+
+type createProjectUC struct {
+	uc      interfaces.CreateProjectUsecase
+	created prometheus.Counter
+}
+
+func (u *createProjectUC) Execute(ctx context.Context, in *createmodel.CreateProjectInput) (*createmodel.CreateProjectOutput, error) {
+	out, err := u.uc.Execute(ctx, in)
+	if err == nil {
+		u.created.Inc()
+	}
+	return out, err
+}
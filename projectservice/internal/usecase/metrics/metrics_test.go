@@ -0,0 +1,31 @@
+package ucmetrics
+
+import (
+	"context"
+	"errors"
+	createmodel "projectservice/internal/usecase/models/createproject"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type stubCreateUC struct {
+	err error
+}
+
+func (s stubCreateUC) Execute(ctx context.Context, in *createmodel.CreateProjectInput) (*createmodel.CreateProjectOutput, error) {
+	return createmodel.NewCreateProjectOutput(1), s.err
+}
+
+func TestCreateProject(t *testing.T) {
+	m := NewUsecaseMetrics(prometheus.NewRegistry())
+
+	for _, err := range []error{nil, errors.New("db down"), nil} {
+		_, gotErr := m.CreateProject(stubCreateUC{err: err}).Execute(context.Background(), nil)
+		require.Equal(t, err, gotErr)
+	}
+
+	require.Equal(t, float64(2), testutil.ToFloat64(m.projectsCreated))
+}
@@ -0,0 +1,17 @@
+package changerolemodel
+
+type ChangeMemberRoleInput struct {
+	UserId    uint32
+	ProjectId uint32
+	MemberId  uint32
+	Role      string
+}
+
+func NewChangeMemberRoleInput(userId, projectId, memberId uint32, role string) *ChangeMemberRoleInput {
+	return &ChangeMemberRoleInput{
+		UserId:    userId,
+		ProjectId: projectId,
+		MemberId:  memberId,
+		Role:      role,
+	}
+}
@@ -0,0 +1,11 @@
+package changerolemodel
+
+type ChangeMemberRoleOutput struct {
+	IsChanged bool
+}
+
+func NewChangeMemberRoleOutput(isChanged bool) *ChangeMemberRoleOutput {
+	return &ChangeMemberRoleOutput{
+		IsChanged: isChanged,
+	}
+}
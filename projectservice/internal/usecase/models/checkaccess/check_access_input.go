@@ -0,0 +1,13 @@
+package checkaccessmodel
+
+type CheckAccessInput struct {
+	UserId    uint32
+	ProjectId uint32
+}
+
+func NewCheckAccessInput(userId uint32, projectId uint32) *CheckAccessInput {
+	return &CheckAccessInput{
+		UserId:    userId,
+		ProjectId: projectId,
+	}
+}
@@ -0,0 +1,13 @@
+package checkaccessmodel
+
+type CheckAccessOutput struct {
+	HasAccess bool
+	Role      string
+}
+
+func NewCheckAccessOutput(hasAccess bool, role string) *CheckAccessOutput {
+	return &CheckAccessOutput{
+		HasAccess: hasAccess,
+		Role:      role,
+	}
+}
@@ -1,13 +1,13 @@
 package deletemodel
 
 type DeleteProjectInput struct {
-	OwnerId   uint32
+	UserId    uint32
 	ProjectId uint32
 }
 
-func NewDeleteProjectInput(ownerId uint32, projectId uint32) *DeleteProjectInput {
+func NewDeleteProjectInput(userId uint32, projectId uint32) *DeleteProjectInput {
 	return &DeleteProjectInput{
-		OwnerId:   ownerId,
+		UserId:    userId,
 		ProjectId: projectId,
 	}
 }
@@ -1,11 +1,21 @@
 package getallmodel
 
 type GetAllProjectsInput struct {
-	OwnerId uint32
+	UserId    uint32
+	Limit     uint32
+	Cursor    string
+	Sort      string
+	Direction string
+	Name      string
 }
 
-func NewGetAllProjectsInput(ownerId uint32) *GetAllProjectsInput {
+func NewGetAllProjectsInput(userId, limit uint32, cursor, sort, direction, name string) *GetAllProjectsInput {
 	return &GetAllProjectsInput{
-		OwnerId: ownerId,
+		UserId:    userId,
+		Limit:     limit,
+		Cursor:    cursor,
+		Sort:      sort,
+		Direction: direction,
+		Name:      name,
 	}
 }
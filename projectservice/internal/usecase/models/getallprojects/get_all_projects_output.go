@@ -3,11 +3,13 @@ package getallmodel
 import projectdomain "projectservice/internal/domain/project"
 
 type GetAllProjectsOutput struct {
-	Projects []*projectdomain.ProjectDomain
+	Projects   []*projectdomain.ProjectDomain
+	NextCursor string
 }
 
-func NewGetAllProjectsOutput(projects []*projectdomain.ProjectDomain) *GetAllProjectsOutput {
+func NewGetAllProjectsOutput(projects []*projectdomain.ProjectDomain, nextCursor string) *GetAllProjectsOutput {
 	return &GetAllProjectsOutput{
-		Projects: projects,
+		Projects:   projects,
+		NextCursor: nextCursor,
 	}
 }
@@ -0,0 +1,13 @@
+package getmembersmodel
+
+type GetMembersInput struct {
+	UserId    uint32
+	ProjectId uint32
+}
+
+func NewGetMembersInput(userId, projectId uint32) *GetMembersInput {
+	return &GetMembersInput{
+		UserId:    userId,
+		ProjectId: projectId,
+	}
+}
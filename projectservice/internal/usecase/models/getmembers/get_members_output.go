@@ -0,0 +1,13 @@
+package getmembersmodel
+
+import memberdomain "projectservice/internal/domain/member"
+
+type GetMembersOutput struct {
+	Members []*memberdomain.MemberDomain
+}
+
+func NewGetMembersOutput(members []*memberdomain.MemberDomain) *GetMembersOutput {
+	return &GetMembersOutput{
+		Members: members,
+	}
+}
@@ -0,0 +1,11 @@
+package getmodel
+
+type GetProjectInput struct {
+	ProjectId uint32
+}
+
+func NewGetProjectInput(projectId uint32) *GetProjectInput {
+	return &GetProjectInput{
+		ProjectId: projectId,
+	}
+}
@@ -0,0 +1,13 @@
+package getmodel
+
+import projectdomain "projectservice/internal/domain/project"
+
+type GetProjectOutput struct {
+	Project *projectdomain.ProjectDomain
+}
+
+func NewGetProjectOutput(project *projectdomain.ProjectDomain) *GetProjectOutput {
+	return &GetProjectOutput{
+		Project: project,
+	}
+}
@@ -0,0 +1,13 @@
+package getbyidmodel
+
+type GetProjectByIdInput struct {
+	UserId    uint32
+	ProjectId uint32
+}
+
+func NewGetProjectByIdInput(userId, projectId uint32) *GetProjectByIdInput {
+	return &GetProjectByIdInput{
+		UserId:    userId,
+		ProjectId: projectId,
+	}
+}
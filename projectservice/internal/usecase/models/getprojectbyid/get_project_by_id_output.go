@@ -0,0 +1,18 @@
+package getbyidmodel
+
+import (
+	memberdomain "projectservice/internal/domain/member"
+	projectdomain "projectservice/internal/domain/project"
+)
+
+type GetProjectByIdOutput struct {
+	Project *projectdomain.ProjectDomain
+	Role    memberdomain.Role
+}
+
+func NewGetProjectByIdOutput(project *projectdomain.ProjectDomain, role memberdomain.Role) *GetProjectByIdOutput {
+	return &GetProjectByIdOutput{
+		Project: project,
+		Role:    role,
+	}
+}
@@ -0,0 +1,17 @@
+package invitemodel
+
+type InviteMemberInput struct {
+	UserId    uint32
+	ProjectId uint32
+	MemberId  uint32
+	Role      string
+}
+
+func NewInviteMemberInput(userId, projectId, memberId uint32, role string) *InviteMemberInput {
+	return &InviteMemberInput{
+		UserId:    userId,
+		ProjectId: projectId,
+		MemberId:  memberId,
+		Role:      role,
+	}
+}
@@ -0,0 +1,11 @@
+package invitemodel
+
+type InviteMemberOutput struct {
+	IsInvited bool
+}
+
+func NewInviteMemberOutput(isInvited bool) *InviteMemberOutput {
+	return &InviteMemberOutput{
+		IsInvited: isInvited,
+	}
+}
@@ -0,0 +1,11 @@
+package publishmodel
+
+type PublishEventsInput struct {
+	Limit uint32
+}
+
+func NewPublishEventsInput(limit uint32) *PublishEventsInput {
+	return &PublishEventsInput{
+		Limit: limit,
+	}
+}
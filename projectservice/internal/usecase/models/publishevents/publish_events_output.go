@@ -0,0 +1,11 @@
+package publishmodel
+
+type PublishEventsOutput struct {
+	Published uint32
+}
+
+func NewPublishEventsOutput(published uint32) *PublishEventsOutput {
+	return &PublishEventsOutput{
+		Published: published,
+	}
+}
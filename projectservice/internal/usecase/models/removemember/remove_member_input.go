@@ -0,0 +1,15 @@
+package removemodel
+
+type RemoveMemberInput struct {
+	UserId    uint32
+	ProjectId uint32
+	MemberId  uint32
+}
+
+func NewRemoveMemberInput(userId, projectId, memberId uint32) *RemoveMemberInput {
+	return &RemoveMemberInput{
+		UserId:    userId,
+		ProjectId: projectId,
+		MemberId:  memberId,
+	}
+}
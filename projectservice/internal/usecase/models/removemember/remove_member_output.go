@@ -0,0 +1,11 @@
+package removemodel
+
+type RemoveMemberOutput struct {
+	IsRemoved bool
+}
+
+func NewRemoveMemberOutput(isRemoved bool) *RemoveMemberOutput {
+	return &RemoveMemberOutput{
+		IsRemoved: isRemoved,
+	}
+}
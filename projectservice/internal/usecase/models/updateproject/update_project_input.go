@@ -0,0 +1,17 @@
+package updatemodel
+
+type UpdateProjectInput struct {
+	UserId    uint32
+	ProjectId uint32
+	Version   uint32
+	Name      *string
+}
+
+func NewUpdateProjectInput(userId, projectId, version uint32, name *string) *UpdateProjectInput {
+	return &UpdateProjectInput{
+		UserId:    userId,
+		ProjectId: projectId,
+		Version:   version,
+		Name:      name,
+	}
+}
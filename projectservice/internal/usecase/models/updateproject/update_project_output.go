@@ -0,0 +1,13 @@
+package updatemodel
+
+type UpdateProjectOutput struct {
+	IsUpdated bool
+	Version   uint32
+}
+
+func NewUpdateProjectOutput(isUpdated bool, version uint32) *UpdateProjectOutput {
+	return &UpdateProjectOutput{
+		IsUpdated: isUpdated,
+		Version:   version,
+	}
+}
@@ -0,0 +1 @@
+DROP TABLE project_members;
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS project_members(
+    project_id INT NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    user_id INT NOT NULL,
+    role VARCHAR(16) NOT NULL CHECK (role IN ('owner', 'admin', 'member', 'viewer')),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (project_id, user_id)
+);
+
+CREATE INDEX idx_project_members_user_id ON project_members(user_id);
+
+INSERT INTO project_members(project_id, user_id, role, created_at)
+SELECT id, owner_id, 'owner', created_at FROM projects
+ON CONFLICT DO NOTHING;
@@ -0,0 +1 @@
+ALTER TABLE projects DROP COLUMN version;
\ No newline at end of file
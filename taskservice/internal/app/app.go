@@ -3,46 +3,105 @@ package app
 import (
 	"context"
 	"database/sql"
+	"platform/grpc/clientmetrics"
+	"platform/invalidation"
+	"platform/logger"
+	"platform/requestid"
+	"platform/server/rest"
 	"taskservice/internal/config"
+	"taskservice/internal/infrastructure/grpc/projectservice"
 	"taskservice/internal/infrastructure/grpc/userservice"
 	"taskservice/internal/infrastructure/postgres"
-	"taskservice/internal/transport/rest"
+	eventconsumer "taskservice/internal/transport/events"
 	resthandler "taskservice/internal/transport/rest/handler"
+	changedescuc "taskservice/internal/usecase/implementations/changedescription"
+	changestatusuc "taskservice/internal/usecase/implementations/changestatus"
 	createuc "taskservice/internal/usecase/implementations/createtask"
-	"taskservice/pkg/logger"
+	deleteprojtasksuc "taskservice/internal/usecase/implementations/deleteprojecttasks"
+	deleteuc "taskservice/internal/usecase/implementations/deletetask"
+	getalluc "taskservice/internal/usecase/implementations/getalltasks"
+	getuc "taskservice/internal/usecase/implementations/gettask"
+	updateuc "taskservice/internal/usecase/implementations/updatetask"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
 )
 
+const projectServiceTarget = "projectservice"
+
 type App struct {
 	cfg        *config.Config
 	restServer *rest.RestServer
+	consumer   *eventconsumer.Consumer
+	subscriber *invalidation.Subscriber
 	client     *userservice.UserServiceClient
+	projClient *projectservice.ProjectServiceClient
 	db         *sql.DB
+	redis      *redis.Client
 }
 
 func NewApp() *App {
 	cfg := config.MustLoad()
-	log := logger.SetupLogger(cfg.LoggerConf.Level)
+	log := logger.SetupLogger(cfg.LoggerConf.Level, cfg.LoggerConf.Format)
 
-	db := mustLoadPostgres(cfg)
+	reg := prometheus.DefaultRegisterer
+	db := mustLoadPostgres(cfg, reg)
+	redisClient := mustLoadRedis(cfg, reg)
+	limiter := mustLoadRateLimiter(cfg, redisClient)
 
 	postgres := postgres.NewPostgres(db)
+	clientMetrics := clientmetrics.NewClientMetrics(reg)
+	projClient := projectservice.NewProjectServiceClient(
+		log,
+		cfg.ConnectionsConf.ProjectServConnConf.Host,
+		cfg.ConnectionsConf.ProjectServConnConf.Port,
+		cfg.ConnectionsConf.ProjectServConnConf.ResponseTimeout,
+		grpc.WithChainUnaryInterceptor(requestid.UnaryClientInterceptor(), clientMetrics.UnaryClientInterceptor(projectServiceTarget)),
+	)
+	client := loadUserServiceClient(cfg, log, clientMetrics)
 
-	createUC := createuc.NewCreateTaskUC(log, postgres)
+	createUC := createuc.NewCreateTaskUC(log, postgres, projClient, client)
+	deleteUC := deleteuc.NewDeleteTaskUC(log, postgres, projClient)
+	getAllUC := getalluc.NewGetAllTasksUC(log, postgres, projClient, client)
+	changeDescUC := changedescuc.NewChangeDescriptionUC(log, postgres, projClient)
+	getUC := getuc.NewGetTaskUC(log, postgres, projClient, client)
+	changeStatusUC := changestatusuc.NewChangeStatusUC(log, postgres, projClient)
+	updateUC := updateuc.NewUpdateTaskUC(log, postgres, projClient, client)
+	deleteProjTasksUC := deleteprojtasksuc.NewDeleteProjectTasksUC(log, postgres)
 
-	client := userservice.NewUserServiceClient(log, cfg.ConnectionsConf.UserServConnConf.Host, cfg.ConnectionsConf.UserServConnConf.Port)
-	handl := resthandler.NewRestHandler(log, createUC)
+	handl := resthandler.NewRestHandler(log, createUC, deleteUC, getAllUC, changeDescUC, getUC, changeStatusUC, updateUC)
 
-	restServer := mustLoadRestServer(cfg, log, handl, client)
+	sessionValid := loadSessionValidator(cfg, log, client)
+	sessionValid, subscriber := loadSessionCache(cfg, log, sessionValid, redisClient)
+	restServer := mustLoadRestServer(cfg, log, handl, sessionValid, limiter, reg)
+	consumer := eventconsumer.NewConsumer(
+		log,
+		redisClient,
+		cfg.EventsConf.Stream,
+		cfg.EventsConf.Group,
+		cfg.EventsConf.Consumer,
+		cfg.EventsConf.Block,
+		deleteProjTasksUC,
+	)
 
 	return &App{
 		cfg:        cfg,
 		restServer: restServer,
+		consumer:   consumer,
+		subscriber: subscriber,
 		client:     client,
+		projClient: projClient,
 		db:         db,
+		redis:      redisClient,
 	}
 }
 
 func (a *App) Run() {
+	go a.consumer.MustStart()
+	if a.subscriber != nil {
+		go a.subscriber.Start()
+	}
 	a.restServer.MustStart()
 }
 
@@ -51,6 +110,12 @@ func (a *App) Stop() {
 	defer cancel()
 
 	a.restServer.Stop(ctx)
+	a.consumer.Stop()
+	if a.subscriber != nil {
+		a.subscriber.Stop()
+	}
 	a.client.Stop()
+	a.projClient.Stop()
 	a.db.Close()
+	a.redis.Close()
 }
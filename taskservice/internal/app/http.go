@@ -4,27 +4,41 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	platformmetrics "platform/metrics"
+	platformmiddleware "platform/middleware"
+	"platform/ratelimit"
+	"platform/server/rest"
+	"platform/sessionvalidator"
 	"taskservice/internal/config"
-	"taskservice/internal/repository/sessionvalidator"
-	"taskservice/internal/transport/rest"
 	resthandler "taskservice/internal/transport/rest/handler"
-	"taskservice/internal/transport/rest/middleware"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func mustLoadRestServer(cfg *config.Config, log *slog.Logger, handl *resthandler.RestHandler, sessionValid sessionvalidator.SessionValidator) *rest.RestServer {
+func mustLoadRestServer(cfg *config.Config, log *slog.Logger, handl *resthandler.RestHandler, sessionValid sessionvalidator.SessionValidator, limiter ratelimit.Limiter, reg prometheus.Registerer) *rest.RestServer {
 	gin.SetMode(cfg.RestConf.Mode)
-	router := gin.New()
+	router := rest.MustNewRouter(cfg.RestConf.TrustedProxies)
+	// outside Recovery so panics are counted as 500
+	router.Use(platformmetrics.HTTPMiddleware(reg))
 	router.Use(gin.Recovery())
-	router.Use(middleware.GetSessionMiddleware(log))
-	router.Use(middleware.SessionAuthMiddleware(log, sessionValid, cfg.ConnectionsConf.UserServConnConf.ResponseTimeout))
-	router.Use(middleware.TimeoutMiddleware(cfg.RestConf.RequestTimeout))
+	router.Use(platformmiddleware.RequestIDMiddleware())
+	// registered before the auth middlewares so scrapers need no session
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	// keyed by ip only, so requests without a valid session are limited too
+	router.Use(platformmiddleware.IPRateLimitMiddleware(log, limiter, limitFromConfig(cfg.RateLimitConf.IP)))
+	router.Use(platformmiddleware.GetSessionMiddleware(log))
+	router.Use(platformmiddleware.SessionAuthMiddleware(log, sessionValid, cfg.ConnectionsConf.UserServConnConf.ResponseTimeout))
+	router.Use(platformmiddleware.RateLimitMiddleware(log, limiter, loadRateLimitRules(cfg)))
+	router.Use(platformmiddleware.TimeoutMiddleware(cfg.RestConf.RequestTimeout))
 
 	router.POST("/task/create", handl.Create)
-	router.DELETE("task/delete", handl.Delete)
+	router.DELETE("/task/delete", handl.Delete)
 	router.GET("/task/getall/:project_id", handl.GetAll)
-	router.PATCH("/task/change/desription/:task_id", handl.ChangeDescription)
+	router.PATCH("/task/change/description/:task_id", handl.ChangeDescription)
+	router.PATCH("/task/change/status/:task_id", handl.ChangeStatus)
+	router.PATCH("/task/update/:task_id", handl.Update)
 	router.GET("/task/get/:task_id", handl.Get)
 
 	server := &http.Server{
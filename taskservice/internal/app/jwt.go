@@ -0,0 +1,18 @@
+package app
+
+import (
+	"log/slog"
+	jwtvalidator "platform/jwt"
+	"platform/sessionvalidator"
+	"taskservice/internal/config"
+	"taskservice/internal/infrastructure/grpc/userservice"
+)
+
+func loadSessionValidator(cfg *config.Config, log *slog.Logger, client *userservice.UserServiceClient) sessionvalidator.SessionValidator {
+	if !cfg.JWTConf.Enabled {
+		return client
+	}
+
+	keys := jwtvalidator.NewKeySet(cfg.JWTConf.JWKSURL, cfg.JWTConf.RefreshInterval, cfg.ConnectionsConf.UserServConnConf.ResponseTimeout)
+	return jwtvalidator.NewValidator(log, client, keys, cfg.JWTConf.Issuer, cfg.JWTConf.Audience)
+}
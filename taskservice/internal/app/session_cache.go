@@ -0,0 +1,21 @@
+package app
+
+import (
+	"log/slog"
+	"platform/invalidation"
+	"platform/sessioncache"
+	"platform/sessionvalidator"
+	"taskservice/internal/config"
+
+	"github.com/redis/go-redis/v9"
+)
+
+func loadSessionCache(cfg *config.Config, log *slog.Logger, next sessionvalidator.SessionValidator, client *redis.Client) (sessionvalidator.SessionValidator, *invalidation.Subscriber) {
+	if !cfg.SessionCacheConf.Enabled {
+		return next, nil
+	}
+
+	cache := sessioncache.NewCache(next, cfg.SessionCacheConf.Capacity, cfg.SessionCacheConf.TTL, cfg.SessionCacheConf.NegativeTTL)
+	subscriber := invalidation.NewSubscriber(log, client, cfg.SessionCacheConf.InvalidationChannel, cache)
+	return cache, subscriber
+}
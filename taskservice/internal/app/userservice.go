@@ -0,0 +1,29 @@
+package app
+
+import (
+	"log/slog"
+	"platform/grpc/breaker"
+	"platform/grpc/clientmetrics"
+	"platform/grpc/userclient"
+	"platform/requestid"
+	"taskservice/internal/config"
+	"taskservice/internal/infrastructure/grpc/userservice"
+
+	"google.golang.org/grpc"
+)
+
+const userServiceTarget = "userservice"
+
+func loadUserServiceClient(cfg *config.Config, log *slog.Logger, metrics *clientmetrics.ClientMetrics) *userservice.UserServiceClient {
+	conn := cfg.ConnectionsConf.UserServConnConf
+	interceptors := []grpc.UnaryClientInterceptor{requestid.UnaryClientInterceptor(), metrics.UnaryClientInterceptor(userServiceTarget)}
+
+	if conn.CircuitBreaker.Enabled {
+		b := breaker.NewBreaker(log, conn.CircuitBreaker.FailureThreshold, conn.CircuitBreaker.OpenTimeout, conn.CircuitBreaker.HalfOpenRequests)
+		metrics.ObserveBreaker(userServiceTarget, b)
+		interceptors = append(interceptors, b.UnaryClientInterceptor())
+	}
+
+	client := userclient.NewClient(log, conn.Addresses, conn.Retry, grpc.WithChainUnaryInterceptor(interceptors...))
+	return userservice.NewUserServiceClient(client)
+}
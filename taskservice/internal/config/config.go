@@ -1,12 +1,12 @@
 package config
 
 import (
-	"flag"
+	"fmt"
 	"os"
+	platformconfig "platform/config"
+	"platform/grpc/userclient"
 	"strconv"
 	"time"
-
-	"github.com/goccy/go-yaml"
 )
 
 var (
@@ -14,13 +14,23 @@ var (
 	localType  = "local"
 )
 
+const (
+	MemoryRateLimiter = "memory"
+	RedisRateLimiter  = "redis"
+)
+
 type Config struct {
-	Type            string            `yaml:"type"`
-	RestConf        RestAPIConfig     `yaml:"restapi"`
-	GrpcConf        GRPCConfig        `yaml:"grpc"`
-	ConnectionsConf ConnectionsConfig `yaml:"connections"`
-	PostgresConf    PostgresConfig    `yaml:"postgres"`
-	LoggerConf      LoggerConfig      `yaml:"logger"`
+	Type             string             `yaml:"type"`
+	RestConf         RestAPIConfig      `yaml:"restapi"`
+	GrpcConf         GRPCConfig         `yaml:"grpc"`
+	ConnectionsConf  ConnectionsConfig  `yaml:"connections"`
+	PostgresConf     PostgresConfig     `yaml:"postgres"`
+	LoggerConf       LoggerConfig       `yaml:"logger"`
+	RedisConf        RedisConfig        `yaml:"redis"`
+	EventsConf       EventsConfig       `yaml:"events"`
+	RateLimitConf    RateLimitConfig    `yaml:"rate_limit"`
+	JWTConf          JWTConfig          `yaml:"jwt"`
+	SessionCacheConf SessionCacheConfig `yaml:"session_cache"`
 }
 
 type RestAPIConfig struct {
@@ -30,6 +40,7 @@ type RestAPIConfig struct {
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
 	RequestTimeout  time.Duration `yaml:"request_timeout"`
 	Mode            string        `yaml:"mode"`
+	TrustedProxies  []string      `yaml:"trusted_proxies"`
 }
 
 type GRPCConfig struct {
@@ -38,10 +49,27 @@ type GRPCConfig struct {
 }
 
 type ConnectionsConfig struct {
-	UserServConnConf UserServiceConnectionConfig `yaml:"userservice"`
+	UserServConnConf    UserServiceConnectionConfig    `yaml:"userservice"`
+	ProjectServConnConf ProjectServiceConnectionConfig `yaml:"projectservice"`
 }
 
 type UserServiceConnectionConfig struct {
+	Host            string                 `yaml:"host"`
+	Port            uint32                 `yaml:"port"`
+	Addresses       []string               `yaml:"addresses"`
+	ResponseTimeout time.Duration          `yaml:"response_timeout"`
+	Retry           userclient.RetryPolicy `yaml:"retry"`
+	CircuitBreaker  CircuitBreakerConfig   `yaml:"circuit_breaker"`
+}
+
+type CircuitBreakerConfig struct {
+	Enabled          bool          `yaml:"enabled"`
+	FailureThreshold uint32        `yaml:"failure_threshold"`
+	OpenTimeout      time.Duration `yaml:"open_timeout"`
+	HalfOpenRequests uint32        `yaml:"half_open_requests"`
+}
+
+type ProjectServiceConnectionConfig struct {
 	Host            string        `yaml:"host"`
 	Port            uint32        `yaml:"port"`
 	ResponseTimeout time.Duration `yaml:"response_timeout"`
@@ -57,33 +85,69 @@ type PostgresConfig struct {
 }
 
 type LoggerConfig struct {
-	Level string `yaml:"level"`
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
 }
 
-func MustLoad() *Config {
-	confPath := fetchConfigPath()
+type RedisConfig struct {
+	Host     string `yaml:"host"`
+	Port     uint32 `yaml:"port"`
+	Password string
+	DB       int `yaml:"db"`
+}
 
-	if confPath == "" {
-		panic("config path is empty")
-	}
+type EventsConfig struct {
+	Stream   string        `yaml:"stream"`
+	Group    string        `yaml:"group"`
+	Consumer string        `yaml:"consumer"`
+	Block    time.Duration `yaml:"block"`
+}
 
-	if _, err := os.Stat(confPath); err != nil {
-		panic("cannot open config path")
-	}
+type RateLimitConfig struct {
+	Backend string             `yaml:"backend"`
+	Prefix  string             `yaml:"prefix"`
+	IP      LimitConfig        `yaml:"ip"`
+	Default LimitConfig        `yaml:"default"`
+	Routes  []RouteLimitConfig `yaml:"routes"`
+}
 
-	data, err := os.ReadFile(confPath)
-	if err != nil {
-		panic("cannot read config path")
-	}
+type LimitConfig struct {
+	Requests int64         `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+	Burst    int64         `yaml:"burst"`
+}
 
-	var config Config
+type RouteLimitConfig struct {
+	Method      string `yaml:"method"`
+	Path        string `yaml:"path"`
+	LimitConfig `yaml:",inline"`
+}
 
-	err = yaml.Unmarshal(data, &config)
-	if err != nil {
-		panic("cannot parse config path")
-	}
+type JWTConfig struct {
+	Enabled         bool          `yaml:"enabled"`
+	JWKSURL         string        `yaml:"jwks_url"`
+	Issuer          string        `yaml:"issuer"`
+	Audience        string        `yaml:"audience"`
+	RefreshInterval time.Duration `yaml:"refresh_interval"`
+}
+
+type SessionCacheConfig struct {
+	Enabled             bool          `yaml:"enabled"`
+	Capacity            int           `yaml:"capacity"`
+	TTL                 time.Duration `yaml:"ttl"`
+	NegativeTTL         time.Duration `yaml:"negative_ttl"`
+	InvalidationChannel string        `yaml:"invalidation_channel"`
+}
+
+func MustLoad() *Config {
+	var config Config
+	platformconfig.MustLoad(&config)
 
 	loadSecrets(&config)
+	mustValidateUserServiceConnectionConfig(&config)
+	mustValidateRateLimitConfig(&config)
+	mustValidateJWTConfig(&config)
+	mustValidateSessionCacheConfig(&config)
 
 	return &config
 }
@@ -94,6 +158,10 @@ func loadSecrets(cfg *Config) {
 		if cfg.PostgresConf.Password == "" {
 			panic("PostgresConfig password field empty, (DB_PASS)")
 		}
+		cfg.RedisConf.Password = os.Getenv("REDIS_PASS")
+		if cfg.RedisConf.Password == "" {
+			panic("RedisConfig password field empty, (REDIS_PASS)")
+		}
 	} else if cfg.Type == dockerType {
 		cfg.PostgresConf.User = os.Getenv("DB_USER")
 		if cfg.PostgresConf.User == "" {
@@ -123,18 +191,85 @@ func loadSecrets(cfg *Config) {
 		if cfg.PostgresConf.Sslmode == "" {
 			panic("PostgresConfig sslmode filed empty, (DB_MODE)")
 		}
+		cfg.RedisConf.Host = os.Getenv("REDIS_HOST")
+		if cfg.RedisConf.Host == "" {
+			panic("RedisConfig host field empty, (REDIS_HOST)")
+		}
+		redisPort, err := strconv.Atoi(os.Getenv("REDIS_PORT"))
+		if err != nil || redisPort == 0 {
+			panic("RedisConfig port error, (REDIS_PORT)")
+		}
+		cfg.RedisConf.Port = uint32(redisPort)
+		cfg.RedisConf.Password = os.Getenv("REDIS_PASS")
+		if cfg.RedisConf.Password == "" {
+			panic("RedisConfig password field empty, (REDIS_PASS)")
+		}
+		redisDb, _ := strconv.Atoi(os.Getenv("REDIS_DB"))
+		cfg.RedisConf.DB = redisDb
 	}
 }
 
-func fetchConfigPath() string {
-	var confPath string
+func mustValidateUserServiceConnectionConfig(cfg *Config) {
+	conn := &cfg.ConnectionsConf.UserServConnConf
+	if len(conn.Addresses) == 0 {
+		conn.Addresses = []string{fmt.Sprintf("%s:%d", conn.Host, conn.Port)}
+	}
+	if conn.Retry.MaxAttempts > 1 {
+		if conn.Retry.InitialBackoff <= 0 || conn.Retry.MaxBackoff <= 0 || conn.Retry.BackoffMultiplier < 1 {
+			panic("UserServConnConf retry backoffs must be positive and backoff_multiplier at least 1")
+		}
+	}
+	if conn.CircuitBreaker.Enabled {
+		if conn.CircuitBreaker.FailureThreshold == 0 || conn.CircuitBreaker.OpenTimeout <= 0 || conn.CircuitBreaker.HalfOpenRequests == 0 {
+			panic("UserServConnConf circuit_breaker failure_threshold, open_timeout and half_open_requests must be positive")
+		}
+	}
+}
 
-	flag.StringVar(&confPath, "config", "", "path to config")
-	flag.Parse()
+func mustValidateRateLimitConfig(cfg *Config) {
+	switch cfg.RateLimitConf.Backend {
+	case "":
+		cfg.RateLimitConf.Backend = MemoryRateLimiter
+	case MemoryRateLimiter, RedisRateLimiter:
+	default:
+		panic("RateLimitConf unknown backend: " + cfg.RateLimitConf.Backend)
+	}
+	mustValidateLimitConfig("ip", cfg.RateLimitConf.IP)
+	mustValidateLimitConfig("default", cfg.RateLimitConf.Default)
+	for _, route := range cfg.RateLimitConf.Routes {
+		if route.Method == "" || route.Path == "" {
+			panic("RateLimitConf route method and path fields must be set")
+		}
+		mustValidateLimitConfig(route.Method+" "+route.Path, route.LimitConfig)
+	}
+}
+
+func mustValidateLimitConfig(name string, cfg LimitConfig) {
+	if cfg.Requests <= 0 || cfg.Period <= 0 || cfg.Burst <= 0 {
+		panic("RateLimitConf " + name + " requests, period and burst must be positive")
+	}
+}
 
-	if confPath == "" {
-		confPath = os.Getenv("CONFIG")
+func mustValidateJWTConfig(cfg *Config) {
+	if !cfg.JWTConf.Enabled {
+		return
 	}
+	if cfg.JWTConf.JWKSURL == "" || cfg.JWTConf.Issuer == "" || cfg.JWTConf.Audience == "" {
+		panic("JWTConf jwks_url, issuer and audience fields must be set")
+	}
+	if cfg.JWTConf.RefreshInterval <= 0 {
+		panic("JWTConf refresh_interval must be positive")
+	}
+}
 
-	return confPath
+func mustValidateSessionCacheConfig(cfg *Config) {
+	if !cfg.SessionCacheConf.Enabled {
+		return
+	}
+	if cfg.SessionCacheConf.Capacity <= 0 || cfg.SessionCacheConf.TTL <= 0 || cfg.SessionCacheConf.NegativeTTL < 0 {
+		panic("SessionCacheConf capacity and ttl must be positive, negative_ttl must not be negative")
+	}
+	if cfg.SessionCacheConf.InvalidationChannel == "" {
+		panic("SessionCacheConf invalidation_channel field must be set")
+	}
 }
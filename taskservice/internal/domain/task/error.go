@@ -3,6 +3,11 @@ package taskdomain
 import "errors"
 
 var (
-	ErrInvalidProjectId   = errors.New("invalid project id")
-	ErrInvalidDescription = errors.New("invalid description")
+	ErrInvalidProjectId        = errors.New("invalid project id")
+	ErrInvalidTitle            = errors.New("invalid title")
+	ErrInvalidDescription      = errors.New("invalid description")
+	ErrInvalidCreatedBy        = errors.New("invalid creator id")
+	ErrInvalidStatus           = errors.New("invalid status")
+	ErrInvalidStatusTransition = errors.New("status transition not allowed")
+	ErrInvalidPriority         = errors.New("invalid priority")
 )
@@ -2,28 +2,189 @@ package taskdomain
 
 import "time"
 
+type Status string
+
+const (
+	StatusTodo       Status = "todo"
+	StatusInProgress Status = "in_progress"
+	StatusReview     Status = "review"
+	StatusDone       Status = "done"
+)
+
+type Priority string
+
+const (
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+)
+
+var statusTransitions = map[Status][]Status{
+	StatusTodo:       {StatusInProgress},
+	StatusInProgress: {StatusTodo, StatusReview},
+	StatusReview:     {StatusInProgress, StatusDone},
+	StatusDone:       {StatusInProgress},
+}
+
 type TaskDomain struct {
 	Id          uint32
 	ProjectId   uint32
+	Title       string
 	Description string
+	Status      Status
+	Priority    Priority
+	AssigneeId  uint32
+	CreatedBy   uint32
 	Deadline    time.Time
+	Version     uint32
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
-func NewTaskDomain(projectId uint32, description string, deadline time.Time) (*TaskDomain, error) {
+func NewTaskDomain(
+	projectId uint32,
+	createdBy uint32,
+	title string,
+	description string,
+	priority Priority,
+	assigneeId uint32,
+	deadline time.Time,
+) (*TaskDomain, error) {
 	if err := validateProjectId(projectId); err != nil {
 		return nil, err
 	}
+	if createdBy == 0 {
+		return nil, ErrInvalidCreatedBy
+	}
+	if err := validateTitle(title); err != nil {
+		return nil, err
+	}
 	if err := validateDescription(description); err != nil {
 		return nil, err
 	}
+	if priority == "" {
+		priority = PriorityMedium
+	}
+	if err := ValidatePriority(priority); err != nil {
+		return nil, err
+	}
 	return &TaskDomain{
 		Id:          0,
 		ProjectId:   projectId,
+		Title:       title,
 		Description: description,
+		Status:      StatusTodo,
+		Priority:    priority,
+		AssigneeId:  assigneeId,
+		CreatedBy:   createdBy,
 		Deadline:    deadline,
 	}, nil
 }
 
+func RestoreTaskDomain(
+	id uint32,
+	projectId uint32,
+	title string,
+	description string,
+	status Status,
+	priority Priority,
+	assigneeId uint32,
+	createdBy uint32,
+	deadline time.Time,
+	version uint32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *TaskDomain {
+	return &TaskDomain{
+		Id:          id,
+		ProjectId:   projectId,
+		Title:       title,
+		Description: description,
+		Status:      status,
+		Priority:    priority,
+		AssigneeId:  assigneeId,
+		CreatedBy:   createdBy,
+		Deadline:    deadline,
+		Version:     version,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}
+}
+
+func (t *TaskDomain) ChangeTitle(title string) error {
+	if err := validateTitle(title); err != nil {
+		return err
+	}
+	t.Title = title
+	return nil
+}
+
+func (t *TaskDomain) ChangeDescription(description string) error {
+	if err := validateDescription(description); err != nil {
+		return err
+	}
+	t.Description = description
+	return nil
+}
+
+func (t *TaskDomain) ChangeStatus(status Status) error {
+	if err := ValidateStatus(status); err != nil {
+		return err
+	}
+	if !CanTransition(t.Status, status) {
+		return ErrInvalidStatusTransition
+	}
+	t.Status = status
+	return nil
+}
+
+func (t *TaskDomain) ChangePriority(priority Priority) error {
+	if err := ValidatePriority(priority); err != nil {
+		return err
+	}
+	t.Priority = priority
+	return nil
+}
+
+func (t *TaskDomain) Assign(assigneeId uint32) {
+	t.AssigneeId = assigneeId
+}
+
+func (t *TaskDomain) UserIds() []uint32 {
+	ids := []uint32{t.CreatedBy}
+	if t.AssigneeId != 0 && t.AssigneeId != t.CreatedBy {
+		ids = append(ids, t.AssigneeId)
+	}
+	return ids
+}
+
+func ValidateStatus(status Status) error {
+	switch status {
+	case StatusTodo, StatusInProgress, StatusReview, StatusDone:
+		return nil
+	default:
+		return ErrInvalidStatus
+	}
+}
+
+func ValidatePriority(priority Priority) error {
+	switch priority {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return nil
+	default:
+		return ErrInvalidPriority
+	}
+}
+
+func CanTransition(from, to Status) bool {
+	for _, allowed := range statusTransitions[from] {
+		if allowed == to {
+			return true
+		}
+	}
+	return false
+}
+
 func validateProjectId(projectId uint32) error {
 	if projectId == 0 {
 		return ErrInvalidProjectId
@@ -31,6 +192,14 @@ func validateProjectId(projectId uint32) error {
 	return nil
 }
 
+func validateTitle(title string) error {
+	rTitle := []rune(title)
+	if len(rTitle) == 0 || len(rTitle) > 255 {
+		return ErrInvalidTitle
+	}
+	return nil
+}
+
 func validateDescription(description string) error {
 	rDesc := []rune(description)
 	if len(rDesc) > 255 {
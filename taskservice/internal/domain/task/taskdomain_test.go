@@ -13,48 +13,303 @@ func TestTaskDomain(t *testing.T) {
 		testName string
 
 		ProjectId   uint32
+		CreatedBy   uint32
+		Title       string
 		Description string
+		Priority    Priority
+		AssigneeId  uint32
 		Deadline    time.Time
 
-		expErr error
+		expPriority Priority
+		expErr      error
 	}{
 		{
 			testName: "Success",
 
 			ProjectId:   1,
+			CreatedBy:   1,
+			Title:       "title",
 			Description: "desc",
+			Priority:    PriorityHigh,
+			AssigneeId:  2,
 			Deadline:    time.Now(),
 
-			expErr: nil,
+			expPriority: PriorityHigh,
+			expErr:      nil,
+		}, {
+			testName: "Default priority",
+
+			ProjectId: 1,
+			CreatedBy: 1,
+			Title:     "title",
+
+			expPriority: PriorityMedium,
+			expErr:      nil,
 		}, {
 			testName: "Invalid project id",
 
 			ProjectId:   0,
+			CreatedBy:   1,
+			Title:       "title",
 			Description: "desc",
 			Deadline:    time.Now(),
 
 			expErr: ErrInvalidProjectId,
+		}, {
+			testName: "Invalid creator id",
+
+			ProjectId: 1,
+			CreatedBy: 0,
+			Title:     "title",
+
+			expErr: ErrInvalidCreatedBy,
+		}, {
+			testName: "Empty title",
+
+			ProjectId: 1,
+			CreatedBy: 1,
+			Title:     "",
+
+			expErr: ErrInvalidTitle,
+		}, {
+			testName: "Too long title",
+
+			ProjectId: 1,
+			CreatedBy: 1,
+			Title:     strings.Repeat("a", 256),
+
+			expErr: ErrInvalidTitle,
 		}, {
 			testName: "Invalid description",
 
 			ProjectId:   1,
+			CreatedBy:   1,
+			Title:       "title",
 			Description: strings.Repeat("a", 256),
 			Deadline:    time.Now(),
 
 			expErr: ErrInvalidDescription,
+		}, {
+			testName: "Invalid priority",
+
+			ProjectId: 1,
+			CreatedBy: 1,
+			Title:     "title",
+			Priority:  "urgent",
+
+			expErr: ErrInvalidPriority,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.testName, func(t *testing.T) {
-			td, err := NewTaskDomain(tt.ProjectId, tt.Description, tt.Deadline)
+			td, err := NewTaskDomain(tt.ProjectId, tt.CreatedBy, tt.Title, tt.Description, tt.Priority, tt.AssigneeId, tt.Deadline)
 			require.Equal(t, tt.expErr, err)
 			if err == nil {
 				require.Equal(t, uint32(0), td.Id)
 				require.Equal(t, tt.ProjectId, td.ProjectId)
+				require.Equal(t, tt.CreatedBy, td.CreatedBy)
+				require.Equal(t, tt.Title, td.Title)
 				require.Equal(t, tt.Description, td.Description)
+				require.Equal(t, StatusTodo, td.Status)
+				require.Equal(t, tt.expPriority, td.Priority)
+				require.Equal(t, tt.AssigneeId, td.AssigneeId)
 				require.Equal(t, tt.Deadline, td.Deadline)
 			}
 		})
 	}
 }
+
+func TestTaskDomain_ChangeDescription(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		description string
+
+		expDescription string
+		expErr         error
+	}{
+		{
+			testName: "Success",
+
+			description: "new desc",
+
+			expDescription: "new desc",
+			expErr:         nil,
+		}, {
+			testName: "Invalid description",
+
+			description: strings.Repeat("a", 256),
+
+			expDescription: "desc",
+			expErr:         ErrInvalidDescription,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			td := RestoreTaskDomain(1, 1, "title", "desc", StatusTodo, PriorityMedium, 0, 1, time.Time{}, 1, time.Time{}, time.Time{})
+			err := td.ChangeDescription(tt.description)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expDescription, td.Description)
+		})
+	}
+}
+
+func TestTaskDomain_ChangeTitle(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		title string
+
+		expTitle string
+		expErr   error
+	}{
+		{
+			testName: "Success",
+
+			title: "new title",
+
+			expTitle: "new title",
+			expErr:   nil,
+		}, {
+			testName: "Empty title",
+
+			title: "",
+
+			expTitle: "title",
+			expErr:   ErrInvalidTitle,
+		}, {
+			testName: "Too long title",
+
+			title: strings.Repeat("a", 256),
+
+			expTitle: "title",
+			expErr:   ErrInvalidTitle,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			td := RestoreTaskDomain(1, 1, "title", "desc", StatusTodo, PriorityMedium, 0, 1, time.Time{}, 1, time.Time{}, time.Time{})
+			err := td.ChangeTitle(tt.title)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expTitle, td.Title)
+		})
+	}
+}
+
+func TestTaskDomain_ChangePriority(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		priority Priority
+
+		expPriority Priority
+		expErr      error
+	}{
+		{
+			testName: "Success",
+
+			priority: PriorityHigh,
+
+			expPriority: PriorityHigh,
+			expErr:      nil,
+		}, {
+			testName: "Unknown priority",
+
+			priority: "urgent",
+
+			expPriority: PriorityMedium,
+			expErr:      ErrInvalidPriority,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			td := RestoreTaskDomain(1, 1, "title", "desc", StatusTodo, PriorityMedium, 0, 1, time.Time{}, 1, time.Time{}, time.Time{})
+			err := td.ChangePriority(tt.priority)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expPriority, td.Priority)
+		})
+	}
+}
+
+func TestTaskDomain_ChangeStatus(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		from Status
+		to   Status
+
+		expStatus Status
+		expErr    error
+	}{
+		{
+			testName: "Todo to in progress",
+
+			from: StatusTodo,
+			to:   StatusInProgress,
+
+			expStatus: StatusInProgress,
+			expErr:    nil,
+		}, {
+			testName: "In progress to review",
+
+			from: StatusInProgress,
+			to:   StatusReview,
+
+			expStatus: StatusReview,
+			expErr:    nil,
+		}, {
+			testName: "Review to done",
+
+			from: StatusReview,
+			to:   StatusDone,
+
+			expStatus: StatusDone,
+			expErr:    nil,
+		}, {
+			testName: "Done reopened",
+
+			from: StatusDone,
+			to:   StatusInProgress,
+
+			expStatus: StatusInProgress,
+			expErr:    nil,
+		}, {
+			testName: "Todo to done",
+
+			from: StatusTodo,
+			to:   StatusDone,
+
+			expStatus: StatusTodo,
+			expErr:    ErrInvalidStatusTransition,
+		}, {
+			testName: "Same status",
+
+			from: StatusReview,
+			to:   StatusReview,
+
+			expStatus: StatusReview,
+			expErr:    ErrInvalidStatusTransition,
+		}, {
+			testName: "Unknown status",
+
+			from: StatusTodo,
+			to:   "blocked",
+
+			expStatus: StatusTodo,
+			expErr:    ErrInvalidStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			td := RestoreTaskDomain(1, 1, "title", "desc", tt.from, PriorityMedium, 0, 1, time.Time{}, 1, time.Time{}, time.Time{})
+			err := td.ChangeStatus(tt.to)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expStatus, td.Status)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package projectservice
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	projectservicev1 "platform/proto/projectservice"
+	"taskservice/internal/repository/projectaccess"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+type ProjectServiceClient struct {
+	log         *slog.Logger
+	conn        *grpc.ClientConn
+	client      projectservicev1.ProjectServiceClient
+	respTimeout time.Duration
+}
+
+func NewProjectServiceClient(log *slog.Logger, host string, port uint32, respTimeout time.Duration, opts ...grpc.DialOption) *ProjectServiceClient {
+	const op = "projectserviceclient.NewProjectServiceClient"
+
+	log.Info("create grpc client", slog.String("op", op))
+	opts = append([]grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}, opts...)
+	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", host, port), opts...)
+	if err != nil {
+		panic("cannot create new grpc client: " + err.Error())
+	}
+
+	client := projectservicev1.NewProjectServiceClient(conn)
+
+	return &ProjectServiceClient{
+		log:         log,
+		conn:        conn,
+		client:      client,
+		respTimeout: respTimeout,
+	}
+}
+
+func (p *ProjectServiceClient) CheckAccess(ctx context.Context, userId uint32, projectId uint32) (projectaccess.Role, error) {
+	in := &projectservicev1.CheckAccessRequest{
+		UserId:    userId,
+		ProjectId: projectId,
+	}
+
+	tctx, cancel := context.WithTimeout(ctx, p.respTimeout)
+	defer cancel()
+
+	res, err := p.client.CheckAccess(tctx, in)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return "", projectaccess.ErrProjectNotFound
+		}
+		return "", err
+	}
+
+	if !res.HasAccess {
+		return "", projectaccess.ErrAccessDenied
+	}
+
+	return projectaccess.Role(res.Role), nil
+}
+
+func (p *ProjectServiceClient) Stop() {
+	p.conn.Close()
+}
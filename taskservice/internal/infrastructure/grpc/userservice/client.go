@@ -2,51 +2,68 @@ package userservice
 
 import (
 	"context"
-	"fmt"
-	"log/slog"
-	userservicev1 "taskservice/proto/userservice"
+	"platform/grpc/userclient"
+	userservicev1 "platform/proto/userservice"
+	"strings"
+	"taskservice/internal/repository/userdirectory"
 
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// UserServiceClient adds the user lookups of the task views to the shared
+// userservice client.
 type UserServiceClient struct {
-	log    *slog.Logger
-	conn   *grpc.ClientConn
-	client userservicev1.UserServiceClient
+	*userclient.Client
 }
 
-func NewUserServiceClient(log *slog.Logger, host string, port uint32) *UserServiceClient {
-	const op = "userserviceclient.NewUserServiceClient"
-
-	log.Info("create grpc client", slog.String("op", op))
-	conn, err := grpc.NewClient(fmt.Sprintf("%s:%d", host, port), grpc.WithTransportCredentials(insecure.NewCredentials()))
-	if err != nil {
-		panic("cannot create new grpc client: " + err.Error())
+func NewUserServiceClient(client *userclient.Client) *UserServiceClient {
+	return &UserServiceClient{
+		Client: client,
 	}
+}
 
-	client := userservicev1.NewUserServiceClient(conn)
+func (u *UserServiceClient) GetUserName(ctx context.Context, userId uint32) (string, error) {
+	in := &userservicev1.GetUserRequest{
+		UserId: userId,
+	}
 
-	return &UserServiceClient{
-		log:    log,
-		conn:   conn,
-		client: client,
+	res, err := u.UserService().GetUser(ctx, in)
+	if err != nil {
+		if code := status.Code(err); code == codes.NotFound || code == codes.InvalidArgument {
+			return "", userdirectory.ErrUserNotFound
+		}
+		return "", err
 	}
+
+	return displayName(res.User), nil
 }
 
-func (u *UserServiceClient) GetIdBySession(ctx context.Context, sessionId string) (uint32, error) {
-	in := &userservicev1.GetIdBySessionRequest{
-		SessionId: sessionId,
+func (u *UserServiceClient) GetUserNames(ctx context.Context, userIds []uint32) (map[uint32]string, error) {
+	in := &userservicev1.BatchGetUsersRequest{
+		UserIds: userIds,
 	}
 
-	res, err := u.client.GetIdBySession(ctx, in)
+	res, err := u.UserService().BatchGetUsers(ctx, in)
 	if err != nil {
-		return 0, err
+		return nil, err
+	}
+
+	names := make(map[uint32]string, len(res.Users))
+	for _, user := range res.Users {
+		names[user.Id] = displayName(user)
 	}
 
-	return res.UserId, nil
+	return names, nil
 }
 
-func (u *UserServiceClient) Stop() {
-	u.conn.Close()
+func displayName(user *userservicev1.User) string {
+	parts := make([]string, 0, 2)
+	if user.FirstName != "" {
+		parts = append(parts, user.FirstName)
+	}
+	if user.LastName != "" {
+		parts = append(parts, user.LastName)
+	}
+	return strings.Join(parts, " ")
 }
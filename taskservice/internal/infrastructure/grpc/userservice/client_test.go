@@ -0,0 +1,130 @@
+package userservice
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net"
+	"platform/grpc/userclient"
+	userservicev1 "platform/proto/userservice"
+	"taskservice/internal/repository/userdirectory"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakeUserService struct {
+	userservicev1.UnimplementedUserServiceServer
+
+	users map[uint32]*userservicev1.User
+}
+
+func (f *fakeUserService) GetUser(ctx context.Context, in *userservicev1.GetUserRequest) (*userservicev1.GetUserResponse, error) {
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid user id")
+	}
+	user, ok := f.users[in.UserId]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "user not found")
+	}
+	return &userservicev1.GetUserResponse{User: user}, nil
+}
+
+func (f *fakeUserService) BatchGetUsers(ctx context.Context, in *userservicev1.BatchGetUsersRequest) (*userservicev1.BatchGetUsersResponse, error) {
+	res := &userservicev1.BatchGetUsersResponse{}
+	for _, id := range in.UserIds {
+		if user, ok := f.users[id]; ok {
+			res.Users = append(res.Users, user)
+		}
+	}
+	return res, nil
+}
+
+func newTestClient(t *testing.T) *UserServiceClient {
+	service := &fakeUserService{users: map[uint32]*userservicev1.User{
+		1: {Id: 1, FirstName: "Ivan", LastName: "Ivanov"},
+		2: {Id: 2, FirstName: "Petr"},
+	}}
+
+	lis := bufconn.Listen(1024 * 1024)
+	serv := grpc.NewServer()
+	userservicev1.RegisterUserServiceServer(serv, service)
+	healthpb.RegisterHealthServer(serv, health.NewServer())
+	go serv.Serve(lis)
+	t.Cleanup(serv.Stop)
+
+	dialer := grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+		return lis.DialContext(ctx)
+	})
+	client := userclient.NewClient(slog.New(slog.NewTextHandler(io.Discard, nil)), []string{"a"}, userclient.RetryPolicy{}, dialer)
+	t.Cleanup(client.Stop)
+
+	return NewUserServiceClient(client)
+}
+
+func TestUserServiceClient_GetUserName(t *testing.T) {
+	tests := []struct {
+		testName string
+		userId   uint32
+
+		expOut string
+		expErr error
+	}{
+		{
+			testName: "Success",
+			userId:   1,
+
+			expOut: "Ivan Ivanov",
+			expErr: nil,
+		}, {
+			testName: "First name only",
+			userId:   2,
+
+			expOut: "Petr",
+			expErr: nil,
+		}, {
+			testName: "Not found",
+			userId:   3,
+
+			expOut: "",
+			expErr: userdirectory.ErrUserNotFound,
+		}, {
+			testName: "Invalid id",
+			userId:   0,
+
+			expOut: "",
+			expErr: userdirectory.ErrUserNotFound,
+		},
+	}
+
+	client := newTestClient(t)
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			out, err := client.GetUserName(ctx, tt.userId)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
+
+func TestUserServiceClient_GetUserNames(t *testing.T) {
+	client := newTestClient(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	out, err := client.GetUserNames(ctx, []uint32{1, 2, 3})
+	require.NoError(t, err)
+	require.Equal(t, map[uint32]string{1: "Ivan Ivanov", 2: "Petr"}, out)
+}
@@ -9,7 +9,40 @@ func TaskDomainToModel(td *taskdomain.TaskDomain) *posmodels.TaskPosModel {
 	return posmodels.NewTaskPosModel(
 		td.Id,
 		td.ProjectId,
+		td.Title,
 		td.Description,
+		string(td.Status),
+		string(td.Priority),
+		td.AssigneeId,
+		td.CreatedBy,
 		td.Deadline,
+		td.Version,
+		td.CreatedAt,
+		td.UpdatedAt,
 	)
 }
+
+func TaskModelToDomain(tm *posmodels.TaskPosModel) *taskdomain.TaskDomain {
+	return taskdomain.RestoreTaskDomain(
+		tm.Id,
+		tm.ProjectId,
+		tm.Title,
+		tm.Description,
+		taskdomain.Status(tm.Status),
+		taskdomain.Priority(tm.Priority),
+		uint32(tm.AssigneeId.Int32),
+		tm.CreatedBy,
+		tm.Deadline.Time,
+		tm.Version,
+		tm.CreatedAt,
+		tm.UpdatedAt,
+	)
+}
+
+func TaskModelsToDomain(tm []*posmodels.TaskPosModel) []*taskdomain.TaskDomain {
+	tasks := make([]*taskdomain.TaskDomain, 0, len(tm))
+	for _, val := range tm {
+		tasks = append(tasks, TaskModelToDomain(val))
+	}
+	return tasks
+}
@@ -6,22 +6,55 @@ import (
 )
 
 type TaskPosModel struct {
-	Id          uint32       `db:"id"`
-	ProjectId   uint32       `db:"project_id"`
-	Description string       `db:"description"`
-	Deadline    sql.NullTime `db:"deadline"`
+	Id          uint32        `db:"id"`
+	ProjectId   uint32        `db:"project_id"`
+	Title       string        `db:"title"`
+	Description string        `db:"description"`
+	Status      string        `db:"status"`
+	Priority    string        `db:"priority"`
+	AssigneeId  sql.NullInt32 `db:"assignee_id"`
+	CreatedBy   uint32        `db:"created_by"`
+	Deadline    sql.NullTime  `db:"deadline"`
+	Version     uint32        `db:"version"`
+	CreatedAt   time.Time     `db:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at"`
 }
 
-func NewTaskPosModel(id uint32, projectId uint32, description string, deadline time.Time) *TaskPosModel {
+func NewTaskPosModel(
+	id uint32,
+	projectId uint32,
+	title string,
+	description string,
+	status string,
+	priority string,
+	assigneeId uint32,
+	createdBy uint32,
+	deadline time.Time,
+	version uint32,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *TaskPosModel {
 	dline := sql.NullTime{
 		Time:  deadline,
 		Valid: !deadline.IsZero(),
 	}
+	assignee := sql.NullInt32{
+		Int32: int32(assigneeId),
+		Valid: assigneeId != 0,
+	}
 
 	return &TaskPosModel{
 		Id:          id,
 		ProjectId:   projectId,
+		Title:       title,
 		Description: description,
+		Status:      status,
+		Priority:    priority,
+		AssigneeId:  assignee,
+		CreatedBy:   createdBy,
 		Deadline:    dline,
+		Version:     version,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
 	}
 }
@@ -3,8 +3,11 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"errors"
 	taskdomain "taskservice/internal/domain/task"
 	posmapper "taskservice/internal/infrastructure/postgres/mapper"
+	posmodels "taskservice/internal/infrastructure/postgres/models"
+	"taskservice/internal/repository/storage"
 )
 
 var (
@@ -24,7 +27,18 @@ func NewPostgres(db *sql.DB) *Postgres {
 func (p *Postgres) Save(ctx context.Context, td *taskdomain.TaskDomain) (uint32, error) {
 	model := posmapper.TaskDomainToModel(td)
 
-	row := p.db.QueryRowContext(ctx, QuerieCreate, model.ProjectId, model.Description, model.Deadline)
+	row := p.db.QueryRowContext(
+		ctx,
+		QuerieCreate,
+		model.ProjectId,
+		model.Title,
+		model.Description,
+		model.Status,
+		model.Priority,
+		model.AssigneeId,
+		model.CreatedBy,
+		model.Deadline,
+	)
 
 	var id uint32
 
@@ -35,3 +49,178 @@ func (p *Postgres) Save(ctx context.Context, td *taskdomain.TaskDomain) (uint32,
 
 	return id, nil
 }
+
+func (p *Postgres) GetById(ctx context.Context, taskId uint32) (*taskdomain.TaskDomain, error) {
+	row := p.db.QueryRowContext(ctx, QuerieGetById, taskId)
+
+	var model posmodels.TaskPosModel
+
+	err := row.Scan(
+		&model.Id,
+		&model.ProjectId,
+		&model.Title,
+		&model.Description,
+		&model.Status,
+		&model.Priority,
+		&model.AssigneeId,
+		&model.CreatedBy,
+		&model.Deadline,
+		&model.Version,
+		&model.CreatedAt,
+		&model.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storage.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return posmapper.TaskModelToDomain(&model), nil
+}
+
+func (p *Postgres) GetAll(ctx context.Context, projectId uint32) ([]*taskdomain.TaskDomain, error) {
+	rows, err := p.db.QueryContext(ctx, QuerieGetAll, projectId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var tasks []*posmodels.TaskPosModel
+	for rows.Next() {
+		task := &posmodels.TaskPosModel{}
+
+		err := rows.Scan(
+			&task.Id,
+			&task.ProjectId,
+			&task.Title,
+			&task.Description,
+			&task.Status,
+			&task.Priority,
+			&task.AssigneeId,
+			&task.CreatedBy,
+			&task.Deadline,
+			&task.Version,
+			&task.CreatedAt,
+			&task.UpdatedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	if len(tasks) == 0 {
+		return nil, storage.ErrNotFound
+	}
+
+	return posmapper.TaskModelsToDomain(tasks), nil
+}
+
+func (p *Postgres) UpdateDescription(ctx context.Context, taskId uint32, description string) error {
+	res, err := p.db.ExecContext(ctx, QuerieUpdateDescription, description, taskId)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *Postgres) UpdateStatus(ctx context.Context, taskId uint32, from, to taskdomain.Status) error {
+	res, err := p.db.ExecContext(ctx, QuerieUpdateStatus, string(to), taskId, string(from))
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storage.ErrStatusChanged
+	}
+
+	return nil
+}
+
+func (p *Postgres) Update(ctx context.Context, td *taskdomain.TaskDomain) (uint32, error) {
+	model := posmapper.TaskDomainToModel(td)
+
+	row := p.db.QueryRowContext(
+		ctx,
+		QuerieUpdate,
+		model.Id,
+		model.Version,
+		model.Title,
+		model.Priority,
+		model.AssigneeId,
+	)
+
+	var version uint32
+
+	err := row.Scan(&version)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, storage.ErrVersionConflict
+		}
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (p *Postgres) Delete(ctx context.Context, taskId uint32) error {
+	res, err := p.db.ExecContext(ctx, QuerieDelete, taskId)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storage.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *Postgres) DeleteByProjectId(ctx context.Context, eventId uint64, projectId uint32) error {
+	tx, err := p.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.ExecContext(ctx, QuerieInsertProcessed, eventId)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storage.ErrAlreadyProcessed
+	}
+
+	if _, err := tx.ExecContext(ctx, QuerieDeleteByProjectId, projectId); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	taskdomain "taskservice/internal/domain/task"
 	posmodels "taskservice/internal/infrastructure/postgres/models"
+	"taskservice/internal/repository/storage"
 	"testing"
 	"time"
 
@@ -22,12 +23,29 @@ func TestPostgres_Save_Success(t *testing.T) {
 	posModel := posmodels.NewTaskPosModel(
 		0,
 		1,
+		"title",
 		"desc",
+		"todo",
+		"high",
+		2,
+		1,
 		timeNow,
+		0,
+		time.Time{},
+		time.Time{},
 	)
 
 	mock.ExpectQuery(regexp.QuoteMeta(QuerieCreate)).
-		WithArgs(posModel.ProjectId, posModel.Description, posModel.Deadline).
+		WithArgs(
+			posModel.ProjectId,
+			posModel.Title,
+			posModel.Description,
+			posModel.Status,
+			posModel.Priority,
+			posModel.AssigneeId,
+			posModel.CreatedBy,
+			posModel.Deadline,
+		).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1)).
 		WillReturnError(nil)
 
@@ -35,7 +53,11 @@ func TestPostgres_Save_Success(t *testing.T) {
 
 	td, err := taskdomain.NewTaskDomain(
 		1,
+		1,
+		"title",
 		"desc",
+		taskdomain.PriorityHigh,
+		2,
 		timeNow,
 	)
 	require.NoError(t, err)
@@ -44,3 +66,397 @@ func TestPostgres_Save_Success(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, uint32(1), id)
 }
+
+func TestPostgres_GetById(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		taskId     uint32
+		returnRows *sqlmock.Rows
+
+		expTask *taskdomain.TaskDomain
+		expErr  error
+	}{
+		{
+			testName: "Success",
+
+			taskId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "project_id", "title", "description", "status", "priority",
+				"assignee_id", "created_by", "deadline", "version", "created_at", "updated_at",
+			}).AddRow(1, 1, "title", "desc", "in_progress", "high", 2, 1, timeNow, 1, timeNow, timeNow),
+
+			expTask: taskdomain.RestoreTaskDomain(1, 1, "title", "desc", taskdomain.StatusInProgress, taskdomain.PriorityHigh, 2, 1, timeNow, 1, timeNow, timeNow),
+			expErr:  nil,
+		}, {
+			testName: "Without deadline and assignee",
+
+			taskId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "project_id", "title", "description", "status", "priority",
+				"assignee_id", "created_by", "deadline", "version", "created_at", "updated_at",
+			}).AddRow(1, 1, "title", "desc", "todo", "medium", nil, 1, nil, 1, timeNow, timeNow),
+
+			expTask: taskdomain.RestoreTaskDomain(1, 1, "title", "desc", taskdomain.StatusTodo, taskdomain.PriorityMedium, 0, 1, time.Time{}, 1, timeNow, timeNow),
+			expErr:  nil,
+		}, {
+			testName: "Not found",
+
+			taskId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "project_id", "title", "description", "status", "priority",
+				"assignee_id", "created_by", "deadline", "version", "created_at", "updated_at",
+			}),
+
+			expTask: nil,
+			expErr:  storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieGetById)).
+				WithArgs(tt.taskId).
+				WillReturnRows(tt.returnRows)
+
+			postgres := NewPostgres(db)
+
+			task, err := postgres.GetById(context.Background(), tt.taskId)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expTask, task)
+		})
+	}
+}
+
+func TestPostgres_GetAll(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		projectId  uint32
+		returnRows *sqlmock.Rows
+
+		expTasks []*taskdomain.TaskDomain
+		expErr   error
+	}{
+		{
+			testName: "Success",
+
+			projectId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "project_id", "title", "description", "status", "priority",
+				"assignee_id", "created_by", "deadline", "version", "created_at", "updated_at",
+			}).AddRow(1, 1, "A", "", "todo", "low", 2, 1, timeNow, 1, timeNow, timeNow).
+				AddRow(2, 1, "B", "", "done", "medium", nil, 1, nil, 1, timeNow, timeNow),
+
+			expTasks: []*taskdomain.TaskDomain{
+				taskdomain.RestoreTaskDomain(1, 1, "A", "", taskdomain.StatusTodo, taskdomain.PriorityLow, 2, 1, timeNow, 1, timeNow, timeNow),
+				taskdomain.RestoreTaskDomain(2, 1, "B", "", taskdomain.StatusDone, taskdomain.PriorityMedium, 0, 1, time.Time{}, 1, timeNow, timeNow),
+			},
+			expErr: nil,
+		}, {
+			testName: "Not found",
+
+			projectId: 1,
+			returnRows: sqlmock.NewRows([]string{
+				"id", "project_id", "title", "description", "status", "priority",
+				"assignee_id", "created_by", "deadline", "version", "created_at", "updated_at",
+			}),
+
+			expTasks: nil,
+			expErr:   storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieGetAll)).
+				WithArgs(tt.projectId).
+				WillReturnRows(tt.returnRows)
+
+			postgres := NewPostgres(db)
+
+			tasks, err := postgres.GetAll(context.Background(), tt.projectId)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expTasks, tasks)
+		})
+	}
+}
+
+func TestPostgres_UpdateDescription(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		taskId      uint32
+		description string
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			taskId:      1,
+			description: "desc",
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName: "Not found",
+
+			taskId:      1,
+			description: "desc",
+			rowAffected: 0,
+
+			expErr: storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QuerieUpdateDescription)).
+				WithArgs(tt.description, tt.taskId).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+
+			postgres := NewPostgres(db)
+
+			err = postgres.UpdateDescription(context.Background(), tt.taskId, tt.description)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestPostgres_UpdateStatus(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		taskId      uint32
+		from        taskdomain.Status
+		to          taskdomain.Status
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			taskId:      1,
+			from:        taskdomain.StatusTodo,
+			to:          taskdomain.StatusInProgress,
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName: "Status changed",
+
+			taskId:      1,
+			from:        taskdomain.StatusTodo,
+			to:          taskdomain.StatusInProgress,
+			rowAffected: 0,
+
+			expErr: storage.ErrStatusChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QuerieUpdateStatus)).
+				WithArgs(string(tt.to), tt.taskId, string(tt.from)).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+
+			postgres := NewPostgres(db)
+
+			err = postgres.UpdateStatus(context.Background(), tt.taskId, tt.from, tt.to)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestPostgres_Update(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		td *taskdomain.TaskDomain
+
+		returnRows *sqlmock.Rows
+
+		expVersion uint32
+		expErr     error
+	}{
+		{
+			testName: "Success",
+
+			td: taskdomain.RestoreTaskDomain(1, 1, "new", "desc", taskdomain.StatusTodo, taskdomain.PriorityHigh, 2, 1, time.Time{}, 3, time.Time{}, time.Time{}),
+
+			returnRows: sqlmock.NewRows([]string{"version"}).AddRow(4),
+
+			expVersion: 4,
+			expErr:     nil,
+		}, {
+			testName: "Version conflict",
+
+			td: taskdomain.RestoreTaskDomain(1, 1, "new", "desc", taskdomain.StatusTodo, taskdomain.PriorityHigh, 0, 1, time.Time{}, 3, time.Time{}, time.Time{}),
+
+			returnRows: sqlmock.NewRows([]string{"version"}),
+
+			expVersion: 0,
+			expErr:     storage.ErrVersionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			model := posmodels.NewTaskPosModel(
+				tt.td.Id,
+				tt.td.ProjectId,
+				tt.td.Title,
+				tt.td.Description,
+				string(tt.td.Status),
+				string(tt.td.Priority),
+				tt.td.AssigneeId,
+				tt.td.CreatedBy,
+				tt.td.Deadline,
+				tt.td.Version,
+				tt.td.CreatedAt,
+				tt.td.UpdatedAt,
+			)
+
+			mock.ExpectQuery(regexp.QuoteMeta(QuerieUpdate)).
+				WithArgs(model.Id, model.Version, model.Title, model.Priority, model.AssigneeId).
+				WillReturnRows(tt.returnRows)
+
+			postgres := NewPostgres(db)
+
+			version, err := postgres.Update(context.Background(), tt.td)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expVersion, version)
+		})
+	}
+}
+
+func TestPostgres_Delete(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		taskId      uint32
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			taskId:      1,
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName: "Not found",
+
+			taskId:      1,
+			rowAffected: 0,
+
+			expErr: storage.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QuerieDelete)).
+				WithArgs(tt.taskId).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+
+			postgres := NewPostgres(db)
+
+			err = postgres.Delete(context.Background(), tt.taskId)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+func TestPostgres_DeleteByProjectId(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		eventId           uint64
+		projectId         uint32
+		processedAffected int64
+
+		expDelete bool
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			eventId:           1,
+			projectId:         2,
+			processedAffected: 1,
+
+			expDelete: true,
+			expErr:    nil,
+		}, {
+			testName: "Already processed",
+
+			eventId:           1,
+			projectId:         2,
+			processedAffected: 0,
+
+			expDelete: false,
+			expErr:    storage.ErrAlreadyProcessed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectBegin()
+			mock.ExpectExec(regexp.QuoteMeta(QuerieInsertProcessed)).
+				WithArgs(tt.eventId).
+				WillReturnResult(sqlmock.NewResult(0, tt.processedAffected))
+			if tt.expDelete {
+				mock.ExpectExec(regexp.QuoteMeta(QuerieDeleteByProjectId)).
+					WithArgs(tt.projectId).
+					WillReturnResult(sqlmock.NewResult(0, 3))
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+
+			postgres := NewPostgres(db)
+
+			err = postgres.DeleteByProjectId(context.Background(), tt.eventId, tt.projectId)
+			require.Equal(t, tt.expErr, err)
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
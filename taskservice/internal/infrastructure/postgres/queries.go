@@ -1,5 +1,13 @@
 package postgres
 
 var (
-	QuerieCreate = "INSERT INTO tasks (project_id, description, deadline) VALUES($1, $2, $3) RETURNING id;"
+	QuerieCreate            = "INSERT INTO tasks (project_id, title, description, status, priority, assignee_id, created_by, deadline) VALUES($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id;"
+	QuerieGetById           = "SELECT id, project_id, title, description, status, priority, assignee_id, created_by, deadline, version, created_at, updated_at FROM tasks WHERE id = $1;"
+	QuerieGetAll            = "SELECT id, project_id, title, description, status, priority, assignee_id, created_by, deadline, version, created_at, updated_at FROM tasks WHERE project_id = $1 ORDER BY id;"
+	QuerieUpdateDescription = "UPDATE tasks SET description = $1, version = version + 1, updated_at = now() WHERE id = $2;"
+	QuerieUpdateStatus      = "UPDATE tasks SET status = $1, version = version + 1, updated_at = now() WHERE id = $2 AND status = $3;"
+	QuerieUpdate            = "UPDATE tasks SET title = $3, priority = $4, assignee_id = $5, version = version + 1, updated_at = now() WHERE id = $1 AND version = $2 RETURNING version;"
+	QuerieDelete            = "DELETE FROM tasks WHERE id = $1;"
+	QuerieDeleteByProjectId = "DELETE FROM tasks WHERE project_id = $1;"
+	QuerieInsertProcessed   = "INSERT INTO processed_events (event_id) VALUES($1) ON CONFLICT (event_id) DO NOTHING;"
 )
@@ -0,0 +1,8 @@
+package projectaccess
+
+import "errors"
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrAccessDenied    = errors.New("access denied")
+)
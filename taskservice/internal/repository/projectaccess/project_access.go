@@ -0,0 +1,7 @@
+package projectaccess
+
+import "context"
+
+type ProjectAccessChecker interface {
+	CheckAccess(ctx context.Context, userId uint32, projectId uint32) (Role, error)
+}
@@ -0,0 +1,16 @@
+package projectaccess
+
+type Role string
+
+const (
+	RoleOwner  Role = "owner"
+	RoleAdmin  Role = "admin"
+	RoleMember Role = "member"
+	RoleViewer Role = "viewer"
+)
+
+// CanModifyTasks reports whether the role may create, change or delete tasks.
+// Viewers only get read access.
+func CanModifyTasks(role Role) bool {
+	return role == RoleOwner || role == RoleAdmin || role == RoleMember
+}
@@ -0,0 +1,10 @@
+package storage
+
+import "errors"
+
+var (
+	ErrNotFound         = errors.New("entry not found")
+	ErrAlreadyProcessed = errors.New("event already processed")
+	ErrStatusChanged    = errors.New("task status changed concurrently")
+	ErrVersionConflict  = errors.New("entry version conflict")
+)
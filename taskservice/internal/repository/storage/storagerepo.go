@@ -7,4 +7,11 @@ import (
 
 type StorageRepo interface {
 	Save(ctx context.Context, td *taskdomain.TaskDomain) (uint32, error)
+	GetById(ctx context.Context, taskId uint32) (*taskdomain.TaskDomain, error)
+	GetAll(ctx context.Context, projectId uint32) ([]*taskdomain.TaskDomain, error)
+	UpdateDescription(ctx context.Context, taskId uint32, description string) error
+	UpdateStatus(ctx context.Context, taskId uint32, from, to taskdomain.Status) error
+	Update(ctx context.Context, td *taskdomain.TaskDomain) (uint32, error)
+	Delete(ctx context.Context, taskId uint32) error
+	DeleteByProjectId(ctx context.Context, eventId uint64, projectId uint32) error
 }
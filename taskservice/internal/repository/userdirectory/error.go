@@ -0,0 +1,7 @@
+package userdirectory
+
+import "errors"
+
+var (
+	ErrUserNotFound = errors.New("user not found")
+)
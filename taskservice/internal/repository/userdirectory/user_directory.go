@@ -0,0 +1,8 @@
+package userdirectory
+
+import "context"
+
+type UserDirectory interface {
+	GetUserName(ctx context.Context, userId uint32) (string, error)
+	GetUserNames(ctx context.Context, userIds []uint32) (map[uint32]string, error)
+}
@@ -0,0 +1,199 @@
+package eventconsumer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	deleteprojtaskserr "taskservice/internal/usecase/error/deleteprojecttasks"
+	"taskservice/internal/usecase/interfaces"
+	deleteprojtasksmodel "taskservice/internal/usecase/models/deleteprojecttasks"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	projectDeletedType = "ProjectDeleted"
+	readCount          = 100
+)
+
+type projectDeletedPayload struct {
+	ProjectId uint32 `json:"project_id"`
+	OwnerId   uint32 `json:"owner_id"`
+}
+
+type Consumer struct {
+	log    *slog.Logger
+	client *redis.Client
+	stream string
+	group  string
+	name   string
+	block  time.Duration
+
+	deleteProjTasksUC interfaces.DeleteProjectTasksUsecase
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func NewConsumer(
+	log *slog.Logger,
+	client *redis.Client,
+	stream string,
+	group string,
+	name string,
+	block time.Duration,
+	deleteProjTasksUC interfaces.DeleteProjectTasksUsecase,
+) *Consumer {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Consumer{
+		log:               log,
+		client:            client,
+		stream:            stream,
+		group:             group,
+		name:              name,
+		block:             block,
+		deleteProjTasksUC: deleteProjTasksUC,
+		ctx:               ctx,
+		cancel:            cancel,
+		done:              make(chan struct{}),
+	}
+}
+
+func (c *Consumer) MustStart() {
+	const op = "eventconsumer.MustStart"
+	c.log.Info("starting event consumer", slog.String("op", op), slog.String("stream", c.stream), slog.String("group", c.group))
+	defer close(c.done)
+
+	err := c.client.XGroupCreateMkStream(c.ctx, c.stream, c.group, "0").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		panic("failed to create consumer group: " + err.Error())
+	}
+
+	// Start with messages that were delivered to this consumer but never acked.
+	// The pending list is paged from the last seen id until a page comes back
+	// empty, a pass with failed messages starts over from the beginning.
+	cursor := "0"
+	passFailed := false
+	for c.ctx.Err() == nil {
+		last, failed := c.consume(cursor)
+		switch {
+		case cursor == ">":
+			if failed {
+				cursor = "0"
+			}
+		case last != "":
+			cursor = last
+			passFailed = passFailed || failed
+		case failed:
+			// the read itself failed, try the same page again
+		case passFailed:
+			cursor = "0"
+			passFailed = false
+		default:
+			cursor = ">"
+		}
+	}
+}
+
+// consume handles one batch read after id. It returns the id of the last
+// message read, empty when there was none, and whether the read or any
+// message failed.
+func (c *Consumer) consume(id string) (string, bool) {
+	const op = "eventconsumer.consume"
+	log := c.log.With(slog.String("op", op))
+
+	streams, err := c.client.XReadGroup(c.ctx, &redis.XReadGroupArgs{
+		Group:    c.group,
+		Consumer: c.name,
+		Streams:  []string{c.stream, id},
+		Count:    readCount,
+		Block:    c.block,
+	}).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) || c.ctx.Err() != nil {
+			return "", false
+		}
+		log.Warn("cannot read events", slog.String("error", err.Error()))
+		c.wait()
+		return "", true
+	}
+
+	last := ""
+	failed := false
+	for _, stream := range streams {
+		for _, msg := range stream.Messages {
+			last = msg.ID
+			if err := c.handleMessage(c.ctx, msg); err != nil {
+				log.Warn("cannot handle event", slog.String("messageId", msg.ID), slog.String("error", err.Error()))
+				failed = true
+				continue
+			}
+			if err := c.client.XAck(c.ctx, c.stream, c.group, msg.ID).Err(); err != nil {
+				log.Warn("cannot ack event", slog.String("messageId", msg.ID), slog.String("error", err.Error()))
+			}
+		}
+	}
+
+	if failed {
+		c.wait()
+	}
+
+	return last, failed
+}
+
+func (c *Consumer) handleMessage(ctx context.Context, msg redis.XMessage) error {
+	const op = "eventconsumer.handleMessage"
+	log := c.log.With(slog.String("op", op), slog.String("messageId", msg.ID))
+
+	eventType, _ := msg.Values["event_type"].(string)
+	if eventType != projectDeletedType {
+		log.InfoContext(ctx, "skip unknown event", slog.String("eventType", eventType))
+		return nil
+	}
+
+	rawId, _ := msg.Values["event_id"].(string)
+	eventId, err := strconv.ParseUint(rawId, 10, 64)
+	if err != nil {
+		log.WarnContext(ctx, "skip event with invalid id", slog.String("eventId", rawId))
+		return nil
+	}
+
+	rawPayload, _ := msg.Values["payload"].(string)
+	var payload projectDeletedPayload
+	if err := json.Unmarshal([]byte(rawPayload), &payload); err != nil {
+		log.WarnContext(ctx, "skip event with invalid payload", slog.String("error", err.Error()))
+		return nil
+	}
+
+	in := deleteprojtasksmodel.NewDeleteProjectTasksInput(eventId, payload.ProjectId)
+
+	if _, err := c.deleteProjTasksUC.Execute(ctx, in); err != nil {
+		if errors.Is(err, deleteprojtaskserr.ErrInvalidProjectId) {
+			log.WarnContext(ctx, "skip event with invalid project id")
+			return nil
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (c *Consumer) wait() {
+	select {
+	case <-c.ctx.Done():
+	case <-time.After(c.block):
+	}
+}
+
+func (c *Consumer) Stop() {
+	const op = "eventconsumer.Stop"
+	c.log.Info("start event consumer shutdown", slog.String("op", op))
+	c.cancel()
+	<-c.done
+	c.log.Info("event consumer stopped", slog.String("op", op))
+}
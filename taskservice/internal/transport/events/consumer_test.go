@@ -0,0 +1,210 @@
+package eventconsumer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	eventmocks "taskservice/internal/transport/events/mocks"
+	deleteprojtaskserr "taskservice/internal/usecase/error/deleteprojecttasks"
+	deleteprojtasksmodel "taskservice/internal/usecase/models/deleteprojecttasks"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../usecase/interfaces/delete_project_tasks.go -destination=./mocks/mock_delete_project_tasks.go -package=eventmocks
+func TestConsumer_HandleMessage(t *testing.T) {
+	ucErr := errors.New("usecase error")
+
+	tests := []struct {
+		testName string
+
+		msg redis.XMessage
+
+		expUC       bool
+		ucIn        *deleteprojtasksmodel.DeleteProjectTasksInput
+		ucReturnErr error
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			msg: redis.XMessage{ID: "1-0", Values: map[string]any{
+				"event_id":   "1",
+				"event_type": "ProjectDeleted",
+				"payload":    `{"project_id":2,"owner_id":3}`,
+			}},
+
+			expUC:       true,
+			ucIn:        deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 2),
+			ucReturnErr: nil,
+
+			expErr: nil,
+		}, {
+			testName: "Unknown event type",
+
+			msg: redis.XMessage{ID: "1-0", Values: map[string]any{
+				"event_id":   "1",
+				"event_type": "ProjectRenamed",
+				"payload":    `{}`,
+			}},
+
+			expUC: false,
+
+			expErr: nil,
+		}, {
+			testName: "Invalid payload",
+
+			msg: redis.XMessage{ID: "1-0", Values: map[string]any{
+				"event_id":   "1",
+				"event_type": "ProjectDeleted",
+				"payload":    `{`,
+			}},
+
+			expUC: false,
+
+			expErr: nil,
+		}, {
+			testName: "Invalid project id",
+
+			msg: redis.XMessage{ID: "1-0", Values: map[string]any{
+				"event_id":   "1",
+				"event_type": "ProjectDeleted",
+				"payload":    `{"project_id":0,"owner_id":3}`,
+			}},
+
+			expUC:       true,
+			ucIn:        deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 0),
+			ucReturnErr: deleteprojtaskserr.ErrInvalidProjectId,
+
+			expErr: nil,
+		}, {
+			testName: "Usecase error",
+
+			msg: redis.XMessage{ID: "1-0", Values: map[string]any{
+				"event_id":   "1",
+				"event_type": "ProjectDeleted",
+				"payload":    `{"project_id":2,"owner_id":3}`,
+			}},
+
+			expUC:       true,
+			ucIn:        deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 2),
+			ucReturnErr: ucErr,
+
+			expErr: ucErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			ucMock := eventmocks.NewMockDeleteProjectTasksUsecase(ctrl)
+			if tt.expUC {
+				ucMock.EXPECT().Execute(gomock.Any(), tt.ucIn).
+					Return(nil, tt.ucReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			consumer := NewConsumer(log, nil, "stream", "group", "consumer", time.Second, ucMock)
+
+			err := consumer.handleMessage(context.Background(), tt.msg)
+			require.Equal(t, tt.expErr, err)
+		})
+	}
+}
+
+// countHook trims XREADGROUP replies to COUNT, miniredis returns the whole
+// pending list when re-reading it while redis pages it.
+type countHook struct{}
+
+func (countHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+func (countHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		err := next(ctx, cmd)
+		xcmd, ok := cmd.(*redis.XStreamSliceCmd)
+		if !ok || err != nil || cmd.Name() != "xreadgroup" {
+			return err
+		}
+		streams := xcmd.Val()
+		for i := range streams {
+			if len(streams[i].Messages) > readCount {
+				streams[i].Messages = streams[i].Messages[:readCount]
+			}
+		}
+		xcmd.SetVal(streams)
+		return nil
+	}
+}
+
+func (countHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return next
+}
+
+func TestConsumer_PendingBeyondOneBatch(t *testing.T) {
+	const pending = 2*readCount + 50
+
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	defer client.Close()
+	ctx := context.Background()
+
+	require.NoError(t, client.XGroupCreateMkStream(ctx, "stream", "group", "0").Err())
+	for i := 1; i <= pending; i++ {
+		require.NoError(t, client.XAdd(ctx, &redis.XAddArgs{Stream: "stream", Values: map[string]any{
+			"event_id":   fmt.Sprint(i),
+			"event_type": "ProjectDeleted",
+			"payload":    `{"project_id":2,"owner_id":3}`,
+		}}).Err())
+	}
+	// delivered before a crash and never acked
+	require.NoError(t, client.XReadGroup(ctx, &redis.XReadGroupArgs{
+		Group:    "group",
+		Consumer: "consumer",
+		Streams:  []string{"stream", ">"},
+	}).Err())
+	require.NoError(t, client.XAdd(ctx, &redis.XAddArgs{Stream: "stream", Values: map[string]any{
+		"event_id":   fmt.Sprint(pending + 1),
+		"event_type": "ProjectDeleted",
+		"payload":    `{"project_id":2,"owner_id":3}`,
+	}}).Err())
+	client.AddHook(countHook{})
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var handled atomic.Int32
+	ucMock := eventmocks.NewMockDeleteProjectTasksUsecase(ctrl)
+	ucMock.EXPECT().Execute(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, *deleteprojtasksmodel.DeleteProjectTasksInput) (*deleteprojtasksmodel.DeleteProjectTasksOutput, error) {
+			handled.Add(1)
+			return nil, nil
+		}).
+		Times(pending + 1)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	consumer := NewConsumer(log, client, "stream", "group", "consumer", 10*time.Millisecond, ucMock)
+	go consumer.MustStart()
+
+	require.Eventually(t, func() bool {
+		return handled.Load() == pending+1
+	}, 5*time.Second, 10*time.Millisecond)
+	consumer.Stop()
+
+	info, err := client.XPending(ctx, "stream", "group").Result()
+	require.NoError(t, err)
+	require.Zero(t, info.Count)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../usecase/interfaces/delete_project_tasks.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../usecase/interfaces/delete_project_tasks.go -destination=./mocks/mock_delete_project_tasks.go -package=eventmocks
+//
+
+// Package eventmocks is a generated GoMock package.
+package eventmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	deleteprojtasksmodel "taskservice/internal/usecase/models/deleteprojecttasks"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDeleteProjectTasksUsecase is a mock of DeleteProjectTasksUsecase interface.
+type MockDeleteProjectTasksUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeleteProjectTasksUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockDeleteProjectTasksUsecaseMockRecorder is the mock recorder for MockDeleteProjectTasksUsecase.
+type MockDeleteProjectTasksUsecaseMockRecorder struct {
+	mock *MockDeleteProjectTasksUsecase
+}
+
+// NewMockDeleteProjectTasksUsecase creates a new mock instance.
+func NewMockDeleteProjectTasksUsecase(ctrl *gomock.Controller) *MockDeleteProjectTasksUsecase {
+	mock := &MockDeleteProjectTasksUsecase{ctrl: ctrl}
+	mock.recorder = &MockDeleteProjectTasksUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeleteProjectTasksUsecase) EXPECT() *MockDeleteProjectTasksUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockDeleteProjectTasksUsecase) Execute(ctx context.Context, in *deleteprojtasksmodel.DeleteProjectTasksInput) (*deleteprojtasksmodel.DeleteProjectTasksOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*deleteprojtasksmodel.DeleteProjectTasksOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockDeleteProjectTasksUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockDeleteProjectTasksUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,5 @@
+package changedescdto
+
+type ChangeDescriptionRequest struct {
+	Description string `json:"description" binding:"required"`
+}
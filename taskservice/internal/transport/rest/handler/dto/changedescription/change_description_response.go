@@ -0,0 +1,5 @@
+package changedescdto
+
+type ChangeDescriptionResponse struct {
+	IsChanged bool `json:"is_changed" binding:"required"`
+}
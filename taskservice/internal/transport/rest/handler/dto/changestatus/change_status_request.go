@@ -0,0 +1,5 @@
+package changestatusdto
+
+type ChangeStatusRequest struct {
+	Status string `json:"status" binding:"required"`
+}
@@ -0,0 +1,6 @@
+package changestatusdto
+
+type ChangeStatusResponse struct {
+	IsChanged bool   `json:"is_changed" binding:"required"`
+	Status    string `json:"status,omitempty"`
+}
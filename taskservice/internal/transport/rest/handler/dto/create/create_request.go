@@ -4,6 +4,9 @@ import "time"
 
 type CreateRequest struct {
 	ProjectId   uint32    `json:"project_id" binding:"required"`
-	Description string    `json:"description" binding:"required"`
+	Title       string    `json:"title" binding:"required"`
+	Description string    `json:"description"`
+	Priority    string    `json:"priority"`
+	AssigneeId  uint32    `json:"assignee_id"`
 	Deadline    time.Time `json:"deadline"`
 }
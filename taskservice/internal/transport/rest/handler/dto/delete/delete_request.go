@@ -0,0 +1,5 @@
+package deletedto
+
+type DeleteRequest struct {
+	TaskId uint32 `json:"task_id" binding:"required"`
+}
@@ -0,0 +1,5 @@
+package deletedto
+
+type DeleteResponse struct {
+	IsDeleted bool `json:"is_deleted" binding:"required"`
+}
@@ -0,0 +1,7 @@
+package getdto
+
+import taskdto "taskservice/internal/transport/rest/handler/dto/task"
+
+type GetResponse struct {
+	Task *taskdto.TaskResponse `json:"task" binding:"required"`
+}
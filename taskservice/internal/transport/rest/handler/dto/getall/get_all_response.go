@@ -0,0 +1,7 @@
+package getalldto
+
+import taskdto "taskservice/internal/transport/rest/handler/dto/task"
+
+type GetAllResponse struct {
+	Tasks []*taskdto.TaskResponse `json:"tasks" binding:"required"`
+}
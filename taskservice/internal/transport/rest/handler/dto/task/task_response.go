@@ -0,0 +1,20 @@
+package taskdto
+
+import "time"
+
+type TaskResponse struct {
+	TaskId        uint32     `json:"task_id"`
+	ProjectId     uint32     `json:"project_id"`
+	Title         string     `json:"title"`
+	Description   string     `json:"description"`
+	Status        string     `json:"status"`
+	Priority      string     `json:"priority"`
+	AssigneeId    *uint32    `json:"assignee_id,omitempty"`
+	AssigneeName  string     `json:"assignee_name,omitempty"`
+	CreatedBy     uint32     `json:"created_by"`
+	CreatedByName string     `json:"created_by_name,omitempty"`
+	Deadline      *time.Time `json:"deadline,omitempty"`
+	Version       uint32     `json:"version"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
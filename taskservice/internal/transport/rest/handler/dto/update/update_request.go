@@ -0,0 +1,8 @@
+package updatedto
+
+type UpdateRequest struct {
+	Version    uint32  `json:"version" binding:"required"`
+	Title      *string `json:"title"`
+	Priority   *string `json:"priority"`
+	AssigneeId *uint32 `json:"assignee_id"`
+}
@@ -1,14 +1,33 @@
 package handlmapper
 
 import (
+	taskdomain "taskservice/internal/domain/task"
+	changedescdto "taskservice/internal/transport/rest/handler/dto/changedescription"
+	changestatusdto "taskservice/internal/transport/rest/handler/dto/changestatus"
 	createdto "taskservice/internal/transport/rest/handler/dto/create"
+	deletedto "taskservice/internal/transport/rest/handler/dto/delete"
+	getdto "taskservice/internal/transport/rest/handler/dto/get"
+	getalldto "taskservice/internal/transport/rest/handler/dto/getall"
+	taskdto "taskservice/internal/transport/rest/handler/dto/task"
+	updatedto "taskservice/internal/transport/rest/handler/dto/update"
+	changedescmodel "taskservice/internal/usecase/models/changedescription"
+	changestatusmodel "taskservice/internal/usecase/models/changestatus"
 	createmodel "taskservice/internal/usecase/models/createtask"
+	deletemodel "taskservice/internal/usecase/models/deletetask"
+	getallmodel "taskservice/internal/usecase/models/getalltasks"
+	getmodel "taskservice/internal/usecase/models/gettask"
+	updatemodel "taskservice/internal/usecase/models/updatetask"
+	"time"
 )
 
-func CreateRequestToInput(req *createdto.CreateRequest) *createmodel.CreateTaskInput {
+func CreateRequestToInput(req *createdto.CreateRequest, userId uint32) *createmodel.CreateTaskInput {
 	return createmodel.NewCreateInput(
+		userId,
 		req.ProjectId,
+		req.Title,
 		req.Description,
+		req.Priority,
+		req.AssigneeId,
 		req.Deadline,
 	)
 }
@@ -18,3 +37,92 @@ func CreateOutputToResponse(out *createmodel.CreateTaskOutput) *createdto.Create
 		TaskId: out.TaskId,
 	}
 }
+
+func DeleteRequestToInput(req *deletedto.DeleteRequest, userId uint32) *deletemodel.DeleteTaskInput {
+	return deletemodel.NewDeleteTaskInput(userId, req.TaskId)
+}
+
+func DeleteOutputToResponse(out *deletemodel.DeleteTaskOutput) *deletedto.DeleteResponse {
+	return &deletedto.DeleteResponse{
+		IsDeleted: out.IsDeleted,
+	}
+}
+
+func GetOutputToResponse(out *getmodel.GetTaskOutput) *getdto.GetResponse {
+	return &getdto.GetResponse{
+		Task: TaskDomainToResponse(out.Task, out.UserNames),
+	}
+}
+
+func GetAllOutputToResponse(out *getallmodel.GetAllTasksOutput) *getalldto.GetAllResponse {
+	tasks := make([]*taskdto.TaskResponse, 0, len(out.Tasks))
+	for _, td := range out.Tasks {
+		tasks = append(tasks, TaskDomainToResponse(td, out.UserNames))
+	}
+	return &getalldto.GetAllResponse{
+		Tasks: tasks,
+	}
+}
+
+func ChangeDescriptionRequestToInput(req *changedescdto.ChangeDescriptionRequest, userId uint32, taskId uint32) *changedescmodel.ChangeDescriptionInput {
+	return changedescmodel.NewChangeDescriptionInput(userId, taskId, req.Description)
+}
+
+func ChangeDescriptionOutputToResponse(out *changedescmodel.ChangeDescriptionOutput) *changedescdto.ChangeDescriptionResponse {
+	return &changedescdto.ChangeDescriptionResponse{
+		IsChanged: out.IsChanged,
+	}
+}
+
+func ChangeStatusRequestToInput(req *changestatusdto.ChangeStatusRequest, userId uint32, taskId uint32) *changestatusmodel.ChangeStatusInput {
+	return changestatusmodel.NewChangeStatusInput(userId, taskId, req.Status)
+}
+
+func ChangeStatusOutputToResponse(out *changestatusmodel.ChangeStatusOutput) *changestatusdto.ChangeStatusResponse {
+	return &changestatusdto.ChangeStatusResponse{
+		IsChanged: out.IsChanged,
+		Status:    out.Status,
+	}
+}
+
+func UpdateRequestToInput(req *updatedto.UpdateRequest, userId uint32, taskId uint32) *updatemodel.UpdateTaskInput {
+	return updatemodel.NewUpdateTaskInput(userId, taskId, req.Version, req.Title, req.Priority, req.AssigneeId)
+}
+
+func UpdateOutputToResponse(out *updatemodel.UpdateTaskOutput) *updatedto.UpdateResponse {
+	return &updatedto.UpdateResponse{
+		IsUpdated: out.IsUpdated,
+		Version:   out.Version,
+	}
+}
+
+func TaskDomainToResponse(td *taskdomain.TaskDomain, userNames map[uint32]string) *taskdto.TaskResponse {
+	var deadline *time.Time
+	if !td.Deadline.IsZero() {
+		deadline = &td.Deadline
+	}
+
+	var assigneeId *uint32
+	var assigneeName string
+	if td.AssigneeId != 0 {
+		assigneeId = &td.AssigneeId
+		assigneeName = userNames[td.AssigneeId]
+	}
+
+	return &taskdto.TaskResponse{
+		TaskId:        td.Id,
+		ProjectId:     td.ProjectId,
+		Title:         td.Title,
+		Description:   td.Description,
+		Status:        string(td.Status),
+		Priority:      string(td.Priority),
+		AssigneeId:    assigneeId,
+		AssigneeName:  assigneeName,
+		CreatedBy:     td.CreatedBy,
+		CreatedByName: userNames[td.CreatedBy],
+		Deadline:      deadline,
+		Version:       td.Version,
+		CreatedAt:     td.CreatedAt,
+		UpdatedAt:     td.UpdatedAt,
+	}
+}
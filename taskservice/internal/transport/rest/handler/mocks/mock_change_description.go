@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/change_description.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/change_description.go -destination=./mocks/mock_change_description.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	changedescmodel "taskservice/internal/usecase/models/changedescription"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChangeDescriptionUsecase is a mock of ChangeDescriptionUsecase interface.
+type MockChangeDescriptionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeDescriptionUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChangeDescriptionUsecaseMockRecorder is the mock recorder for MockChangeDescriptionUsecase.
+type MockChangeDescriptionUsecaseMockRecorder struct {
+	mock *MockChangeDescriptionUsecase
+}
+
+// NewMockChangeDescriptionUsecase creates a new mock instance.
+func NewMockChangeDescriptionUsecase(ctrl *gomock.Controller) *MockChangeDescriptionUsecase {
+	mock := &MockChangeDescriptionUsecase{ctrl: ctrl}
+	mock.recorder = &MockChangeDescriptionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeDescriptionUsecase) EXPECT() *MockChangeDescriptionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockChangeDescriptionUsecase) Execute(ctx context.Context, in *changedescmodel.ChangeDescriptionInput) (*changedescmodel.ChangeDescriptionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*changedescmodel.ChangeDescriptionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockChangeDescriptionUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChangeDescriptionUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/change_status.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/change_status.go -destination=./mocks/mock_change_status.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	changestatusmodel "taskservice/internal/usecase/models/changestatus"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChangeStatusUsecase is a mock of ChangeStatusUsecase interface.
+type MockChangeStatusUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangeStatusUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChangeStatusUsecaseMockRecorder is the mock recorder for MockChangeStatusUsecase.
+type MockChangeStatusUsecaseMockRecorder struct {
+	mock *MockChangeStatusUsecase
+}
+
+// NewMockChangeStatusUsecase creates a new mock instance.
+func NewMockChangeStatusUsecase(ctrl *gomock.Controller) *MockChangeStatusUsecase {
+	mock := &MockChangeStatusUsecase{ctrl: ctrl}
+	mock.recorder = &MockChangeStatusUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangeStatusUsecase) EXPECT() *MockChangeStatusUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockChangeStatusUsecase) Execute(ctx context.Context, in *changestatusmodel.ChangeStatusInput) (*changestatusmodel.ChangeStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*changestatusmodel.ChangeStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockChangeStatusUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChangeStatusUsecase)(nil).Execute), ctx, in)
+}
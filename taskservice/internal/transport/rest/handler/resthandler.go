@@ -4,11 +4,25 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
 	taskdomain "taskservice/internal/domain/task"
+	changedescdto "taskservice/internal/transport/rest/handler/dto/changedescription"
+	changestatusdto "taskservice/internal/transport/rest/handler/dto/changestatus"
 	createdto "taskservice/internal/transport/rest/handler/dto/create"
+	deletedto "taskservice/internal/transport/rest/handler/dto/delete"
+	updatedto "taskservice/internal/transport/rest/handler/dto/update"
 	handlmapper "taskservice/internal/transport/rest/handler/mapper"
 	handlvalidator "taskservice/internal/transport/rest/handler/validator"
+	changedescerr "taskservice/internal/usecase/error/changedescription"
+	changestatuserr "taskservice/internal/usecase/error/changestatus"
+	createerr "taskservice/internal/usecase/error/createtask"
+	deleteerr "taskservice/internal/usecase/error/deletetask"
+	getallerr "taskservice/internal/usecase/error/getalltasks"
+	geterr "taskservice/internal/usecase/error/gettask"
+	updateerr "taskservice/internal/usecase/error/updatetask"
 	"taskservice/internal/usecase/interfaces"
+	getallmodel "taskservice/internal/usecase/models/getalltasks"
+	getmodel "taskservice/internal/usecase/models/gettask"
 
 	"github.com/gin-gonic/gin"
 )
@@ -16,27 +30,57 @@ import (
 type RestHandler struct {
 	log *slog.Logger
 
-	createUC interfaces.CreateTaskUsecase
+	createUC       interfaces.CreateTaskUsecase
+	deleteUC       interfaces.DeleteTaskUsecase
+	getAllUC       interfaces.GetAllTasksUsecase
+	changeDescUC   interfaces.ChangeDescriptionUsecase
+	getUC          interfaces.GetTaskUsecase
+	changeStatusUC interfaces.ChangeStatusUsecase
+	updateUC       interfaces.UpdateTaskUsecase
 }
 
-func NewRestHandler(log *slog.Logger, createUC interfaces.CreateTaskUsecase) *RestHandler {
+func NewRestHandler(
+	log *slog.Logger,
+	createUC interfaces.CreateTaskUsecase,
+	deleteUC interfaces.DeleteTaskUsecase,
+	getAllUC interfaces.GetAllTasksUsecase,
+	changeDescUC interfaces.ChangeDescriptionUsecase,
+	getUC interfaces.GetTaskUsecase,
+	changeStatusUC interfaces.ChangeStatusUsecase,
+	updateUC interfaces.UpdateTaskUsecase,
+) *RestHandler {
 	return &RestHandler{
-		log:      log,
-		createUC: createUC,
+		log:            log,
+		createUC:       createUC,
+		deleteUC:       deleteUC,
+		getAllUC:       getAllUC,
+		changeDescUC:   changeDescUC,
+		getUC:          getUC,
+		changeStatusUC: changeStatusUC,
+		updateUC:       updateUC,
 	}
 }
 
 func (h *RestHandler) Create(ctx *gin.Context) {
 	const op = "resthandler.Create"
 
-	log := h.log.With(slog.String("op", op))
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
 
-	log.Info("starting create request")
+	log.InfoContext(ctx, "starting create request")
 
 	var req createdto.CreateRequest
 
 	if err := ctx.ShouldBindJSON(&req); err != nil {
-		log.Warn("error with request data", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
 		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"errors": errMap,
@@ -49,22 +93,47 @@ func (h *RestHandler) Create(ctx *gin.Context) {
 		return
 	}
 
-	in := handlmapper.CreateRequestToInput(&req)
+	in := handlmapper.CreateRequestToInput(&req, userId)
 
 	out, err := h.createUC.Execute(ctx.Request.Context(), in)
 	if err != nil {
 		if errors.Is(err, taskdomain.ErrInvalidProjectId) {
-			log.Info("invalid project id")
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidTitle) {
+			log.InfoContext(ctx, "invalid title")
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
 		} else if errors.Is(err, taskdomain.ErrInvalidDescription) {
-			log.Info("invalid description")
+			log.InfoContext(ctx, "invalid description")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidPriority) {
+			log.InfoContext(ctx, "invalid priority")
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"error": err.Error(),
 			})
+		} else if errors.Is(err, createerr.ErrInvalidAssignee) {
+			log.InfoContext(ctx, "invalid assignee")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, createerr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, createerr.ErrAccessDenied) || errors.Is(err, createerr.ErrForbidden) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
 		} else {
-			log.Warn("cannot create new task", slog.String("error", err.Error()))
+			log.WarnContext(ctx, "cannot create new task", slog.String("error", err.Error()))
 			ctx.JSON(http.StatusInternalServerError, gin.H{
 				"error": "internal server error",
 			})
@@ -72,24 +141,492 @@ func (h *RestHandler) Create(ctx *gin.Context) {
 		return
 	}
 
-	log.Info("create request completed successfully")
+	log.InfoContext(ctx, "create request completed successfully")
 
 	resp := handlmapper.CreateOutputToResponse(out)
 	ctx.JSON(http.StatusOK, resp)
 }
 
 func (h *RestHandler) Delete(ctx *gin.Context) {
-	panic("not implemented")
+	const op = "resthandler.Delete"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting delete request")
+
+	var req deletedto.DeleteRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.DeleteRequestToInput(&req, userId)
+
+	out, err := h.deleteUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, deleteerr.ErrInvalidTaskId) {
+			log.InfoContext(ctx, "invalid task id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, deleteerr.ErrTaskNotFound) {
+			log.InfoContext(ctx, "task not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, deleteerr.ErrAccessDenied) || errors.Is(err, deleteerr.ErrForbidden) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot delete task", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "delete request completed successfully")
+
+	resp := handlmapper.DeleteOutputToResponse(out)
+	ctx.JSON(http.StatusOK, resp)
 }
 
 func (h *RestHandler) GetAll(ctx *gin.Context) {
-	panic("not implemented")
+	const op = "resthandler.GetAll"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting get all request")
+
+	projectId, ok := getParamId(ctx, "project_id")
+	if !ok {
+		log.InfoContext(ctx, "invalid project id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": getallerr.ErrInvalidProjectId.Error(),
+		})
+		return
+	}
+
+	in := getallmodel.NewGetAllTasksInput(userId, projectId)
+
+	out, err := h.getAllUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, getallerr.ErrInvalidProjectId) {
+			log.InfoContext(ctx, "invalid project id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getallerr.ErrTasksNotFound) {
+			log.InfoContext(ctx, "tasks not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getallerr.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getallerr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot get tasks", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "get all request completed successfully")
+
+	resp := handlmapper.GetAllOutputToResponse(out)
+	ctx.JSON(http.StatusOK, resp)
 }
 
 func (h *RestHandler) ChangeDescription(ctx *gin.Context) {
-	panic("not implemented")
+	const op = "resthandler.ChangeDescription"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting change description request")
+
+	taskId, ok := getParamId(ctx, "task_id")
+	if !ok {
+		log.InfoContext(ctx, "invalid task id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": changedescerr.ErrInvalidTaskId.Error(),
+		})
+		return
+	}
+
+	var req changedescdto.ChangeDescriptionRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ChangeDescriptionRequestToInput(&req, userId, taskId)
+
+	out, err := h.changeDescUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, changedescerr.ErrInvalidTaskId) {
+			log.InfoContext(ctx, "invalid task id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidDescription) {
+			log.InfoContext(ctx, "invalid description")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changedescerr.ErrTaskNotFound) {
+			log.InfoContext(ctx, "task not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changedescerr.ErrAccessDenied) || errors.Is(err, changedescerr.ErrForbidden) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot change description", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "change description request completed successfully")
+
+	resp := handlmapper.ChangeDescriptionOutputToResponse(out)
+	ctx.JSON(http.StatusOK, resp)
 }
 
 func (h *RestHandler) Get(ctx *gin.Context) {
-	panic("not implemented")
+	const op = "resthandler.Get"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting get request")
+
+	taskId, ok := getParamId(ctx, "task_id")
+	if !ok {
+		log.InfoContext(ctx, "invalid task id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": geterr.ErrInvalidTaskId.Error(),
+		})
+		return
+	}
+
+	in := getmodel.NewGetTaskInput(userId, taskId)
+
+	out, err := h.getUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, geterr.ErrInvalidTaskId) {
+			log.InfoContext(ctx, "invalid task id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, geterr.ErrTaskNotFound) {
+			log.InfoContext(ctx, "task not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, geterr.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot get task", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "get request completed successfully")
+
+	resp := handlmapper.GetOutputToResponse(out)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *RestHandler) ChangeStatus(ctx *gin.Context) {
+	const op = "resthandler.ChangeStatus"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting change status request")
+
+	taskId, ok := getParamId(ctx, "task_id")
+	if !ok {
+		log.InfoContext(ctx, "invalid task id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": changestatuserr.ErrInvalidTaskId.Error(),
+		})
+		return
+	}
+
+	var req changestatusdto.ChangeStatusRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ChangeStatusRequestToInput(&req, userId, taskId)
+
+	out, err := h.changeStatusUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, changestatuserr.ErrInvalidTaskId) {
+			log.InfoContext(ctx, "invalid task id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidStatus) {
+			log.InfoContext(ctx, "invalid status")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidStatusTransition) {
+			log.InfoContext(ctx, "status transition not allowed")
+			ctx.JSON(http.StatusUnprocessableEntity, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changestatuserr.ErrTaskNotFound) {
+			log.InfoContext(ctx, "task not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changestatuserr.ErrAccessDenied) || errors.Is(err, changestatuserr.ErrForbidden) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changestatuserr.ErrStatusConflict) {
+			log.InfoContext(ctx, "status conflict")
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "cannot change status", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "change status request completed successfully")
+
+	resp := handlmapper.ChangeStatusOutputToResponse(out)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *RestHandler) Update(ctx *gin.Context) {
+	const op = "resthandler.Update"
+
+	userId := getUserId(ctx)
+	if userId == 0 {
+		h.log.ErrorContext(ctx, "failed to get userId", slog.String("op", op))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+		return
+	}
+
+	log := h.log.With(slog.String("op", op), slog.Int("userId", int(userId)))
+
+	log.InfoContext(ctx, "starting update request")
+
+	taskId, ok := getParamId(ctx, "task_id")
+	if !ok {
+		log.InfoContext(ctx, "invalid task id param")
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": updateerr.ErrInvalidTaskId.Error(),
+		})
+		return
+	}
+
+	var req updatedto.UpdateRequest
+
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.UpdateRequestToInput(&req, userId, taskId)
+
+	out, err := h.updateUC.Execute(ctx.Request.Context(), in)
+	if err != nil {
+		if errors.Is(err, updateerr.ErrInvalidTaskId) {
+			log.InfoContext(ctx, "invalid task id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrInvalidVersion) {
+			log.InfoContext(ctx, "invalid version")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrNothingToUpdate) {
+			log.InfoContext(ctx, "nothing to update")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidTitle) {
+			log.InfoContext(ctx, "invalid title")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, taskdomain.ErrInvalidPriority) {
+			log.InfoContext(ctx, "invalid priority")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrInvalidAssignee) {
+			log.InfoContext(ctx, "invalid assignee")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrTaskNotFound) {
+			log.InfoContext(ctx, "task not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrAccessDenied) || errors.Is(err, updateerr.ErrForbidden) {
+			log.InfoContext(ctx, "access denied")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updateerr.ErrVersionConflict) {
+			log.InfoContext(ctx, "version conflict")
+			resp := gin.H{
+				"error": err.Error(),
+			}
+			if out != nil {
+				resp["version"] = out.Version
+			}
+			ctx.JSON(http.StatusConflict, resp)
+		} else {
+			log.WarnContext(ctx, "cannot update task", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+		return
+	}
+
+	log.InfoContext(ctx, "update request completed successfully")
+
+	resp := handlmapper.UpdateOutputToResponse(out)
+	ctx.JSON(http.StatusOK, resp)
+}
+
+func getParamId(ctx *gin.Context, key string) (uint32, bool) {
+	id, err := strconv.ParseUint(ctx.Param(key), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(id), true
+}
+
+func getUserId(ctx *gin.Context) uint32 {
+	if val, exists := ctx.Get("userId"); exists {
+		return val.(uint32)
+	} else {
+		return 0
+	}
 }
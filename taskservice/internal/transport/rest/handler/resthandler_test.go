@@ -7,8 +7,23 @@ import (
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	taskdomain "taskservice/internal/domain/task"
 	handlmocks "taskservice/internal/transport/rest/handler/mocks"
+	changedescerr "taskservice/internal/usecase/error/changedescription"
+	changestatuserr "taskservice/internal/usecase/error/changestatus"
+	createerr "taskservice/internal/usecase/error/createtask"
+	deleteerr "taskservice/internal/usecase/error/deletetask"
+	getallerr "taskservice/internal/usecase/error/getalltasks"
+	geterr "taskservice/internal/usecase/error/gettask"
+	updateerr "taskservice/internal/usecase/error/updatetask"
+	changedescmodel "taskservice/internal/usecase/models/changedescription"
+	changestatusmodel "taskservice/internal/usecase/models/changestatus"
 	createmodel "taskservice/internal/usecase/models/createtask"
+	deletemodel "taskservice/internal/usecase/models/deletetask"
+	getallmodel "taskservice/internal/usecase/models/getalltasks"
+	getmodel "taskservice/internal/usecase/models/gettask"
+	updatemodel "taskservice/internal/usecase/models/updatetask"
 	"testing"
 	"time"
 
@@ -18,8 +33,14 @@ import (
 )
 
 //go:generate mockgen -source=./../../../usecase/interfaces/create_task.go -destination=./mocks/mock_create_project.go -package=handlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/delete_task.go -destination=./mocks/mock_delete_task.go -package=handlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/get_all_tasks.go -destination=./mocks/mock_get_all_tasks.go -package=handlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/change_description.go -destination=./mocks/mock_change_description.go -package=handlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/get_task.go -destination=./mocks/mock_get_task.go -package=handlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/change_status.go -destination=./mocks/mock_change_status.go -package=handlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/update_task.go -destination=./mocks/mock_update_task.go -package=handlmocks
 func TestResthandler_Create(t *testing.T) {
-	timeNow := time.Now().Round(0)
+	timeNow := time.Now().UTC().Round(0)
 
 	tests := []struct {
 		testName string
@@ -40,7 +61,11 @@ func TestResthandler_Create(t *testing.T) {
 			expCreateMock: true,
 			createIn: createmodel.NewCreateInput(
 				1,
+				1,
+				"title",
 				"desc",
+				"",
+				0,
 				timeNow,
 			),
 			createReturnOut: createmodel.NewCreateOutput(
@@ -50,6 +75,7 @@ func TestResthandler_Create(t *testing.T) {
 
 			body: map[string]any{
 				"project_id":  1,
+				"title":       "title",
 				"description": "desc",
 				"deadline":    timeNow,
 			},
@@ -66,6 +92,7 @@ func TestResthandler_Create(t *testing.T) {
 
 			body: map[string]any{
 				"project":     1,
+				"title":       "title",
 				"description": "desc",
 				"deadline":    timeNow,
 			},
@@ -73,7 +100,7 @@ func TestResthandler_Create(t *testing.T) {
 			expTaskId:     0,
 			expStatusCode: http.StatusBadRequest,
 		}, {
-			testName: "Missing filed description",
+			testName: "Missing filed title",
 
 			expCreateMock:   false,
 			createIn:        nil,
@@ -81,9 +108,9 @@ func TestResthandler_Create(t *testing.T) {
 			createReturnErr: nil,
 
 			body: map[string]any{
-				"project_id": 1,
-				"desc":       "desc",
-				"deadline":   timeNow,
+				"project_id":  1,
+				"description": "desc",
+				"deadline":    timeNow,
 			},
 
 			expTaskId:     0,
@@ -94,7 +121,11 @@ func TestResthandler_Create(t *testing.T) {
 			expCreateMock: true,
 			createIn: createmodel.NewCreateInput(
 				1,
+				1,
+				"title",
 				"desc",
+				"",
+				0,
 				time.Time{}.Round(0),
 			),
 			createReturnOut: createmodel.NewCreateOutput(
@@ -104,11 +135,89 @@ func TestResthandler_Create(t *testing.T) {
 
 			body: map[string]any{
 				"project_id":  1,
+				"title":       "title",
 				"description": "desc",
 			},
 
 			expTaskId:     1,
 			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Access denied",
+
+			expCreateMock: true,
+			createIn: createmodel.NewCreateInput(
+				1,
+				2,
+				"title",
+				"desc",
+				"",
+				0,
+				timeNow,
+			),
+			createReturnOut: nil,
+			createReturnErr: createerr.ErrAccessDenied,
+
+			body: map[string]any{
+				"project_id":  2,
+				"title":       "title",
+				"description": "desc",
+				"deadline":    timeNow,
+			},
+
+			expTaskId:     0,
+			expStatusCode: http.StatusForbidden,
+		}, {
+			testName: "Invalid assignee",
+
+			expCreateMock: true,
+			createIn: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"high",
+				3,
+				timeNow,
+			),
+			createReturnOut: nil,
+			createReturnErr: createerr.ErrInvalidAssignee,
+
+			body: map[string]any{
+				"project_id":  1,
+				"title":       "title",
+				"description": "desc",
+				"priority":    "high",
+				"assignee_id": 3,
+				"deadline":    timeNow,
+			},
+
+			expTaskId:     0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Project not found",
+
+			expCreateMock: true,
+			createIn: createmodel.NewCreateInput(
+				1,
+				2,
+				"title",
+				"desc",
+				"",
+				0,
+				timeNow,
+			),
+			createReturnOut: nil,
+			createReturnErr: createerr.ErrProjectNotFound,
+
+			body: map[string]any{
+				"project_id":  2,
+				"title":       "title",
+				"description": "desc",
+				"deadline":    timeNow,
+			},
+
+			expTaskId:     0,
+			expStatusCode: http.StatusNotFound,
 		},
 	}
 
@@ -125,9 +234,10 @@ func TestResthandler_Create(t *testing.T) {
 
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-			handl := NewRestHandler(log, createUCmock)
+			handl := NewRestHandler(log, createUCmock, nil, nil, nil, nil, nil, nil)
 
 			router := gin.New()
+			router.Use(setUserId(1))
 			router.POST("/test", handl.Create)
 
 			w := httptest.NewRecorder()
@@ -150,3 +260,893 @@ func TestResthandler_Create(t *testing.T) {
 		})
 	}
 }
+
+func TestResthandler_Delete(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expDeleteMock   bool
+		deleteIn        *deletemodel.DeleteTaskInput
+		deleteReturnOut *deletemodel.DeleteTaskOutput
+		deleteReturnErr error
+
+		body map[string]any
+
+		expIsDeleted  bool
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			expDeleteMock:   true,
+			deleteIn:        deletemodel.NewDeleteTaskInput(1, 1),
+			deleteReturnOut: deletemodel.NewDeleteTaskOutput(true),
+			deleteReturnErr: nil,
+
+			body: map[string]any{
+				"task_id": 1,
+			},
+
+			expIsDeleted:  true,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Missing field task id",
+
+			expDeleteMock: false,
+
+			body: map[string]any{
+				"task": 1,
+			},
+
+			expIsDeleted:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Not found",
+
+			expDeleteMock:   true,
+			deleteIn:        deletemodel.NewDeleteTaskInput(1, 1),
+			deleteReturnOut: nil,
+			deleteReturnErr: deleteerr.ErrTaskNotFound,
+
+			body: map[string]any{
+				"task_id": 1,
+			},
+
+			expIsDeleted:  false,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			expDeleteMock:   true,
+			deleteIn:        deletemodel.NewDeleteTaskInput(1, 1),
+			deleteReturnOut: nil,
+			deleteReturnErr: deleteerr.ErrAccessDenied,
+
+			body: map[string]any{
+				"task_id": 1,
+			},
+
+			expIsDeleted:  false,
+			expStatusCode: http.StatusForbidden,
+		}, {
+			testName: "Viewer",
+
+			expDeleteMock:   true,
+			deleteIn:        deletemodel.NewDeleteTaskInput(1, 1),
+			deleteReturnOut: nil,
+			deleteReturnErr: deleteerr.ErrForbidden,
+
+			body: map[string]any{
+				"task_id": 1,
+			},
+
+			expIsDeleted:  false,
+			expStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			deleteUCmock := handlmocks.NewMockDeleteTaskUsecase(ctrl)
+			if tt.expDeleteMock {
+				deleteUCmock.EXPECT().Execute(gomock.Any(), tt.deleteIn).
+					Return(tt.deleteReturnOut, tt.deleteReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, nil, deleteUCmock, nil, nil, nil, nil, nil)
+
+			router := gin.New()
+			router.Use(setUserId(1))
+			router.DELETE("/test", handl.Delete)
+
+			w := httptest.NewRecorder()
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodDelete, "/test", bytes.NewReader(b))
+			require.NoError(t, err)
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsDeleted bool `json:"is_deleted"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expIsDeleted, respBody.IsDeleted)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestResthandler_GetAll(t *testing.T) {
+	timeNow := time.Now().UTC().Round(0)
+
+	tests := []struct {
+		testName string
+
+		projectIdParam string
+
+		expGetAllMock   bool
+		getAllIn        *getallmodel.GetAllTasksInput
+		getAllReturnOut *getallmodel.GetAllTasksOutput
+		getAllReturnErr error
+
+		expTasksLen   int
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			projectIdParam: "1",
+
+			expGetAllMock: true,
+			getAllIn:      getallmodel.NewGetAllTasksInput(1, 1),
+			getAllReturnOut: getallmodel.NewGetAllTasksOutput([]*taskdomain.TaskDomain{
+				{Id: 1, ProjectId: 1, Description: "A", Deadline: timeNow},
+				{Id: 2, ProjectId: 1, Description: "B"},
+			}, nil),
+			getAllReturnErr: nil,
+
+			expTasksLen:   2,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid project id",
+
+			projectIdParam: "abc",
+
+			expGetAllMock: false,
+
+			expTasksLen:   0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Not found",
+
+			projectIdParam: "1",
+
+			expGetAllMock:   true,
+			getAllIn:        getallmodel.NewGetAllTasksInput(1, 1),
+			getAllReturnOut: nil,
+			getAllReturnErr: getallerr.ErrTasksNotFound,
+
+			expTasksLen:   0,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			projectIdParam: "1",
+
+			expGetAllMock:   true,
+			getAllIn:        getallmodel.NewGetAllTasksInput(1, 1),
+			getAllReturnOut: nil,
+			getAllReturnErr: getallerr.ErrAccessDenied,
+
+			expTasksLen:   0,
+			expStatusCode: http.StatusForbidden,
+		}, {
+			testName: "Project not found",
+
+			projectIdParam: "1",
+
+			expGetAllMock:   true,
+			getAllIn:        getallmodel.NewGetAllTasksInput(1, 1),
+			getAllReturnOut: nil,
+			getAllReturnErr: getallerr.ErrProjectNotFound,
+
+			expTasksLen:   0,
+			expStatusCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getAllUCmock := handlmocks.NewMockGetAllTasksUsecase(ctrl)
+			if tt.expGetAllMock {
+				getAllUCmock.EXPECT().Execute(gomock.Any(), tt.getAllIn).
+					Return(tt.getAllReturnOut, tt.getAllReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, nil, nil, getAllUCmock, nil, nil, nil, nil)
+
+			router := gin.New()
+			router.Use(setUserId(1))
+			router.GET("/test/:project_id", handl.GetAll)
+
+			w := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/test/"+tt.projectIdParam, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				Tasks []struct {
+					TaskId   uint32     `json:"task_id"`
+					Deadline *time.Time `json:"deadline"`
+				} `json:"tasks"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Len(t, respBody.Tasks, tt.expTasksLen)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+			if tt.expTasksLen > 0 {
+				require.Equal(t, timeNow, *respBody.Tasks[0].Deadline)
+				require.Nil(t, respBody.Tasks[1].Deadline)
+			}
+		})
+	}
+}
+
+func TestResthandler_ChangeDescription(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		taskIdParam string
+		body        map[string]any
+
+		expChangeMock   bool
+		changeIn        *changedescmodel.ChangeDescriptionInput
+		changeReturnOut *changedescmodel.ChangeDescriptionOutput
+		changeReturnErr error
+
+		expIsChanged  bool
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"description": "new",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			changeReturnOut: changedescmodel.NewChangeDescriptionOutput(true),
+			changeReturnErr: nil,
+
+			expIsChanged:  true,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid task id",
+
+			taskIdParam: "-1",
+			body: map[string]any{
+				"description": "new",
+			},
+
+			expChangeMock: false,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Missing field description",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"desc": "new",
+			},
+
+			expChangeMock: false,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Invalid description",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"description": strings.Repeat("A", 256),
+			},
+
+			expChangeMock:   true,
+			changeIn:        changedescmodel.NewChangeDescriptionInput(1, 1, strings.Repeat("A", 256)),
+			changeReturnOut: nil,
+			changeReturnErr: taskdomain.ErrInvalidDescription,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Not found",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"description": "new",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			changeReturnOut: nil,
+			changeReturnErr: changedescerr.ErrTaskNotFound,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"description": "new",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			changeReturnOut: nil,
+			changeReturnErr: changedescerr.ErrAccessDenied,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changeDescUCmock := handlmocks.NewMockChangeDescriptionUsecase(ctrl)
+			if tt.expChangeMock {
+				changeDescUCmock.EXPECT().Execute(gomock.Any(), tt.changeIn).
+					Return(tt.changeReturnOut, tt.changeReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, nil, nil, nil, changeDescUCmock, nil, nil, nil)
+
+			router := gin.New()
+			router.Use(setUserId(1))
+			router.PATCH("/test/:task_id", handl.ChangeDescription)
+
+			w := httptest.NewRecorder()
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPatch, "/test/"+tt.taskIdParam, bytes.NewReader(b))
+			require.NoError(t, err)
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsChanged bool `json:"is_changed"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expIsChanged, respBody.IsChanged)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestResthandler_ChangeStatus(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		taskIdParam string
+		body        map[string]any
+
+		expChangeMock   bool
+		changeIn        *changestatusmodel.ChangeStatusInput
+		changeReturnOut *changestatusmodel.ChangeStatusOutput
+		changeReturnErr error
+
+		expIsChanged  bool
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"status": "in_progress",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			changeReturnOut: changestatusmodel.NewChangeStatusOutput(true, "in_progress"),
+			changeReturnErr: nil,
+
+			expIsChanged:  true,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid task id",
+
+			taskIdParam: "abc",
+			body: map[string]any{
+				"status": "in_progress",
+			},
+
+			expChangeMock: false,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Missing field status",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"state": "in_progress",
+			},
+
+			expChangeMock: false,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Invalid status",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"status": "blocked",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changestatusmodel.NewChangeStatusInput(1, 1, "blocked"),
+			changeReturnOut: nil,
+			changeReturnErr: taskdomain.ErrInvalidStatus,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Transition not allowed",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"status": "done",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changestatusmodel.NewChangeStatusInput(1, 1, "done"),
+			changeReturnOut: nil,
+			changeReturnErr: taskdomain.ErrInvalidStatusTransition,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusUnprocessableEntity,
+		}, {
+			testName: "Not found",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"status": "in_progress",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			changeReturnOut: nil,
+			changeReturnErr: changestatuserr.ErrTaskNotFound,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"status": "in_progress",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			changeReturnOut: nil,
+			changeReturnErr: changestatuserr.ErrAccessDenied,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusForbidden,
+		}, {
+			testName: "Conflict",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"status": "in_progress",
+			},
+
+			expChangeMock:   true,
+			changeIn:        changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			changeReturnOut: nil,
+			changeReturnErr: changestatuserr.ErrStatusConflict,
+
+			expIsChanged:  false,
+			expStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changeStatusUCmock := handlmocks.NewMockChangeStatusUsecase(ctrl)
+			if tt.expChangeMock {
+				changeStatusUCmock.EXPECT().Execute(gomock.Any(), tt.changeIn).
+					Return(tt.changeReturnOut, tt.changeReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, nil, nil, nil, nil, nil, changeStatusUCmock, nil)
+
+			router := gin.New()
+			router.Use(setUserId(1))
+			router.PATCH("/test/:task_id", handl.ChangeStatus)
+
+			w := httptest.NewRecorder()
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPatch, "/test/"+tt.taskIdParam, bytes.NewReader(b))
+			require.NoError(t, err)
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsChanged bool `json:"is_changed"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expIsChanged, respBody.IsChanged)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestResthandler_Get(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		taskIdParam string
+
+		expGetMock   bool
+		getIn        *getmodel.GetTaskInput
+		getReturnOut *getmodel.GetTaskOutput
+		getReturnErr error
+
+		expTaskId       uint32
+		expAssigneeName string
+		expCreatorName  string
+		expStatusCode   int
+	}{
+		{
+			testName: "Success",
+
+			taskIdParam: "1",
+
+			expGetMock: true,
+			getIn:      getmodel.NewGetTaskInput(1, 1),
+			getReturnOut: getmodel.NewGetTaskOutput(
+				&taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "desc", AssigneeId: 2, CreatedBy: 1},
+				map[uint32]string{1: "Ivan Ivanov", 2: "Petr Petrov"},
+			),
+			getReturnErr: nil,
+
+			expTaskId:       1,
+			expAssigneeName: "Petr Petrov",
+			expCreatorName:  "Ivan Ivanov",
+			expStatusCode:   http.StatusOK,
+		}, {
+			testName: "Invalid task id",
+
+			taskIdParam: "abc",
+
+			expGetMock: false,
+
+			expTaskId:     0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Not found",
+
+			taskIdParam: "1",
+
+			expGetMock:   true,
+			getIn:        getmodel.NewGetTaskInput(1, 1),
+			getReturnOut: nil,
+			getReturnErr: geterr.ErrTaskNotFound,
+
+			expTaskId:     0,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Access denied",
+
+			taskIdParam: "1",
+
+			expGetMock:   true,
+			getIn:        getmodel.NewGetTaskInput(1, 1),
+			getReturnOut: nil,
+			getReturnErr: geterr.ErrAccessDenied,
+
+			expTaskId:     0,
+			expStatusCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getUCmock := handlmocks.NewMockGetTaskUsecase(ctrl)
+			if tt.expGetMock {
+				getUCmock.EXPECT().Execute(gomock.Any(), tt.getIn).
+					Return(tt.getReturnOut, tt.getReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, nil, nil, nil, nil, getUCmock, nil, nil)
+
+			router := gin.New()
+			router.Use(setUserId(1))
+			router.GET("/test/:task_id", handl.Get)
+
+			w := httptest.NewRecorder()
+
+			req, err := http.NewRequest(http.MethodGet, "/test/"+tt.taskIdParam, nil)
+			require.NoError(t, err)
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				Task struct {
+					TaskId        uint32 `json:"task_id"`
+					AssigneeName  string `json:"assignee_name"`
+					CreatedByName string `json:"created_by_name"`
+				} `json:"task"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expTaskId, respBody.Task.TaskId)
+			require.Equal(t, tt.expAssigneeName, respBody.Task.AssigneeName)
+			require.Equal(t, tt.expCreatorName, respBody.Task.CreatedByName)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+func TestResthandler_Update(t *testing.T) {
+	title := "new title"
+	assignee := uint32(2)
+
+	tests := []struct {
+		testName string
+
+		taskIdParam string
+		body        map[string]any
+
+		expUpdateMock   bool
+		updateIn        *updatemodel.UpdateTaskInput
+		updateReturnOut *updatemodel.UpdateTaskOutput
+		updateReturnErr error
+
+		expIsUpdated  bool
+		expVersion    uint32
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version":     3,
+				"title":       "new title",
+				"assignee_id": 2,
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, &title, nil, &assignee),
+			updateReturnOut: updatemodel.NewUpdateTaskOutput(true, 4),
+			updateReturnErr: nil,
+
+			expIsUpdated:  true,
+			expVersion:    4,
+			expStatusCode: http.StatusOK,
+		}, {
+			testName: "Invalid task id",
+
+			taskIdParam: "abc",
+			body: map[string]any{
+				"version": 3,
+				"title":   "new title",
+			},
+
+			expUpdateMock: false,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Missing field version",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"title": "new title",
+			},
+
+			expUpdateMock: false,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Nothing to update",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version": 3,
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, nil),
+			updateReturnOut: nil,
+			updateReturnErr: updateerr.ErrNothingToUpdate,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Invalid title",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version": 3,
+				"title":   "new title",
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, &title, nil, nil),
+			updateReturnOut: nil,
+			updateReturnErr: taskdomain.ErrInvalidTitle,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Invalid assignee",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version":     3,
+				"assignee_id": 2,
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, &assignee),
+			updateReturnOut: nil,
+			updateReturnErr: updateerr.ErrInvalidAssignee,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusBadRequest,
+		}, {
+			testName: "Not found",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version": 3,
+				"title":   "new title",
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, &title, nil, nil),
+			updateReturnOut: nil,
+			updateReturnErr: updateerr.ErrTaskNotFound,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusNotFound,
+		}, {
+			testName: "Viewer",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version": 3,
+				"title":   "new title",
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, &title, nil, nil),
+			updateReturnOut: nil,
+			updateReturnErr: updateerr.ErrForbidden,
+
+			expIsUpdated:  false,
+			expVersion:    0,
+			expStatusCode: http.StatusForbidden,
+		}, {
+			testName: "Version conflict",
+
+			taskIdParam: "1",
+			body: map[string]any{
+				"version": 3,
+				"title":   "new title",
+			},
+
+			expUpdateMock:   true,
+			updateIn:        updatemodel.NewUpdateTaskInput(1, 1, 3, &title, nil, nil),
+			updateReturnOut: updatemodel.NewUpdateTaskOutput(false, 5),
+			updateReturnErr: updateerr.ErrVersionConflict,
+
+			expIsUpdated:  false,
+			expVersion:    5,
+			expStatusCode: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			updateUCmock := handlmocks.NewMockUpdateTaskUsecase(ctrl)
+			if tt.expUpdateMock {
+				updateUCmock.EXPECT().Execute(gomock.Any(), tt.updateIn).
+					Return(tt.updateReturnOut, tt.updateReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, nil, nil, nil, nil, nil, nil, updateUCmock)
+
+			router := gin.New()
+			router.Use(setUserId(1))
+			router.PATCH("/test/:task_id", handl.Update)
+
+			w := httptest.NewRecorder()
+
+			b, err := json.Marshal(tt.body)
+			require.NoError(t, err)
+
+			req, err := http.NewRequest(http.MethodPatch, "/test/"+tt.taskIdParam, bytes.NewReader(b))
+			require.NoError(t, err)
+
+			router.ServeHTTP(w, req)
+
+			var respBody struct {
+				IsUpdated bool   `json:"is_updated"`
+				Version   uint32 `json:"version"`
+			}
+
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&respBody))
+			require.Equal(t, tt.expIsUpdated, respBody.IsUpdated)
+			require.Equal(t, tt.expVersion, respBody.Version)
+			require.Equal(t, tt.expStatusCode, w.Result().StatusCode)
+		})
+	}
+}
+
+func setUserId(userId uint32) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		ctx.Set("userId", userId)
+		ctx.Next()
+	}
+}
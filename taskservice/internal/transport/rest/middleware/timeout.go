@@ -1,20 +0,0 @@
-package middleware
-
-import (
-	"net/http"
-	"time"
-
-	"github.com/gin-contrib/timeout"
-	"github.com/gin-gonic/gin"
-)
-
-func timeoutResponse(ctx *gin.Context) {
-	ctx.JSON(http.StatusRequestTimeout, "timeout")
-}
-
-func TimeoutMiddleware(t time.Duration) gin.HandlerFunc {
-	return timeout.New(
-		timeout.WithTimeout(t),
-		timeout.WithResponse(timeoutResponse),
-	)
-}
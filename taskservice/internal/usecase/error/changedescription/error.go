@@ -0,0 +1,10 @@
+package changedescerr
+
+import "errors"
+
+var (
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrInvalidTaskId = errors.New("invalid task id")
+	ErrAccessDenied  = errors.New("access denied")
+	ErrForbidden     = errors.New("viewers cannot modify tasks")
+)
@@ -0,0 +1,11 @@
+package changestatuserr
+
+import "errors"
+
+var (
+	ErrTaskNotFound   = errors.New("task not found")
+	ErrInvalidTaskId  = errors.New("invalid task id")
+	ErrAccessDenied   = errors.New("access denied")
+	ErrStatusConflict = errors.New("task status was changed by another request")
+	ErrForbidden      = errors.New("viewers cannot modify tasks")
+)
@@ -0,0 +1,10 @@
+package createerr
+
+import "errors"
+
+var (
+	ErrProjectNotFound = errors.New("project not found")
+	ErrAccessDenied    = errors.New("access denied")
+	ErrInvalidAssignee = errors.New("assignee is not a project member")
+	ErrForbidden       = errors.New("viewers cannot modify tasks")
+)
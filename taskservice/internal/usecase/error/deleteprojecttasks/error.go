@@ -0,0 +1,7 @@
+package deleteprojtaskserr
+
+import "errors"
+
+var (
+	ErrInvalidProjectId = errors.New("invalid project id")
+)
@@ -0,0 +1,10 @@
+package getallerr
+
+import "errors"
+
+var (
+	ErrTasksNotFound    = errors.New("tasks not found")
+	ErrInvalidProjectId = errors.New("invalid project id")
+	ErrProjectNotFound  = errors.New("project not found")
+	ErrAccessDenied     = errors.New("access denied")
+)
@@ -0,0 +1,9 @@
+package geterr
+
+import "errors"
+
+var (
+	ErrTaskNotFound  = errors.New("task not found")
+	ErrInvalidTaskId = errors.New("invalid task id")
+	ErrAccessDenied  = errors.New("access denied")
+)
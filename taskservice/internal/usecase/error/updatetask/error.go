@@ -0,0 +1,14 @@
+package updateerr
+
+import "errors"
+
+var (
+	ErrTaskNotFound    = errors.New("task not found")
+	ErrInvalidTaskId   = errors.New("invalid task id")
+	ErrInvalidVersion  = errors.New("invalid version")
+	ErrNothingToUpdate = errors.New("nothing to update")
+	ErrAccessDenied    = errors.New("access denied")
+	ErrForbidden       = errors.New("viewers cannot modify tasks")
+	ErrInvalidAssignee = errors.New("assignee is not a project member")
+	ErrVersionConflict = errors.New("task was modified concurrently")
+)
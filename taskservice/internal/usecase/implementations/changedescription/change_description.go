@@ -0,0 +1,85 @@
+package changedescuc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	changedescerr "taskservice/internal/usecase/error/changedescription"
+	changedescmodel "taskservice/internal/usecase/models/changedescription"
+)
+
+type ChangeDescriptionUC struct {
+	log *slog.Logger
+
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+}
+
+func NewChangeDescriptionUC(log *slog.Logger, stor storage.StorageRepo, access projectaccess.ProjectAccessChecker) *ChangeDescriptionUC {
+	return &ChangeDescriptionUC{
+		log:    log,
+		stor:   stor,
+		access: access,
+	}
+}
+
+func (c *ChangeDescriptionUC) Execute(ctx context.Context, in *changedescmodel.ChangeDescriptionInput) (*changedescmodel.ChangeDescriptionOutput, error) {
+	const op = "changedescuc.Execute"
+
+	log := c.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("taskId", int(in.TaskId)))
+
+	log.InfoContext(ctx, "starting change description")
+
+	if in.TaskId == 0 {
+		log.InfoContext(ctx, "invalid task id")
+		return nil, changedescerr.ErrInvalidTaskId
+	}
+
+	td, err := c.stor.GetById(ctx, in.TaskId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, changedescerr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot get task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	role, err := c.access.CheckAccess(ctx, in.UserId, td.ProjectId)
+	if err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, changedescerr.ErrTaskNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, changedescerr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !projectaccess.CanModifyTasks(role) {
+		log.InfoContext(ctx, "read-only access", slog.String("role", string(role)))
+		return nil, changedescerr.ErrForbidden
+	}
+
+	if err := td.ChangeDescription(in.Description); err != nil {
+		log.InfoContext(ctx, "cannot change description", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err := c.stor.UpdateDescription(ctx, td.Id, td.Description); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, changedescerr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot update description", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "description changed successfully")
+
+	return changedescmodel.NewChangeDescriptionOutput(true), nil
+}
@@ -0,0 +1,199 @@
+package changedescuc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"strings"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	changedescerr "taskservice/internal/usecase/error/changedescription"
+	changedescmocks "taskservice/internal/usecase/implementations/changedescription/mocks"
+	changedescmodel "taskservice/internal/usecase/models/changedescription"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=changedescmocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=changedescmocks
+func TestChangeDescriptionUC(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expAccess        bool
+		accessProjectId  uint32
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expGetById    bool
+		getByIdInput  uint32
+		getByIdReturn *taskdomain.TaskDomain
+		getByIdErr    error
+
+		expUpdate       bool
+		updateTaskId    uint32
+		updateDesc      string
+		updateReturnErr error
+
+		in     *changedescmodel.ChangeDescriptionInput
+		expOut *changedescmodel.ChangeDescriptionOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "old"},
+			getByIdErr:    nil,
+
+			expUpdate:       true,
+			updateTaskId:    1,
+			updateDesc:      "new",
+			updateReturnErr: nil,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			expOut: changedescmodel.NewChangeDescriptionOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Invalid task id",
+
+			expAccess: false,
+
+			expGetById: false,
+			expUpdate:  false,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 0, "new"),
+			expOut: nil,
+			expErr: changedescerr.ErrInvalidTaskId,
+		}, {
+			testName: "Task not found",
+
+			expAccess: false,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: nil,
+			getByIdErr:    storage.ErrNotFound,
+
+			expUpdate: false,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			expOut: nil,
+			expErr: changedescerr.ErrTaskNotFound,
+		}, {
+			testName: "Invalid description",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "old"},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 1, strings.Repeat("A", 256)),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidDescription,
+		}, {
+			testName: "Task deleted before update",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "old"},
+			getByIdErr:    nil,
+
+			expUpdate:       true,
+			updateTaskId:    1,
+			updateDesc:      "new",
+			updateReturnErr: storage.ErrNotFound,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			expOut: nil,
+			expErr: changedescerr.ErrTaskNotFound,
+		}, {
+			testName: "Access denied",
+
+			expAccess:       true,
+			accessProjectId: 1,
+			accessReturnErr: projectaccess.ErrAccessDenied,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "old"},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			expOut: nil,
+			expErr: changedescerr.ErrAccessDenied,
+		}, {
+			testName: "Viewer",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "old"},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changedescmodel.NewChangeDescriptionInput(1, 1, "new"),
+			expOut: nil,
+			expErr: changedescerr.ErrForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := changedescmocks.NewMockStorageRepo(ctrl)
+			if tt.expGetById {
+				storMock.EXPECT().GetById(gomock.Any(), tt.getByIdInput).
+					Return(tt.getByIdReturn, tt.getByIdErr)
+			}
+			if tt.expUpdate {
+				storMock.EXPECT().UpdateDescription(gomock.Any(), tt.updateTaskId, tt.updateDesc).
+					Return(tt.updateReturnErr)
+			}
+
+			accessMock := changedescmocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.accessProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			changeDescUC := NewChangeDescriptionUC(log, storMock, accessMock)
+
+			out, err := changeDescUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package changestatusuc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	changestatuserr "taskservice/internal/usecase/error/changestatus"
+	changestatusmodel "taskservice/internal/usecase/models/changestatus"
+)
+
+type ChangeStatusUC struct {
+	log *slog.Logger
+
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+}
+
+func NewChangeStatusUC(log *slog.Logger, stor storage.StorageRepo, access projectaccess.ProjectAccessChecker) *ChangeStatusUC {
+	return &ChangeStatusUC{
+		log:    log,
+		stor:   stor,
+		access: access,
+	}
+}
+
+func (c *ChangeStatusUC) Execute(ctx context.Context, in *changestatusmodel.ChangeStatusInput) (*changestatusmodel.ChangeStatusOutput, error) {
+	const op = "changestatusuc.Execute"
+
+	log := c.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("taskId", int(in.TaskId)))
+
+	log.InfoContext(ctx, "starting change status")
+
+	if in.TaskId == 0 {
+		log.InfoContext(ctx, "invalid task id")
+		return nil, changestatuserr.ErrInvalidTaskId
+	}
+
+	td, err := c.stor.GetById(ctx, in.TaskId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, changestatuserr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot get task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	role, err := c.access.CheckAccess(ctx, in.UserId, td.ProjectId)
+	if err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, changestatuserr.ErrTaskNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, changestatuserr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !projectaccess.CanModifyTasks(role) {
+		log.InfoContext(ctx, "read-only access", slog.String("role", string(role)))
+		return nil, changestatuserr.ErrForbidden
+	}
+
+	from := td.Status
+	if err := td.ChangeStatus(taskdomain.Status(in.Status)); err != nil {
+		log.InfoContext(ctx, "cannot change status", slog.String("from", string(from)), slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if err := c.stor.UpdateStatus(ctx, td.Id, from, td.Status); err != nil {
+		if errors.Is(err, storage.ErrStatusChanged) {
+			log.InfoContext(ctx, "status changed concurrently")
+			return nil, changestatuserr.ErrStatusConflict
+		}
+		log.WarnContext(ctx, "cannot update status", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "status changed successfully", slog.String("status", string(td.Status)))
+
+	return changestatusmodel.NewChangeStatusOutput(true, string(td.Status)), nil
+}
@@ -0,0 +1,219 @@
+package changestatusuc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	changestatuserr "taskservice/internal/usecase/error/changestatus"
+	changestatusmocks "taskservice/internal/usecase/implementations/changestatus/mocks"
+	changestatusmodel "taskservice/internal/usecase/models/changestatus"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=changestatusmocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=changestatusmocks
+func TestChangeStatusUC(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		expAccess        bool
+		accessProjectId  uint32
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expGetById    bool
+		getByIdInput  uint32
+		getByIdReturn *taskdomain.TaskDomain
+		getByIdErr    error
+
+		expUpdate       bool
+		updateTaskId    uint32
+		updateFrom      taskdomain.Status
+		updateTo        taskdomain.Status
+		updateReturnErr error
+
+		in     *changestatusmodel.ChangeStatusInput
+		expOut *changestatusmodel.ChangeStatusOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Status: taskdomain.StatusTodo},
+			getByIdErr:    nil,
+
+			expUpdate:       true,
+			updateTaskId:    1,
+			updateFrom:      taskdomain.StatusTodo,
+			updateTo:        taskdomain.StatusInProgress,
+			updateReturnErr: nil,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			expOut: changestatusmodel.NewChangeStatusOutput(true, "in_progress"),
+			expErr: nil,
+		}, {
+			testName: "Invalid task id",
+
+			expAccess: false,
+
+			expGetById: false,
+			expUpdate:  false,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 0, "in_progress"),
+			expOut: nil,
+			expErr: changestatuserr.ErrInvalidTaskId,
+		}, {
+			testName: "Task not found",
+
+			expAccess: false,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: nil,
+			getByIdErr:    storage.ErrNotFound,
+
+			expUpdate: false,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			expOut: nil,
+			expErr: changestatuserr.ErrTaskNotFound,
+		}, {
+			testName: "Access denied",
+
+			expAccess:       true,
+			accessProjectId: 1,
+			accessReturnErr: projectaccess.ErrAccessDenied,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Status: taskdomain.StatusTodo},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			expOut: nil,
+			expErr: changestatuserr.ErrAccessDenied,
+		}, {
+			testName: "Viewer",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Status: taskdomain.StatusTodo},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "in_progress"),
+			expOut: nil,
+			expErr: changestatuserr.ErrForbidden,
+		}, {
+			testName: "Invalid status",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Status: taskdomain.StatusTodo},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "blocked"),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidStatus,
+		}, {
+			testName: "Transition not allowed",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Status: taskdomain.StatusTodo},
+			getByIdErr:    nil,
+
+			expUpdate: false,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "done"),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidStatusTransition,
+		}, {
+			testName: "Status changed concurrently",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Status: taskdomain.StatusReview},
+			getByIdErr:    nil,
+
+			expUpdate:       true,
+			updateTaskId:    1,
+			updateFrom:      taskdomain.StatusReview,
+			updateTo:        taskdomain.StatusDone,
+			updateReturnErr: storage.ErrStatusChanged,
+
+			in:     changestatusmodel.NewChangeStatusInput(1, 1, "done"),
+			expOut: nil,
+			expErr: changestatuserr.ErrStatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := changestatusmocks.NewMockStorageRepo(ctrl)
+			if tt.expGetById {
+				storMock.EXPECT().GetById(gomock.Any(), tt.getByIdInput).
+					Return(tt.getByIdReturn, tt.getByIdErr)
+			}
+			if tt.expUpdate {
+				storMock.EXPECT().UpdateStatus(gomock.Any(), tt.updateTaskId, tt.updateFrom, tt.updateTo).
+					Return(tt.updateReturnErr)
+			}
+
+			accessMock := changestatusmocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.accessProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			changeStatusUC := NewChangeStatusUC(log, storMock, accessMock)
+
+			out, err := changeStatusUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
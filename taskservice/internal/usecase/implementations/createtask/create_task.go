@@ -2,49 +2,107 @@ package createuc
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
 	"taskservice/internal/repository/storage"
+	"taskservice/internal/repository/userdirectory"
+	createerr "taskservice/internal/usecase/error/createtask"
 	createmodel "taskservice/internal/usecase/models/createtask"
 )
 
 type CreateTaskUC struct {
 	log *slog.Logger
 
-	stor storage.StorageRepo
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+	users  userdirectory.UserDirectory
 }
 
-func NewCreateTaskUC(log *slog.Logger, stor storage.StorageRepo) *CreateTaskUC {
+func NewCreateTaskUC(
+	log *slog.Logger,
+	stor storage.StorageRepo,
+	access projectaccess.ProjectAccessChecker,
+	users userdirectory.UserDirectory,
+) *CreateTaskUC {
 	return &CreateTaskUC{
-		log:  log,
-		stor: stor,
+		log:    log,
+		stor:   stor,
+		access: access,
+		users:  users,
 	}
 }
 
 func (c *CreateTaskUC) Execute(ctx context.Context, in *createmodel.CreateTaskInput) (*createmodel.CreateTaskOutput, error) {
 	const op = "createuc.Execute"
 
-	log := c.log.With(slog.String("op", op), slog.Int("projectId", int(in.ProjectId)))
+	log := c.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("projectId", int(in.ProjectId)))
 
-	log.Info("starting create task")
+	log.InfoContext(ctx, "starting create task")
 
 	td, err := taskdomain.NewTaskDomain(
 		in.ProjectId,
+		in.UserId,
+		in.Title,
 		in.Description,
+		taskdomain.Priority(in.Priority),
+		in.AssigneeId,
 		in.Deadline,
 	)
 	if err != nil {
-		log.Info("cannot create task", slog.String("error", err.Error()))
+		log.InfoContext(ctx, "cannot create task", slog.String("error", err.Error()))
 		return nil, err
 	}
 
+	role, err := c.access.CheckAccess(ctx, in.UserId, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, createerr.ErrProjectNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, createerr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !projectaccess.CanModifyTasks(role) {
+		log.InfoContext(ctx, "read-only access", slog.String("role", string(role)))
+		return nil, createerr.ErrForbidden
+	}
+
+	if td.AssigneeId != 0 && td.AssigneeId != in.UserId {
+		if _, err := c.users.GetUserName(ctx, td.AssigneeId); err != nil {
+			if errors.Is(err, userdirectory.ErrUserNotFound) {
+				log.InfoContext(ctx, "assignee not found", slog.Int("assigneeId", int(td.AssigneeId)))
+				return nil, createerr.ErrInvalidAssignee
+			}
+			log.WarnContext(ctx, "cannot get assignee", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		if _, err := c.access.CheckAccess(ctx, td.AssigneeId, in.ProjectId); err != nil {
+			if errors.Is(err, projectaccess.ErrAccessDenied) {
+				log.InfoContext(ctx, "assignee has no access to project", slog.Int("assigneeId", int(td.AssigneeId)))
+				return nil, createerr.ErrInvalidAssignee
+			} else if errors.Is(err, projectaccess.ErrProjectNotFound) {
+				log.InfoContext(ctx, "project not found")
+				return nil, createerr.ErrProjectNotFound
+			}
+			log.WarnContext(ctx, "cannot check assignee access", slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+
 	id, err := c.stor.Save(ctx, td)
 	if err != nil {
-		log.Warn("cannot save task", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "cannot save task", slog.String("error", err.Error()))
 		return nil, err
 	}
 
-	log.Info("task created successfully")
+	log.InfoContext(ctx, "task created successfully")
 
 	return createmodel.NewCreateOutput(id), nil
 }
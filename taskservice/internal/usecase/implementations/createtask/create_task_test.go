@@ -6,6 +6,9 @@ import (
 	"log/slog"
 	"strings"
 	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/userdirectory"
+	createerr "taskservice/internal/usecase/error/createtask"
 	createmocks "taskservice/internal/usecase/implementations/createtask/mocks"
 	createmodel "taskservice/internal/usecase/models/createtask"
 	"testing"
@@ -16,12 +19,25 @@ import (
 )
 
 //go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=createmocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=createmocks
+//go:generate mockgen -source=./../../../repository/userdirectory/user_directory.go -destination=./mocks/mock_user_directory.go -package=createmocks
 func TestCreateUC(t *testing.T) {
 	timeNow := time.Now()
 
 	tests := []struct {
 		testName string
 
+		expAccess        bool
+		accessProjectId  uint32
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expUserLookup bool
+		userLookupErr error
+
+		expAssigneeAccess bool
+		assigneeReturnErr error
+
 		expStorage    bool
 		storInput     *taskdomain.TaskDomain
 		storReturn    uint32
@@ -34,11 +50,20 @@ func TestCreateUC(t *testing.T) {
 		{
 			testName: "Success",
 
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
 			expStorage: true,
 			storInput: &taskdomain.TaskDomain{
 				Id:          0,
 				ProjectId:   1,
+				Title:       "title",
 				Description: "desc",
+				Status:      taskdomain.StatusTodo,
+				Priority:    taskdomain.PriorityMedium,
+				CreatedBy:   1,
 				Deadline:    timeNow,
 			},
 			storReturn:    1,
@@ -46,7 +71,11 @@ func TestCreateUC(t *testing.T) {
 
 			in: createmodel.NewCreateInput(
 				1,
+				1,
+				"title",
 				"desc",
+				"",
+				0,
 				timeNow,
 			),
 			expOut: createmodel.NewCreateOutput(
@@ -54,45 +83,231 @@ func TestCreateUC(t *testing.T) {
 			),
 			expErr: nil,
 		}, {
-			testName: "Bad project id",
+			testName: "Success with assignee",
 
-			expStorage: false,
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUserLookup: true,
+			userLookupErr: nil,
+
+			expAssigneeAccess: true,
+			assigneeReturnErr: nil,
+
+			expStorage: true,
 			storInput: &taskdomain.TaskDomain{
 				Id:          0,
 				ProjectId:   1,
+				Title:       "title",
 				Description: "desc",
+				Status:      taskdomain.StatusTodo,
+				Priority:    taskdomain.PriorityHigh,
+				AssigneeId:  2,
+				CreatedBy:   1,
 				Deadline:    timeNow,
 			},
 			storReturn:    1,
 			storReturnErr: nil,
 
 			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"high",
+				2,
+				timeNow,
+			),
+			expOut: createmodel.NewCreateOutput(
+				1,
+			),
+			expErr: nil,
+		}, {
+			testName: "Bad project id",
+
+			expAccess: false,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
 				0,
+				"title",
 				"desc",
+				"",
+				0,
 				timeNow,
 			),
 			expOut: nil,
 			expErr: taskdomain.ErrInvalidProjectId,
+		}, {
+			testName: "Bad title",
+
+			expAccess: false,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"",
+				"desc",
+				"",
+				0,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidTitle,
 		}, {
 			testName: "Bad description",
 
+			expAccess: false,
+
 			expStorage: false,
-			storInput: &taskdomain.TaskDomain{
-				Id:          0,
-				ProjectId:   1,
-				Description: "desc",
-				Deadline:    timeNow,
-			},
-			storReturn:    1,
-			storReturnErr: nil,
 
 			in: createmodel.NewCreateInput(
 				1,
+				1,
+				"title",
 				strings.Repeat("A", 256),
+				"",
+				0,
 				timeNow,
 			),
 			expOut: nil,
 			expErr: taskdomain.ErrInvalidDescription,
+		}, {
+			testName: "Bad priority",
+
+			expAccess: false,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"urgent",
+				0,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidPriority,
+		}, {
+			testName: "Access denied",
+
+			expAccess:       true,
+			accessProjectId: 1,
+			accessReturnErr: projectaccess.ErrAccessDenied,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"",
+				0,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: createerr.ErrAccessDenied,
+		}, {
+			testName: "Viewer",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"",
+				0,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: createerr.ErrForbidden,
+		}, {
+			testName: "Project not found",
+
+			expAccess:       true,
+			accessProjectId: 1,
+			accessReturnErr: projectaccess.ErrProjectNotFound,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"",
+				0,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: createerr.ErrProjectNotFound,
+		}, {
+			testName: "Assignee not a member",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUserLookup: true,
+			userLookupErr: nil,
+
+			expAssigneeAccess: true,
+			assigneeReturnErr: projectaccess.ErrAccessDenied,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"",
+				2,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: createerr.ErrInvalidAssignee,
+		}, {
+			testName: "Assignee not found",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUserLookup: true,
+			userLookupErr: userdirectory.ErrUserNotFound,
+
+			expStorage: false,
+
+			in: createmodel.NewCreateInput(
+				1,
+				1,
+				"title",
+				"desc",
+				"",
+				2,
+				timeNow,
+			),
+			expOut: nil,
+			expErr: createerr.ErrInvalidAssignee,
 		},
 	}
 
@@ -107,9 +322,25 @@ func TestCreateUC(t *testing.T) {
 					Return(tt.storReturn, tt.storReturnErr)
 			}
 
+			accessMock := createmocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.accessProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+			if tt.expAssigneeAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.AssigneeId, tt.accessProjectId).
+					Return(projectaccess.RoleMember, tt.assigneeReturnErr)
+			}
+
+			usersMock := createmocks.NewMockUserDirectory(ctrl)
+			if tt.expUserLookup {
+				usersMock.EXPECT().GetUserName(gomock.Any(), tt.in.AssigneeId).
+					Return("", tt.userLookupErr)
+			}
+
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-			createUC := NewCreateTaskUC(log, storMock)
+			createUC := NewCreateTaskUC(log, storMock, accessMock, usersMock)
 
 			out, err := createUC.Execute(context.Background(), tt.in)
 			require.Equal(t, tt.expErr, err)
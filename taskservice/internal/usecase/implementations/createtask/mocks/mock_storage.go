@@ -41,6 +41,64 @@ func (m *MockStorageRepo) EXPECT() *MockStorageRepoMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockStorageRepo) Delete(ctx context.Context, taskId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, taskId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageRepoMockRecorder) Delete(ctx, taskId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorageRepo)(nil).Delete), ctx, taskId)
+}
+
+// DeleteByProjectId mocks base method.
+func (m *MockStorageRepo) DeleteByProjectId(ctx context.Context, eventId uint64, projectId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteByProjectId", ctx, eventId, projectId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteByProjectId indicates an expected call of DeleteByProjectId.
+func (mr *MockStorageRepoMockRecorder) DeleteByProjectId(ctx, eventId, projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteByProjectId", reflect.TypeOf((*MockStorageRepo)(nil).DeleteByProjectId), ctx, eventId, projectId)
+}
+
+// GetAll mocks base method.
+func (m *MockStorageRepo) GetAll(ctx context.Context, projectId uint32) ([]*taskdomain.TaskDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll", ctx, projectId)
+	ret0, _ := ret[0].([]*taskdomain.TaskDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStorageRepoMockRecorder) GetAll(ctx, projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStorageRepo)(nil).GetAll), ctx, projectId)
+}
+
+// GetById mocks base method.
+func (m *MockStorageRepo) GetById(ctx context.Context, taskId uint32) (*taskdomain.TaskDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetById", ctx, taskId)
+	ret0, _ := ret[0].(*taskdomain.TaskDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetById indicates an expected call of GetById.
+func (mr *MockStorageRepoMockRecorder) GetById(ctx, taskId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetById", reflect.TypeOf((*MockStorageRepo)(nil).GetById), ctx, taskId)
+}
+
 // Save mocks base method.
 func (m *MockStorageRepo) Save(ctx context.Context, td *taskdomain.TaskDomain) (uint32, error) {
 	m.ctrl.T.Helper()
@@ -55,3 +113,46 @@ func (mr *MockStorageRepoMockRecorder) Save(ctx, td any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockStorageRepo)(nil).Save), ctx, td)
 }
+
+// Update mocks base method.
+func (m *MockStorageRepo) Update(ctx context.Context, td *taskdomain.TaskDomain) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, td)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockStorageRepoMockRecorder) Update(ctx, td any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockStorageRepo)(nil).Update), ctx, td)
+}
+
+// UpdateDescription mocks base method.
+func (m *MockStorageRepo) UpdateDescription(ctx context.Context, taskId uint32, description string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDescription", ctx, taskId, description)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateDescription indicates an expected call of UpdateDescription.
+func (mr *MockStorageRepoMockRecorder) UpdateDescription(ctx, taskId, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDescription", reflect.TypeOf((*MockStorageRepo)(nil).UpdateDescription), ctx, taskId, description)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockStorageRepo) UpdateStatus(ctx context.Context, taskId uint32, from, to taskdomain.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, taskId, from, to)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockStorageRepoMockRecorder) UpdateStatus(ctx, taskId, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockStorageRepo)(nil).UpdateStatus), ctx, taskId, from, to)
+}
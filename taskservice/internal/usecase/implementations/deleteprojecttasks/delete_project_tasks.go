@@ -0,0 +1,49 @@
+package deleteprojtasksuc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"taskservice/internal/repository/storage"
+	deleteprojtaskserr "taskservice/internal/usecase/error/deleteprojecttasks"
+	deleteprojtasksmodel "taskservice/internal/usecase/models/deleteprojecttasks"
+)
+
+type DeleteProjectTasksUC struct {
+	log *slog.Logger
+
+	stor storage.StorageRepo
+}
+
+func NewDeleteProjectTasksUC(log *slog.Logger, stor storage.StorageRepo) *DeleteProjectTasksUC {
+	return &DeleteProjectTasksUC{
+		log:  log,
+		stor: stor,
+	}
+}
+
+func (d *DeleteProjectTasksUC) Execute(ctx context.Context, in *deleteprojtasksmodel.DeleteProjectTasksInput) (*deleteprojtasksmodel.DeleteProjectTasksOutput, error) {
+	const op = "deleteprojtasksuc.Execute"
+
+	log := d.log.With(slog.String("op", op), slog.Uint64("eventId", in.EventId), slog.Int("projectId", int(in.ProjectId)))
+
+	log.InfoContext(ctx, "starting delete project tasks")
+
+	if in.ProjectId == 0 {
+		log.InfoContext(ctx, "invalid project id")
+		return nil, deleteprojtaskserr.ErrInvalidProjectId
+	}
+
+	if err := d.stor.DeleteByProjectId(ctx, in.EventId, in.ProjectId); err != nil {
+		if errors.Is(err, storage.ErrAlreadyProcessed) {
+			log.InfoContext(ctx, "event already processed")
+			return deleteprojtasksmodel.NewDeleteProjectTasksOutput(false), nil
+		}
+		log.WarnContext(ctx, "cannot delete project tasks", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "project tasks deleted successfully")
+
+	return deleteprojtasksmodel.NewDeleteProjectTasksOutput(true), nil
+}
@@ -0,0 +1,98 @@
+package deleteprojtasksuc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"taskservice/internal/repository/storage"
+	deleteprojtaskserr "taskservice/internal/usecase/error/deleteprojecttasks"
+	deleteprojtasksmocks "taskservice/internal/usecase/implementations/deleteprojecttasks/mocks"
+	deleteprojtasksmodel "taskservice/internal/usecase/models/deleteprojecttasks"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=deleteprojtasksmocks
+func TestDeleteProjectTasksUC(t *testing.T) {
+	storErr := errors.New("storage error")
+
+	tests := []struct {
+		testName string
+
+		expStorage    bool
+		storEventId   uint64
+		storProjectId uint32
+		storReturnErr error
+
+		in     *deleteprojtasksmodel.DeleteProjectTasksInput
+		expOut *deleteprojtasksmodel.DeleteProjectTasksOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expStorage:    true,
+			storEventId:   1,
+			storProjectId: 2,
+			storReturnErr: nil,
+
+			in:     deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 2),
+			expOut: deleteprojtasksmodel.NewDeleteProjectTasksOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Already processed",
+
+			expStorage:    true,
+			storEventId:   1,
+			storProjectId: 2,
+			storReturnErr: storage.ErrAlreadyProcessed,
+
+			in:     deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 2),
+			expOut: deleteprojtasksmodel.NewDeleteProjectTasksOutput(false),
+			expErr: nil,
+		}, {
+			testName: "Invalid project id",
+
+			expStorage: false,
+
+			in:     deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 0),
+			expOut: nil,
+			expErr: deleteprojtaskserr.ErrInvalidProjectId,
+		}, {
+			testName: "Storage error",
+
+			expStorage:    true,
+			storEventId:   1,
+			storProjectId: 2,
+			storReturnErr: storErr,
+
+			in:     deleteprojtasksmodel.NewDeleteProjectTasksInput(1, 2),
+			expOut: nil,
+			expErr: storErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := deleteprojtasksmocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storMock.EXPECT().DeleteByProjectId(gomock.Any(), tt.storEventId, tt.storProjectId).
+					Return(tt.storReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			deleteProjTasksUC := NewDeleteProjectTasksUC(log, storMock)
+
+			out, err := deleteProjTasksUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
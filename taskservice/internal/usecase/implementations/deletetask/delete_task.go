@@ -0,0 +1,80 @@
+package deleteuc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	deleteerr "taskservice/internal/usecase/error/deletetask"
+	deletemodel "taskservice/internal/usecase/models/deletetask"
+)
+
+type DeleteTaskUC struct {
+	log *slog.Logger
+
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+}
+
+func NewDeleteTaskUC(log *slog.Logger, stor storage.StorageRepo, access projectaccess.ProjectAccessChecker) *DeleteTaskUC {
+	return &DeleteTaskUC{
+		log:    log,
+		stor:   stor,
+		access: access,
+	}
+}
+
+func (d *DeleteTaskUC) Execute(ctx context.Context, in *deletemodel.DeleteTaskInput) (*deletemodel.DeleteTaskOutput, error) {
+	const op = "deleteuc.Execute"
+
+	log := d.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("taskId", int(in.TaskId)))
+
+	log.InfoContext(ctx, "starting delete task")
+
+	if in.TaskId == 0 {
+		log.InfoContext(ctx, "invalid task id")
+		return nil, deleteerr.ErrInvalidTaskId
+	}
+
+	td, err := d.stor.GetById(ctx, in.TaskId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, deleteerr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot get task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	role, err := d.access.CheckAccess(ctx, in.UserId, td.ProjectId)
+	if err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, deleteerr.ErrTaskNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, deleteerr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !projectaccess.CanModifyTasks(role) {
+		log.InfoContext(ctx, "read-only access", slog.String("role", string(role)))
+		return nil, deleteerr.ErrForbidden
+	}
+
+	if err := d.stor.Delete(ctx, td.Id); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, deleteerr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot delete task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "task deleted successfully")
+
+	return deletemodel.NewDeleteTaskOutput(true), nil
+}
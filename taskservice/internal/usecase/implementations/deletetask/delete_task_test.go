@@ -0,0 +1,198 @@
+package deleteuc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	deleteerr "taskservice/internal/usecase/error/deletetask"
+	deletemocks "taskservice/internal/usecase/implementations/deletetask/mocks"
+	deletemodel "taskservice/internal/usecase/models/deletetask"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=deletemocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=deletemocks
+func TestDeleteTaskUC(t *testing.T) {
+	storErr := errors.New("storage error")
+
+	tests := []struct {
+		testName string
+
+		expGetById    bool
+		getByIdInput  uint32
+		getByIdReturn *taskdomain.TaskDomain
+		getByIdErr    error
+
+		expAccess        bool
+		accessProjectId  uint32
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expStorage    bool
+		storInput     uint32
+		storReturnErr error
+
+		in     *deletemodel.DeleteTaskInput
+		expOut *deletemodel.DeleteTaskOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc"},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessProjectId:  2,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturnErr: nil,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 1),
+			expOut: deletemodel.NewDeleteTaskOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Invalid task id",
+
+			expGetById: false,
+			expAccess:  false,
+			expStorage: false,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 0),
+			expOut: nil,
+			expErr: deleteerr.ErrInvalidTaskId,
+		}, {
+			testName: "Not found",
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: nil,
+			getByIdErr:    storage.ErrNotFound,
+
+			expAccess:  false,
+			expStorage: false,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 1),
+			expOut: nil,
+			expErr: deleteerr.ErrTaskNotFound,
+		}, {
+			testName: "Access denied",
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc"},
+			getByIdErr:    nil,
+
+			expAccess:       true,
+			accessProjectId: 2,
+			accessReturnErr: projectaccess.ErrAccessDenied,
+
+			expStorage: false,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 1),
+			expOut: nil,
+			expErr: deleteerr.ErrAccessDenied,
+		}, {
+			testName: "Viewer",
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc"},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessProjectId:  2,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expStorage: false,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 1),
+			expOut: nil,
+			expErr: deleteerr.ErrForbidden,
+		}, {
+			testName: "Deleted between get and delete",
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc"},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessProjectId:  2,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturnErr: storage.ErrNotFound,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 1),
+			expOut: nil,
+			expErr: deleteerr.ErrTaskNotFound,
+		}, {
+			testName: "Storage error",
+
+			expGetById:    true,
+			getByIdInput:  1,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc"},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessProjectId:  2,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturnErr: storErr,
+
+			in:     deletemodel.NewDeleteTaskInput(1, 1),
+			expOut: nil,
+			expErr: storErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := deletemocks.NewMockStorageRepo(ctrl)
+			if tt.expGetById {
+				storMock.EXPECT().GetById(gomock.Any(), tt.getByIdInput).
+					Return(tt.getByIdReturn, tt.getByIdErr)
+			}
+			if tt.expStorage {
+				storMock.EXPECT().Delete(gomock.Any(), tt.storInput).
+					Return(tt.storReturnErr)
+			}
+
+			accessMock := deletemocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.accessProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			deleteUC := NewDeleteTaskUC(log, storMock, accessMock)
+
+			out, err := deleteUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
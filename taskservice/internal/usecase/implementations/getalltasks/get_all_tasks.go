@@ -0,0 +1,95 @@
+package getalluc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	"taskservice/internal/repository/userdirectory"
+	getallerr "taskservice/internal/usecase/error/getalltasks"
+	getallmodel "taskservice/internal/usecase/models/getalltasks"
+)
+
+type GetAllTasksUC struct {
+	log *slog.Logger
+
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+	users  userdirectory.UserDirectory
+}
+
+func NewGetAllTasksUC(
+	log *slog.Logger,
+	stor storage.StorageRepo,
+	access projectaccess.ProjectAccessChecker,
+	users userdirectory.UserDirectory,
+) *GetAllTasksUC {
+	return &GetAllTasksUC{
+		log:    log,
+		stor:   stor,
+		access: access,
+		users:  users,
+	}
+}
+
+func (g *GetAllTasksUC) Execute(ctx context.Context, in *getallmodel.GetAllTasksInput) (*getallmodel.GetAllTasksOutput, error) {
+	const op = "getalluc.Execute"
+
+	log := g.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("projectId", int(in.ProjectId)))
+
+	log.InfoContext(ctx, "starting get all tasks")
+
+	if in.ProjectId == 0 {
+		log.InfoContext(ctx, "invalid project id")
+		return nil, getallerr.ErrInvalidProjectId
+	}
+
+	if _, err := g.access.CheckAccess(ctx, in.UserId, in.ProjectId); err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, getallerr.ErrProjectNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, getallerr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	tasks, err := g.stor.GetAll(ctx, in.ProjectId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "tasks not found")
+			return nil, getallerr.ErrTasksNotFound
+		}
+		log.WarnContext(ctx, "cannot get tasks", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	userNames, err := g.users.GetUserNames(ctx, collectUserIds(tasks))
+	if err != nil {
+		log.WarnContext(ctx, "cannot get user names", slog.String("error", err.Error()))
+		userNames = nil
+	}
+
+	log.InfoContext(ctx, "tasks received successfully")
+
+	return getallmodel.NewGetAllTasksOutput(tasks, userNames), nil
+}
+
+func collectUserIds(tasks []*taskdomain.TaskDomain) []uint32 {
+	seen := make(map[uint32]struct{})
+	ids := make([]uint32, 0, len(tasks))
+	for _, task := range tasks {
+		for _, id := range task.UserIds() {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
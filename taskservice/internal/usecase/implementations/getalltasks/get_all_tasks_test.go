@@ -0,0 +1,160 @@
+package getalluc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	getallerr "taskservice/internal/usecase/error/getalltasks"
+	getallmocks "taskservice/internal/usecase/implementations/getalltasks/mocks"
+	getallmodel "taskservice/internal/usecase/models/getalltasks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getallmocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=getallmocks
+//go:generate mockgen -source=./../../../repository/userdirectory/user_directory.go -destination=./mocks/mock_user_directory.go -package=getallmocks
+func TestGetAllTasksUC(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expAccess        bool
+		accessProjectId  uint32
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expStorage    bool
+		storInput     uint32
+		storReturn    []*taskdomain.TaskDomain
+		storReturnErr error
+
+		expUsers       bool
+		usersInput     []uint32
+		usersReturn    map[uint32]string
+		usersReturnErr error
+
+		in     *getallmodel.GetAllTasksInput
+		expOut *getallmodel.GetAllTasksOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expStorage: true,
+			storInput:  1,
+			storReturn: []*taskdomain.TaskDomain{
+				{Id: 1, ProjectId: 1, Description: "A", CreatedBy: 1, AssigneeId: 2, Deadline: timeNow},
+				{Id: 2, ProjectId: 1, Description: "B", CreatedBy: 2, AssigneeId: 3, Deadline: timeNow},
+			},
+			storReturnErr: nil,
+
+			expUsers:       true,
+			usersInput:     []uint32{1, 2, 3},
+			usersReturn:    map[uint32]string{1: "Ivan Ivanov", 2: "Petr Petrov"},
+			usersReturnErr: nil,
+
+			in: getallmodel.NewGetAllTasksInput(1, 1),
+			expOut: getallmodel.NewGetAllTasksOutput([]*taskdomain.TaskDomain{
+				{Id: 1, ProjectId: 1, Description: "A", CreatedBy: 1, AssigneeId: 2, Deadline: timeNow},
+				{Id: 2, ProjectId: 1, Description: "B", CreatedBy: 2, AssigneeId: 3, Deadline: timeNow},
+			}, map[uint32]string{1: "Ivan Ivanov", 2: "Petr Petrov"}),
+			expErr: nil,
+		}, {
+			testName: "Invalid project id",
+
+			expAccess: false,
+
+			expStorage: false,
+
+			in:     getallmodel.NewGetAllTasksInput(1, 0),
+			expOut: nil,
+			expErr: getallerr.ErrInvalidProjectId,
+		}, {
+			testName: "Not found",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturn:    nil,
+			storReturnErr: storage.ErrNotFound,
+
+			in:     getallmodel.NewGetAllTasksInput(1, 1),
+			expOut: nil,
+			expErr: getallerr.ErrTasksNotFound,
+		}, {
+			testName: "Access denied",
+
+			expAccess:       true,
+			accessProjectId: 1,
+			accessReturnErr: projectaccess.ErrAccessDenied,
+
+			expStorage: false,
+
+			in:     getallmodel.NewGetAllTasksInput(1, 1),
+			expOut: nil,
+			expErr: getallerr.ErrAccessDenied,
+		}, {
+			testName: "Project not found",
+
+			expAccess:       true,
+			accessProjectId: 1,
+			accessReturnErr: projectaccess.ErrProjectNotFound,
+
+			expStorage: false,
+
+			in:     getallmodel.NewGetAllTasksInput(1, 1),
+			expOut: nil,
+			expErr: getallerr.ErrProjectNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := getallmocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storMock.EXPECT().GetAll(gomock.Any(), tt.storInput).
+					Return(tt.storReturn, tt.storReturnErr)
+			}
+
+			accessMock := getallmocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.accessProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+
+			usersMock := getallmocks.NewMockUserDirectory(ctrl)
+			if tt.expUsers {
+				usersMock.EXPECT().GetUserNames(gomock.Any(), tt.usersInput).
+					Return(tt.usersReturn, tt.usersReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			getAllUC := NewGetAllTasksUC(log, storMock, accessMock, usersMock)
+
+			out, err := getAllUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
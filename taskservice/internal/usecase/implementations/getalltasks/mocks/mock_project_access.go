@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/projectaccess/project_access.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=getallmocks
+//
+
+// Package getallmocks is a generated GoMock package.
+package getallmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	projectaccess "taskservice/internal/repository/projectaccess"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockProjectAccessChecker is a mock of ProjectAccessChecker interface.
+type MockProjectAccessChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockProjectAccessCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockProjectAccessCheckerMockRecorder is the mock recorder for MockProjectAccessChecker.
+type MockProjectAccessCheckerMockRecorder struct {
+	mock *MockProjectAccessChecker
+}
+
+// NewMockProjectAccessChecker creates a new mock instance.
+func NewMockProjectAccessChecker(ctrl *gomock.Controller) *MockProjectAccessChecker {
+	mock := &MockProjectAccessChecker{ctrl: ctrl}
+	mock.recorder = &MockProjectAccessCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProjectAccessChecker) EXPECT() *MockProjectAccessCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckAccess mocks base method.
+func (m *MockProjectAccessChecker) CheckAccess(ctx context.Context, userId, projectId uint32) (projectaccess.Role, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", ctx, userId, projectId)
+	ret0, _ := ret[0].(projectaccess.Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockProjectAccessCheckerMockRecorder) CheckAccess(ctx, userId, projectId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockProjectAccessChecker)(nil).CheckAccess), ctx, userId, projectId)
+}
@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/userdirectory/user_directory.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/userdirectory/user_directory.go -destination=./mocks/mock_user_directory.go -package=getallmocks
+//
+
+// Package getallmocks is a generated GoMock package.
+package getallmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUserDirectory is a mock of UserDirectory interface.
+type MockUserDirectory struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserDirectoryMockRecorder
+	isgomock struct{}
+}
+
+// MockUserDirectoryMockRecorder is the mock recorder for MockUserDirectory.
+type MockUserDirectoryMockRecorder struct {
+	mock *MockUserDirectory
+}
+
+// NewMockUserDirectory creates a new mock instance.
+func NewMockUserDirectory(ctrl *gomock.Controller) *MockUserDirectory {
+	mock := &MockUserDirectory{ctrl: ctrl}
+	mock.recorder = &MockUserDirectoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserDirectory) EXPECT() *MockUserDirectoryMockRecorder {
+	return m.recorder
+}
+
+// GetUserName mocks base method.
+func (m *MockUserDirectory) GetUserName(ctx context.Context, userId uint32) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserName", ctx, userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserName indicates an expected call of GetUserName.
+func (mr *MockUserDirectoryMockRecorder) GetUserName(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserName", reflect.TypeOf((*MockUserDirectory)(nil).GetUserName), ctx, userId)
+}
+
+// GetUserNames mocks base method.
+func (m *MockUserDirectory) GetUserNames(ctx context.Context, userIds []uint32) (map[uint32]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserNames", ctx, userIds)
+	ret0, _ := ret[0].(map[uint32]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserNames indicates an expected call of GetUserNames.
+func (mr *MockUserDirectoryMockRecorder) GetUserNames(ctx, userIds any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserNames", reflect.TypeOf((*MockUserDirectory)(nil).GetUserNames), ctx, userIds)
+}
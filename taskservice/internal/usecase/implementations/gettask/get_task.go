@@ -0,0 +1,79 @@
+package getuc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	"taskservice/internal/repository/userdirectory"
+	geterr "taskservice/internal/usecase/error/gettask"
+	getmodel "taskservice/internal/usecase/models/gettask"
+)
+
+type GetTaskUC struct {
+	log *slog.Logger
+
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+	users  userdirectory.UserDirectory
+}
+
+func NewGetTaskUC(
+	log *slog.Logger,
+	stor storage.StorageRepo,
+	access projectaccess.ProjectAccessChecker,
+	users userdirectory.UserDirectory,
+) *GetTaskUC {
+	return &GetTaskUC{
+		log:    log,
+		stor:   stor,
+		access: access,
+		users:  users,
+	}
+}
+
+func (g *GetTaskUC) Execute(ctx context.Context, in *getmodel.GetTaskInput) (*getmodel.GetTaskOutput, error) {
+	const op = "getuc.Execute"
+
+	log := g.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("taskId", int(in.TaskId)))
+
+	log.InfoContext(ctx, "starting get task")
+
+	if in.TaskId == 0 {
+		log.InfoContext(ctx, "invalid task id")
+		return nil, geterr.ErrInvalidTaskId
+	}
+
+	task, err := g.stor.GetById(ctx, in.TaskId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, geterr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot get task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if _, err := g.access.CheckAccess(ctx, in.UserId, task.ProjectId); err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, geterr.ErrTaskNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, geterr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	userNames, err := g.users.GetUserNames(ctx, task.UserIds())
+	if err != nil {
+		log.WarnContext(ctx, "cannot get user names", slog.String("error", err.Error()))
+		userNames = nil
+	}
+
+	log.InfoContext(ctx, "task received successfully")
+
+	return getmodel.NewGetTaskOutput(task, userNames), nil
+}
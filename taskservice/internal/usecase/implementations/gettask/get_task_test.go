@@ -0,0 +1,182 @@
+package getuc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	geterr "taskservice/internal/usecase/error/gettask"
+	getmocks "taskservice/internal/usecase/implementations/gettask/mocks"
+	getmodel "taskservice/internal/usecase/models/gettask"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getmocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=getmocks
+//go:generate mockgen -source=./../../../repository/userdirectory/user_directory.go -destination=./mocks/mock_user_directory.go -package=getmocks
+func TestGetTaskUC(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expAccess        bool
+		accessProjectId  uint32
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expStorage    bool
+		storInput     uint32
+		storReturn    *taskdomain.TaskDomain
+		storReturnErr error
+
+		expUsers       bool
+		usersInput     []uint32
+		usersReturn    map[uint32]string
+		usersReturnErr error
+
+		in     *getmodel.GetTaskInput
+		expOut *getmodel.GetTaskOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturn:    &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "desc", AssigneeId: 2, CreatedBy: 1, Deadline: timeNow},
+			storReturnErr: nil,
+
+			expUsers:       true,
+			usersInput:     []uint32{1, 2},
+			usersReturn:    map[uint32]string{1: "Ivan Ivanov", 2: "Petr Petrov"},
+			usersReturnErr: nil,
+
+			in: getmodel.NewGetTaskInput(1, 1),
+			expOut: getmodel.NewGetTaskOutput(
+				&taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "desc", AssigneeId: 2, CreatedBy: 1, Deadline: timeNow},
+				map[uint32]string{1: "Ivan Ivanov", 2: "Petr Petrov"},
+			),
+			expErr: nil,
+		}, {
+			testName: "User names unavailable",
+
+			expAccess:        true,
+			accessProjectId:  1,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturn:    &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "desc", CreatedBy: 1, Deadline: timeNow},
+			storReturnErr: nil,
+
+			expUsers:       true,
+			usersInput:     []uint32{1},
+			usersReturn:    nil,
+			usersReturnErr: errors.New("unavailable"),
+
+			in:     getmodel.NewGetTaskInput(1, 1),
+			expOut: getmodel.NewGetTaskOutput(&taskdomain.TaskDomain{Id: 1, ProjectId: 1, Description: "desc", CreatedBy: 1, Deadline: timeNow}, nil),
+			expErr: nil,
+		}, {
+			testName: "Invalid task id",
+
+			expAccess: false,
+
+			expStorage: false,
+
+			in:     getmodel.NewGetTaskInput(1, 0),
+			expOut: nil,
+			expErr: geterr.ErrInvalidTaskId,
+		}, {
+			testName: "Not found",
+
+			expAccess: false,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturn:    nil,
+			storReturnErr: storage.ErrNotFound,
+
+			in:     getmodel.NewGetTaskInput(1, 1),
+			expOut: nil,
+			expErr: geterr.ErrTaskNotFound,
+		}, {
+			testName: "Access denied",
+
+			expAccess:       true,
+			accessProjectId: 2,
+			accessReturnErr: projectaccess.ErrAccessDenied,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturn:    &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc", Deadline: timeNow},
+			storReturnErr: nil,
+
+			in:     getmodel.NewGetTaskInput(1, 1),
+			expOut: nil,
+			expErr: geterr.ErrAccessDenied,
+		}, {
+			testName: "Project not found",
+
+			expAccess:       true,
+			accessProjectId: 2,
+			accessReturnErr: projectaccess.ErrProjectNotFound,
+
+			expStorage:    true,
+			storInput:     1,
+			storReturn:    &taskdomain.TaskDomain{Id: 1, ProjectId: 2, Description: "desc", Deadline: timeNow},
+			storReturnErr: nil,
+
+			in:     getmodel.NewGetTaskInput(1, 1),
+			expOut: nil,
+			expErr: geterr.ErrTaskNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := getmocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storMock.EXPECT().GetById(gomock.Any(), tt.storInput).
+					Return(tt.storReturn, tt.storReturnErr)
+			}
+
+			accessMock := getmocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.accessProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+
+			usersMock := getmocks.NewMockUserDirectory(ctrl)
+			if tt.expUsers {
+				usersMock.EXPECT().GetUserNames(gomock.Any(), tt.usersInput).
+					Return(tt.usersReturn, tt.usersReturnErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			getUC := NewGetTaskUC(log, storMock, accessMock, usersMock)
+
+			out, err := getUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
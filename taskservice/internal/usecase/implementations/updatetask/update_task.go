@@ -0,0 +1,153 @@
+package updateuc
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	"taskservice/internal/repository/userdirectory"
+	updateerr "taskservice/internal/usecase/error/updatetask"
+	updatemodel "taskservice/internal/usecase/models/updatetask"
+)
+
+type UpdateTaskUC struct {
+	log *slog.Logger
+
+	stor   storage.StorageRepo
+	access projectaccess.ProjectAccessChecker
+	users  userdirectory.UserDirectory
+}
+
+func NewUpdateTaskUC(
+	log *slog.Logger,
+	stor storage.StorageRepo,
+	access projectaccess.ProjectAccessChecker,
+	users userdirectory.UserDirectory,
+) *UpdateTaskUC {
+	return &UpdateTaskUC{
+		log:    log,
+		stor:   stor,
+		access: access,
+		users:  users,
+	}
+}
+
+func (u *UpdateTaskUC) Execute(ctx context.Context, in *updatemodel.UpdateTaskInput) (*updatemodel.UpdateTaskOutput, error) {
+	const op = "updateuc.Execute"
+
+	log := u.log.With(slog.String("op", op), slog.Int("userId", int(in.UserId)), slog.Int("taskId", int(in.TaskId)))
+
+	log.InfoContext(ctx, "starting update task")
+
+	if in.TaskId == 0 {
+		log.InfoContext(ctx, "invalid task id")
+		return nil, updateerr.ErrInvalidTaskId
+	}
+	if in.Version == 0 {
+		log.InfoContext(ctx, "invalid version")
+		return nil, updateerr.ErrInvalidVersion
+	}
+	if in.Title == nil && in.Priority == nil && in.AssigneeId == nil {
+		log.InfoContext(ctx, "nothing to update")
+		return nil, updateerr.ErrNothingToUpdate
+	}
+
+	td, err := u.stor.GetById(ctx, in.TaskId)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			log.InfoContext(ctx, "task not found")
+			return nil, updateerr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot get task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	role, err := u.access.CheckAccess(ctx, in.UserId, td.ProjectId)
+	if err != nil {
+		if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return nil, updateerr.ErrTaskNotFound
+		} else if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "access denied")
+			return nil, updateerr.ErrAccessDenied
+		}
+		log.WarnContext(ctx, "cannot check project access", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if !projectaccess.CanModifyTasks(role) {
+		log.InfoContext(ctx, "read-only access", slog.String("role", string(role)))
+		return nil, updateerr.ErrForbidden
+	}
+
+	if td.Version != in.Version {
+		log.InfoContext(ctx, "version conflict", slog.Int("expected", int(in.Version)), slog.Int("actual", int(td.Version)))
+		return updatemodel.NewUpdateTaskOutput(false, td.Version), updateerr.ErrVersionConflict
+	}
+
+	if in.Title != nil {
+		if err := td.ChangeTitle(*in.Title); err != nil {
+			log.InfoContext(ctx, "invalid title")
+			return nil, err
+		}
+	}
+	if in.Priority != nil {
+		if err := td.ChangePriority(taskdomain.Priority(*in.Priority)); err != nil {
+			log.InfoContext(ctx, "invalid priority")
+			return nil, err
+		}
+	}
+	if in.AssigneeId != nil {
+		if err := u.checkAssignee(ctx, log, *in.AssigneeId, in.UserId, td); err != nil {
+			return nil, err
+		}
+		td.Assign(*in.AssigneeId)
+	}
+
+	version, err := u.stor.Update(ctx, td)
+	if err != nil {
+		if errors.Is(err, storage.ErrVersionConflict) {
+			log.InfoContext(ctx, "version conflict")
+			return nil, updateerr.ErrVersionConflict
+		}
+		log.WarnContext(ctx, "cannot update task", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "task updated successfully", slog.Int("version", int(version)))
+
+	return updatemodel.NewUpdateTaskOutput(true, version), nil
+}
+
+// checkAssignee lets through unassigning, keeping the current assignee and
+// self-assigning; anyone else must exist and be a member of the task project.
+func (u *UpdateTaskUC) checkAssignee(ctx context.Context, log *slog.Logger, assigneeId, userId uint32, td *taskdomain.TaskDomain) error {
+	if assigneeId == 0 || assigneeId == td.AssigneeId || assigneeId == userId {
+		return nil
+	}
+
+	if _, err := u.users.GetUserName(ctx, assigneeId); err != nil {
+		if errors.Is(err, userdirectory.ErrUserNotFound) {
+			log.InfoContext(ctx, "assignee not found", slog.Int("assigneeId", int(assigneeId)))
+			return updateerr.ErrInvalidAssignee
+		}
+		log.WarnContext(ctx, "cannot get assignee", slog.String("error", err.Error()))
+		return err
+	}
+
+	if _, err := u.access.CheckAccess(ctx, assigneeId, td.ProjectId); err != nil {
+		if errors.Is(err, projectaccess.ErrAccessDenied) {
+			log.InfoContext(ctx, "assignee has no access to project", slog.Int("assigneeId", int(assigneeId)))
+			return updateerr.ErrInvalidAssignee
+		} else if errors.Is(err, projectaccess.ErrProjectNotFound) {
+			log.InfoContext(ctx, "project not found")
+			return updateerr.ErrTaskNotFound
+		}
+		log.WarnContext(ctx, "cannot check assignee access", slog.String("error", err.Error()))
+		return err
+	}
+
+	return nil
+}
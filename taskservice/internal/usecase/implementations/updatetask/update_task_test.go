@@ -0,0 +1,317 @@
+package updateuc
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	taskdomain "taskservice/internal/domain/task"
+	"taskservice/internal/repository/projectaccess"
+	"taskservice/internal/repository/storage"
+	"taskservice/internal/repository/userdirectory"
+	updateerr "taskservice/internal/usecase/error/updatetask"
+	updatemocks "taskservice/internal/usecase/implementations/updatetask/mocks"
+	updatemodel "taskservice/internal/usecase/models/updatetask"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=updatemocks
+//go:generate mockgen -source=./../../../repository/projectaccess/project_access.go -destination=./mocks/mock_project_access.go -package=updatemocks
+//go:generate mockgen -source=./../../../repository/userdirectory/user_directory.go -destination=./mocks/mock_user_directory.go -package=updatemocks
+func TestUpdateTaskUC(t *testing.T) {
+	newTitle := "new title"
+	emptyTitle := ""
+	highPriority := "high"
+	badPriority := "urgent"
+	assignee := uint32(2)
+	unassign := uint32(0)
+
+	tests := []struct {
+		testName string
+
+		expGetById    bool
+		getByIdReturn *taskdomain.TaskDomain
+		getByIdErr    error
+
+		expAccess        bool
+		accessReturnRole projectaccess.Role
+		accessReturnErr  error
+
+		expUserLookup bool
+		userLookupErr error
+
+		expAssigneeAccess bool
+		assigneeReturnErr error
+
+		expUpdate       bool
+		updateInput     *taskdomain.TaskDomain
+		updateReturn    uint32
+		updateReturnErr error
+
+		in     *updatemodel.UpdateTaskInput
+		expOut *updatemodel.UpdateTaskOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Priority: taskdomain.PriorityMedium, Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUpdate:       true,
+			updateInput:     &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "new title", Priority: taskdomain.PriorityHigh, Version: 3},
+			updateReturn:    4,
+			updateReturnErr: nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &newTitle, &highPriority, nil),
+			expOut: updatemodel.NewUpdateTaskOutput(true, 4),
+			expErr: nil,
+		}, {
+			testName: "Success with assignee",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUserLookup: true,
+			userLookupErr: nil,
+
+			expAssigneeAccess: true,
+			assigneeReturnErr: nil,
+
+			expUpdate:       true,
+			updateInput:     &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", AssigneeId: 2, Version: 3},
+			updateReturn:    4,
+			updateReturnErr: nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, &assignee),
+			expOut: updatemodel.NewUpdateTaskOutput(true, 4),
+			expErr: nil,
+		}, {
+			testName: "Unassign",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", AssigneeId: 2, Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUpdate:       true,
+			updateInput:     &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			updateReturn:    4,
+			updateReturnErr: nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, &unassign),
+			expOut: updatemodel.NewUpdateTaskOutput(true, 4),
+			expErr: nil,
+		}, {
+			testName: "Invalid task id",
+
+			in:     updatemodel.NewUpdateTaskInput(1, 0, 3, &newTitle, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrInvalidTaskId,
+		}, {
+			testName: "Invalid version",
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 0, &newTitle, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrInvalidVersion,
+		}, {
+			testName: "Nothing to update",
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrNothingToUpdate,
+		}, {
+			testName: "Task not found",
+
+			expGetById:    true,
+			getByIdReturn: nil,
+			getByIdErr:    storage.ErrNotFound,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &newTitle, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrTaskNotFound,
+		}, {
+			testName: "Access denied",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: "",
+			accessReturnErr:  projectaccess.ErrAccessDenied,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &newTitle, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrAccessDenied,
+		}, {
+			testName: "Viewer",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleViewer,
+			accessReturnErr:  nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &newTitle, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrForbidden,
+		}, {
+			testName: "Stale version",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 5},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &newTitle, nil, nil),
+			expOut: updatemodel.NewUpdateTaskOutput(false, 5),
+			expErr: updateerr.ErrVersionConflict,
+		}, {
+			testName: "Invalid title",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &emptyTitle, nil, nil),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidTitle,
+		}, {
+			testName: "Invalid priority",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, nil, &badPriority, nil),
+			expOut: nil,
+			expErr: taskdomain.ErrInvalidPriority,
+		}, {
+			testName: "Assignee not found",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUserLookup: true,
+			userLookupErr: userdirectory.ErrUserNotFound,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, &assignee),
+			expOut: nil,
+			expErr: updateerr.ErrInvalidAssignee,
+		}, {
+			testName: "Assignee not a member",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUserLookup: true,
+			userLookupErr: nil,
+
+			expAssigneeAccess: true,
+			assigneeReturnErr: projectaccess.ErrAccessDenied,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, nil, nil, &assignee),
+			expOut: nil,
+			expErr: updateerr.ErrInvalidAssignee,
+		}, {
+			testName: "Concurrent update",
+
+			expGetById:    true,
+			getByIdReturn: &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "title", Version: 3},
+			getByIdErr:    nil,
+
+			expAccess:        true,
+			accessReturnRole: projectaccess.RoleMember,
+			accessReturnErr:  nil,
+
+			expUpdate:       true,
+			updateInput:     &taskdomain.TaskDomain{Id: 1, ProjectId: 1, Title: "new title", Version: 3},
+			updateReturn:    0,
+			updateReturnErr: storage.ErrVersionConflict,
+
+			in:     updatemodel.NewUpdateTaskInput(1, 1, 3, &newTitle, nil, nil),
+			expOut: nil,
+			expErr: updateerr.ErrVersionConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			storMock := updatemocks.NewMockStorageRepo(ctrl)
+			if tt.expGetById {
+				storMock.EXPECT().GetById(gomock.Any(), tt.in.TaskId).
+					Return(tt.getByIdReturn, tt.getByIdErr)
+			}
+			if tt.expUpdate {
+				storMock.EXPECT().Update(gomock.Any(), tt.updateInput).
+					Return(tt.updateReturn, tt.updateReturnErr)
+			}
+
+			accessMock := updatemocks.NewMockProjectAccessChecker(ctrl)
+			if tt.expAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), tt.in.UserId, tt.getByIdReturn.ProjectId).
+					Return(tt.accessReturnRole, tt.accessReturnErr)
+			}
+			if tt.expAssigneeAccess {
+				accessMock.EXPECT().CheckAccess(gomock.Any(), *tt.in.AssigneeId, tt.getByIdReturn.ProjectId).
+					Return(projectaccess.RoleMember, tt.assigneeReturnErr)
+			}
+
+			usersMock := updatemocks.NewMockUserDirectory(ctrl)
+			if tt.expUserLookup {
+				usersMock.EXPECT().GetUserName(gomock.Any(), *tt.in.AssigneeId).
+					Return("", tt.userLookupErr)
+			}
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			updateUC := NewUpdateTaskUC(log, storMock, accessMock, usersMock)
+
+			out, err := updateUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
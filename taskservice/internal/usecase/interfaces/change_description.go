@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	changedescmodel "taskservice/internal/usecase/models/changedescription"
+)
+
+type ChangeDescriptionUsecase interface {
+	Execute(ctx context.Context, in *changedescmodel.ChangeDescriptionInput) (*changedescmodel.ChangeDescriptionOutput, error)
+}
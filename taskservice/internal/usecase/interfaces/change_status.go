@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	changestatusmodel "taskservice/internal/usecase/models/changestatus"
+)
+
+type ChangeStatusUsecase interface {
+	Execute(ctx context.Context, in *changestatusmodel.ChangeStatusInput) (*changestatusmodel.ChangeStatusOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	deleteprojtasksmodel "taskservice/internal/usecase/models/deleteprojecttasks"
+)
+
+type DeleteProjectTasksUsecase interface {
+	Execute(ctx context.Context, in *deleteprojtasksmodel.DeleteProjectTasksInput) (*deleteprojtasksmodel.DeleteProjectTasksOutput, error)
+}
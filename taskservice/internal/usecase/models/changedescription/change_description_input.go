@@ -0,0 +1,15 @@
+package changedescmodel
+
+type ChangeDescriptionInput struct {
+	UserId      uint32
+	TaskId      uint32
+	Description string
+}
+
+func NewChangeDescriptionInput(userId uint32, taskId uint32, description string) *ChangeDescriptionInput {
+	return &ChangeDescriptionInput{
+		UserId:      userId,
+		TaskId:      taskId,
+		Description: description,
+	}
+}
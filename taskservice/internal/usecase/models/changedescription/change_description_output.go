@@ -0,0 +1,11 @@
+package changedescmodel
+
+type ChangeDescriptionOutput struct {
+	IsChanged bool
+}
+
+func NewChangeDescriptionOutput(isChanged bool) *ChangeDescriptionOutput {
+	return &ChangeDescriptionOutput{
+		IsChanged: isChanged,
+	}
+}
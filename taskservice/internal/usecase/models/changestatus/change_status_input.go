@@ -0,0 +1,15 @@
+package changestatusmodel
+
+type ChangeStatusInput struct {
+	UserId uint32
+	TaskId uint32
+	Status string
+}
+
+func NewChangeStatusInput(userId uint32, taskId uint32, status string) *ChangeStatusInput {
+	return &ChangeStatusInput{
+		UserId: userId,
+		TaskId: taskId,
+		Status: status,
+	}
+}
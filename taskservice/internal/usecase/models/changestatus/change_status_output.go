@@ -0,0 +1,13 @@
+package changestatusmodel
+
+type ChangeStatusOutput struct {
+	IsChanged bool
+	Status    string
+}
+
+func NewChangeStatusOutput(isChanged bool, status string) *ChangeStatusOutput {
+	return &ChangeStatusOutput{
+		IsChanged: isChanged,
+		Status:    status,
+	}
+}
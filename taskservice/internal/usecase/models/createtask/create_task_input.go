@@ -3,15 +3,31 @@ package createmodel
 import "time"
 
 type CreateTaskInput struct {
+	UserId      uint32
 	ProjectId   uint32
+	Title       string
 	Description string
+	Priority    string
+	AssigneeId  uint32
 	Deadline    time.Time
 }
 
-func NewCreateInput(projectId uint32, descriprion string, deadline time.Time) *CreateTaskInput {
+func NewCreateInput(
+	userId uint32,
+	projectId uint32,
+	title string,
+	descriprion string,
+	priority string,
+	assigneeId uint32,
+	deadline time.Time,
+) *CreateTaskInput {
 	return &CreateTaskInput{
+		UserId:      userId,
 		ProjectId:   projectId,
+		Title:       title,
 		Description: descriprion,
+		Priority:    priority,
+		AssigneeId:  assigneeId,
 		Deadline:    deadline,
 	}
 }
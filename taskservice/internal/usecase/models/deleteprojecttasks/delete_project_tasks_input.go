@@ -0,0 +1,13 @@
+package deleteprojtasksmodel
+
+type DeleteProjectTasksInput struct {
+	EventId   uint64
+	ProjectId uint32
+}
+
+func NewDeleteProjectTasksInput(eventId uint64, projectId uint32) *DeleteProjectTasksInput {
+	return &DeleteProjectTasksInput{
+		EventId:   eventId,
+		ProjectId: projectId,
+	}
+}
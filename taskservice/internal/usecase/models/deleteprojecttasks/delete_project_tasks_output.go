@@ -0,0 +1,11 @@
+package deleteprojtasksmodel
+
+type DeleteProjectTasksOutput struct {
+	IsDeleted bool
+}
+
+func NewDeleteProjectTasksOutput(isDeleted bool) *DeleteProjectTasksOutput {
+	return &DeleteProjectTasksOutput{
+		IsDeleted: isDeleted,
+	}
+}
@@ -0,0 +1,13 @@
+package deletemodel
+
+type DeleteTaskInput struct {
+	UserId uint32
+	TaskId uint32
+}
+
+func NewDeleteTaskInput(userId uint32, taskId uint32) *DeleteTaskInput {
+	return &DeleteTaskInput{
+		UserId: userId,
+		TaskId: taskId,
+	}
+}
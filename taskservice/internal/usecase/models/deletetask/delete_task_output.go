@@ -0,0 +1,11 @@
+package deletemodel
+
+type DeleteTaskOutput struct {
+	IsDeleted bool
+}
+
+func NewDeleteTaskOutput(isDeleted bool) *DeleteTaskOutput {
+	return &DeleteTaskOutput{
+		IsDeleted: isDeleted,
+	}
+}
@@ -0,0 +1,13 @@
+package getallmodel
+
+type GetAllTasksInput struct {
+	UserId    uint32
+	ProjectId uint32
+}
+
+func NewGetAllTasksInput(userId uint32, projectId uint32) *GetAllTasksInput {
+	return &GetAllTasksInput{
+		UserId:    userId,
+		ProjectId: projectId,
+	}
+}
@@ -0,0 +1,15 @@
+package getallmodel
+
+import taskdomain "taskservice/internal/domain/task"
+
+type GetAllTasksOutput struct {
+	Tasks     []*taskdomain.TaskDomain
+	UserNames map[uint32]string
+}
+
+func NewGetAllTasksOutput(tasks []*taskdomain.TaskDomain, userNames map[uint32]string) *GetAllTasksOutput {
+	return &GetAllTasksOutput{
+		Tasks:     tasks,
+		UserNames: userNames,
+	}
+}
@@ -0,0 +1,13 @@
+package getmodel
+
+type GetTaskInput struct {
+	UserId uint32
+	TaskId uint32
+}
+
+func NewGetTaskInput(userId uint32, taskId uint32) *GetTaskInput {
+	return &GetTaskInput{
+		UserId: userId,
+		TaskId: taskId,
+	}
+}
@@ -0,0 +1,15 @@
+package getmodel
+
+import taskdomain "taskservice/internal/domain/task"
+
+type GetTaskOutput struct {
+	Task      *taskdomain.TaskDomain
+	UserNames map[uint32]string
+}
+
+func NewGetTaskOutput(task *taskdomain.TaskDomain, userNames map[uint32]string) *GetTaskOutput {
+	return &GetTaskOutput{
+		Task:      task,
+		UserNames: userNames,
+	}
+}
@@ -0,0 +1,21 @@
+package updatemodel
+
+type UpdateTaskInput struct {
+	UserId     uint32
+	TaskId     uint32
+	Version    uint32
+	Title      *string
+	Priority   *string
+	AssigneeId *uint32
+}
+
+func NewUpdateTaskInput(userId uint32, taskId uint32, version uint32, title *string, priority *string, assigneeId *uint32) *UpdateTaskInput {
+	return &UpdateTaskInput{
+		UserId:     userId,
+		TaskId:     taskId,
+		Version:    version,
+		Title:      title,
+		Priority:   priority,
+		AssigneeId: assigneeId,
+	}
+}
@@ -0,0 +1,13 @@
+package updatemodel
+
+type UpdateTaskOutput struct {
+	IsUpdated bool
+	Version   uint32
+}
+
+func NewUpdateTaskOutput(isUpdated bool, version uint32) *UpdateTaskOutput {
+	return &UpdateTaskOutput{
+		IsUpdated: isUpdated,
+		Version:   version,
+	}
+}
@@ -0,0 +1,4 @@
+CREATE TABLE IF NOT EXISTS processed_events (
+    event_id BIGINT PRIMARY KEY,
+    processed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
@@ -0,0 +1,10 @@
+DROP INDEX IF EXISTS idx_assignee_id;
+
+ALTER TABLE tasks
+    DROP COLUMN updated_at,
+    DROP COLUMN created_at,
+    DROP COLUMN created_by,
+    DROP COLUMN assignee_id,
+    DROP COLUMN priority,
+    DROP COLUMN status,
+    DROP COLUMN title;
@@ -0,0 +1,16 @@
+ALTER TABLE tasks
+    ADD COLUMN title VARCHAR(255),
+    ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'todo'
+        CHECK (status IN ('todo', 'in_progress', 'review', 'done')),
+    ADD COLUMN priority VARCHAR(16) NOT NULL DEFAULT 'medium'
+        CHECK (priority IN ('low', 'medium', 'high')),
+    ADD COLUMN assignee_id INT,
+    ADD COLUMN created_by INT NOT NULL DEFAULT 0,
+    ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+UPDATE tasks SET title = LEFT(description, 255);
+
+ALTER TABLE tasks ALTER COLUMN title SET NOT NULL;
+
+CREATE INDEX idx_assignee_id ON tasks(assignee_id);
@@ -4,20 +4,43 @@ import (
 	"context"
 	"database/sql"
 	"log/slog"
+	"platform/logger"
+	"platform/server/grpcserv"
+	"platform/server/rest"
 	"userservice/internal/config"
 	bcrypthash "userservice/internal/infrastructure/bcrypt"
+	jwtissuer "userservice/internal/infrastructure/jwt"
 	"userservice/internal/infrastructure/postgres"
 	myredis "userservice/internal/infrastructure/redis"
 	uuidgen "userservice/internal/infrastructure/uuid"
-	grpcserv "userservice/internal/transport/grpc"
 	grpchandler "userservice/internal/transport/grpc/handler"
-	"userservice/internal/transport/rest"
 	resthandler "userservice/internal/transport/rest/handler"
+	"userservice/internal/usecase/implementations/apitokens"
 	"userservice/internal/usecase/implementations/authenticate"
+	"userservice/internal/usecase/implementations/authenticatetoken"
+	"userservice/internal/usecase/implementations/batchgetusers"
+	"userservice/internal/usecase/implementations/changepassword"
+	"userservice/internal/usecase/implementations/confirmreset"
+	"userservice/internal/usecase/implementations/createapitoken"
+	"userservice/internal/usecase/implementations/getprofile"
+	"userservice/internal/usecase/implementations/getuser"
+	"userservice/internal/usecase/implementations/issuetoken"
+	"userservice/internal/usecase/implementations/jwks"
 	"userservice/internal/usecase/implementations/login"
+	"userservice/internal/usecase/implementations/logout"
+	"userservice/internal/usecase/implementations/logoutall"
+	"userservice/internal/usecase/implementations/refreshtoken"
 	"userservice/internal/usecase/implementations/registration"
-	"userservice/pkg/logger"
+	"userservice/internal/usecase/implementations/requestreset"
+	"userservice/internal/usecase/implementations/resendverification"
+	"userservice/internal/usecase/implementations/revokeapitoken"
+	"userservice/internal/usecase/implementations/revokesession"
+	"userservice/internal/usecase/implementations/sessions"
+	"userservice/internal/usecase/implementations/updateprofile"
+	"userservice/internal/usecase/implementations/verifyemail"
+	ucmetrics "userservice/internal/usecase/metrics"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 )
 
@@ -28,29 +51,89 @@ type App struct {
 	cfg        *config.Config
 	db         *sql.DB
 	client     *redis.Client
+	reqResetUC *requestreset.RequestResetUC
+	resendUC   *resendverification.ResendVerificationUC
 }
 
 func NewApp() *App {
 	cfg := config.MustLoad()
-	log := logger.SetupLogger(cfg.LogConf.Level)
+	log := logger.SetupLogger(cfg.LogConf.Level, cfg.LogConf.Format)
 
-	db := mustLoadPostgres(&cfg)
-	client := mustLoadRedis(&cfg)
+	reg := prometheus.DefaultRegisterer
+	db := mustLoadPostgres(&cfg, reg)
+	client := mustLoadRedis(&cfg, reg)
 
 	pos := postgres.NewPostgres(db)
 	hasher := bcrypthash.NewBcryptHasher()
-	redis := myredis.NewRedis(client, &cfg.RedisConf.TTL)
+	redis := myredis.NewRedis(client, &cfg.RedisConf.TTL, cfg.RedisConf.InvalidationChannel)
 	idgen := uuidgen.NewUUIDGenerator()
+	resetTokens := myredis.NewTokenStore(client, "password_reset", cfg.PassConf.ResetTokenTTL)
+	verifyTokens := myredis.NewEmailTokenStore(client, "email_verification", cfg.VerifyConf.TokenTTL)
+	notifier := mustLoadNotifier(&cfg, log)
+	loginAttempts := myredis.NewLoginAttempts(client, cfg.LoginConf.Window)
+	limiter := mustLoadRateLimiter(&cfg, client)
+	refreshTokens := myredis.NewRefreshTokenStore(client)
+	issuer := jwtissuer.NewIssuer(pos, idgen, cfg.JWTConf.Algorithm, cfg.JWTConf.Issuer, cfg.JWTConf.Audience, cfg.JWTConf.AccessTTL, cfg.JWTConf.RotationPeriod)
+	lockoutPolicy := login.LockoutPolicy{
+		MaxEmailAttempts: cfg.LoginConf.MaxEmailAttempts,
+		MaxIPAttempts:    cfg.LoginConf.MaxIPAttempts,
+		Lockout:          cfg.LoginConf.Lockout,
+		MaxLockout:       cfg.LoginConf.MaxLockout,
+	}
+
+	ucMetrics := ucmetrics.NewUsecaseMetrics(reg)
 
-	regUC := registration.NewRegUserUC(log, pos, hasher)
-	logUC := login.NewLoginUserUC(log, pos, hasher, redis, idgen)
-	authUC := authenticate.NewGetUserIDBySessionUC(log, redis)
+	regUC := ucMetrics.Registration(registration.NewRegUserUC(log, pos, hasher, verifyTokens, notifier, idgen))
+	logUC := ucMetrics.Login(login.NewLoginUserUC(log, pos, hasher, redis, idgen, loginAttempts, lockoutPolicy, cfg.VerifyConf.RequireVerified))
+	logoutUC := logout.NewLogoutUserUC(log, redis)
+	logoutAllUC := logoutall.NewLogoutAllUC(log, redis)
+	sessionsUC := sessions.NewGetSessionsUC(log, redis)
+	revokeUC := revokesession.NewRevokeSessionUC(log, redis)
+	authUC := authenticate.NewGetUserIDBySessionUC(log, redis, cfg.RedisConf.Sliding, cfg.RedisConf.TTL, cfg.RedisConf.MaxLifetime)
+	getUserUC := getuser.NewGetUserUC(log, pos)
+	batchGetUC := batchgetusers.NewBatchGetUsersUC(log, pos)
+	profileUC := getprofile.NewGetProfileUC(log, redis, pos)
+	updateProfileUC := updateprofile.NewUpdateProfileUC(log, redis, pos, verifyTokens, notifier, idgen)
+	changePassUC := changepassword.NewChangePasswordUC(log, redis, pos, hasher, refreshTokens)
+	reqResetUC := requestreset.NewRequestResetUC(log, pos, resetTokens, notifier, idgen)
+	confResetUC := confirmreset.NewConfirmResetUC(log, resetTokens, pos, redis, pos, hasher)
+	verifyUC := verifyemail.NewVerifyEmailUC(log, verifyTokens, pos)
+	resendUC := resendverification.NewResendVerificationUC(log, pos, verifyTokens, notifier, idgen)
+	createTokUC := createapitoken.NewCreateApiTokenUC(log, redis, pos, idgen)
+	tokensUC := apitokens.NewGetApiTokensUC(log, redis, pos)
+	revokeTokUC := revokeapitoken.NewRevokeApiTokenUC(log, redis, pos)
+	authTokenUC := authenticatetoken.NewGetUserIDByTokenUC(log, pos)
+	issueTokUC := issuetoken.NewIssueTokenUC(log, redis, issuer, refreshTokens, idgen, cfg.JWTConf.RefreshTTL)
+	refreshUC := refreshtoken.NewRefreshTokenUC(log, refreshTokens, redis, pos, issuer, idgen, cfg.RedisConf.Sliding, cfg.RedisConf.TTL, cfg.RedisConf.MaxLifetime)
+	jwksUC := jwks.NewGetJWKSUC(log, issuer)
 
-	resthandl := resthandler.NewRestHandler(log, cfg.RestConf.CookieTTL, regUC, logUC)
-	grpchandl := grpchandler.NewGRPCHandler(log, authUC)
+	resthandl := resthandler.NewRestHandler(
+		log,
+		cfg.RedisConf.SessionLifetime(),
+		regUC,
+		logUC,
+		logoutUC,
+		logoutAllUC,
+		sessionsUC,
+		revokeUC,
+		profileUC,
+		updateProfileUC,
+		changePassUC,
+		reqResetUC,
+		confResetUC,
+		verifyUC,
+		resendUC,
+		createTokUC,
+		tokensUC,
+		revokeTokUC,
+		issueTokUC,
+		refreshUC,
+		jwksUC,
+	)
+	grpchandl := grpchandler.NewGRPCHandler(log, authUC, authTokenUC, getUserUC, batchGetUC)
 
-	restServer := mustLoadHttpServer(&cfg, log, resthandl)
-	grpcserv := mustLoadGRPCServer(&cfg, log, grpchandl)
+	restServer := mustLoadHttpServer(&cfg, log, resthandl, limiter, reg)
+	grpcserv := mustLoadGRPCServer(&cfg, log, grpchandl, reg)
 
 	return &App{
 		log:        log,
@@ -59,6 +142,8 @@ func NewApp() *App {
 		cfg:        &cfg,
 		db:         db,
 		client:     client,
+		reqResetUC: reqResetUC,
+		resendUC:   resendUC,
 	}
 }
 
@@ -73,6 +158,8 @@ func (a *App) Stop() {
 
 	a.restServer.Stop(ctx)
 	a.grpcServer.Stop()
+	a.reqResetUC.Wait()
+	a.resendUC.Wait()
 
 	a.db.Close()
 	a.client.Close()
@@ -2,21 +2,27 @@ package app
 
 import (
 	"log/slog"
+	"platform/grpc/interceptor"
+	platformmetrics "platform/metrics"
+	userservicev1 "platform/proto/userservice"
+	"platform/requestid"
+	"platform/server/grpcserv"
 	"userservice/internal/config"
-	grpcserv "userservice/internal/transport/grpc"
-	"userservice/internal/transport/grpc/interceptor"
-	userservicev1 "userservice/proto/userservice"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc"
 )
 
-func mustLoadGRPCServer(cfg *config.Config, log *slog.Logger, handl userservicev1.UserServiceServer) *grpcserv.GRPCServer {
+func mustLoadGRPCServer(cfg *config.Config, log *slog.Logger, handl userservicev1.UserServiceServer, reg prometheus.Registerer) *grpcserv.GRPCServer {
 	serv := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
+			requestid.UnaryServerInterceptor(),
+			platformmetrics.UnaryServerInterceptor(reg),
 			interceptor.RecoverInterceptor(log),
 			interceptor.TimeoutInterceptor(log, cfg.GrpcConf.Timeout),
 		),
 	)
 
-	return grpcserv.NewGRPCServer(log, cfg.GrpcConf.Port, handl, serv)
+	userservicev1.RegisterUserServiceServer(serv, handl)
+	return grpcserv.NewGRPCServer(log, cfg.GrpcConf.Port, serv)
 }
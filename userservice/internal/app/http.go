@@ -4,24 +4,52 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	platformmetrics "platform/metrics"
+	platformmiddleware "platform/middleware"
+	"platform/ratelimit"
+	"platform/server/rest"
 	"userservice/internal/config"
-	"userservice/internal/transport/rest"
 	resthandler "userservice/internal/transport/rest/handler"
-	"userservice/internal/transport/rest/middleware"
 
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
-func mustLoadHttpServer(cfg *config.Config, log *slog.Logger, handl *resthandler.RestHandler) *rest.RestServer {
+func mustLoadHttpServer(cfg *config.Config, log *slog.Logger, handl *resthandler.RestHandler, limiter ratelimit.Limiter, reg prometheus.Registerer) *rest.RestServer {
 	// GIN SETTINGS
 	gin.SetMode(cfg.RestConf.Mode)
-	router := gin.New()
-	router.Use(middleware.TimeoutMiddleware(cfg.RestConf.RequestTimeout))
+	router := rest.MustNewRouter(cfg.RestConf.TrustedProxies)
+	// outermost so timeouts and panics are counted too
+	router.Use(platformmetrics.HTTPMiddleware(reg))
+	router.Use(platformmiddleware.TimeoutMiddleware(cfg.RestConf.RequestTimeout))
 	router.Use(gin.Recovery())
+	router.Use(platformmiddleware.RequestIDMiddleware())
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	router.Use(platformmiddleware.RateLimitMiddleware(log, limiter, loadRateLimitRules(cfg)))
 
 	// REGISTER HTTP ROUTES
 	router.POST("/user/registration", handl.Registration)
 	router.POST("/user/login", handl.Login)
+	router.POST("/user/verify", handl.VerifyEmail)
+	router.POST("/user/verify/resend", handl.ResendVerification)
+	router.POST("/user/logout", handl.Logout)
+	router.POST("/user/logout/all", handl.LogoutAll)
+	router.GET("/user/sessions", handl.GetSessions)
+	router.DELETE("/user/sessions/:session_id", handl.RevokeSession)
+	router.GET("/user/me", handl.GetProfile)
+	router.PATCH("/user/me", handl.UpdateProfile)
+	router.POST("/user/password/change", handl.ChangePassword)
+	router.POST("/user/password/reset/request", handl.RequestPasswordReset)
+	router.POST("/user/password/reset/confirm", handl.ConfirmPasswordReset)
+	router.POST("/user/tokens", handl.CreateApiToken)
+	router.GET("/user/tokens", handl.GetApiTokens)
+	router.DELETE("/user/tokens/:token_id", handl.RevokeApiToken)
+	if cfg.JWTConf.Enabled {
+		router.POST("/user/token", handl.IssueToken)
+		router.POST("/user/token/refresh", handl.RefreshToken)
+		router.GET("/.well-known/jwks.json", handl.GetJWKS)
+	}
 
 	// SERVER SETTING
 	serv := &http.Server{
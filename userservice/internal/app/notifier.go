@@ -0,0 +1,24 @@
+package app
+
+import (
+	"log/slog"
+	"userservice/internal/config"
+	localnotifier "userservice/internal/infrastructure/notifier/local"
+	memnotifier "userservice/internal/infrastructure/notifier/memory"
+	smtpnotifier "userservice/internal/infrastructure/notifier/smtp"
+	"userservice/internal/repository/notifier"
+)
+
+func mustLoadNotifier(cfg *config.Config, log *slog.Logger) notifier.Notifier {
+	switch cfg.NotifyConf.Type {
+	case config.SMTPNotifier:
+		smtpConf := cfg.NotifyConf.SMTP
+		return smtpnotifier.NewSMTPNotifier(log, smtpConf.Host, smtpConf.Port, smtpConf.Username, smtpConf.Password, smtpConf.From)
+	case config.MemoryNotifier:
+		return memnotifier.NewMemoryNotifier()
+	case config.LocalNotifier:
+		return localnotifier.NewLocalNotifier(log, cfg.NotifyConf.FilePath)
+	default:
+		panic("unknown notifier type: " + cfg.NotifyConf.Type)
+	}
+}
@@ -3,12 +3,14 @@ package app
 import (
 	"context"
 	"fmt"
+	platformmetrics "platform/metrics"
 	"userservice/internal/config"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/redis/go-redis/v9"
 )
 
-func mustLoadRedis(cfg *config.Config) *redis.Client {
+func mustLoadRedis(cfg *config.Config, reg prometheus.Registerer) *redis.Client {
 	client := redis.NewClient(&redis.Options{
 		Addr:     fmt.Sprintf("%s:%d", cfg.RedisConf.Host, cfg.RedisConf.Port),
 		Password: cfg.RedisConf.Password,
@@ -19,5 +21,7 @@ func mustLoadRedis(cfg *config.Config) *redis.Client {
 		panic("failed to connect to the redis: " + err.Err().Error())
 	}
 
+	platformmetrics.RegisterRedisStats(reg, client)
+
 	return client
 }
@@ -1,12 +1,10 @@
 package config
 
 import (
-	"flag"
 	"os"
+	platformconfig "platform/config"
 	"strconv"
 	"time"
-
-	"gopkg.in/yaml.v3"
 )
 
 var (
@@ -14,13 +12,35 @@ var (
 	localType  = "local"
 )
 
+var (
+	LocalNotifier  = "local"
+	SMTPNotifier   = "smtp"
+	MemoryNotifier = "memory"
+)
+
+var (
+	MemoryRateLimiter = "memory"
+	RedisRateLimiter  = "redis"
+)
+
+var (
+	EdDSAAlgorithm = "EdDSA"
+	RS256Algorithm = "RS256"
+)
+
 type Config struct {
-	Type         string         `yaml:"type"`
-	RestConf     RestAPIConfig  `yaml:"restapi"`
-	GrpcConf     GRPCConfig     `yaml:"grpc"`
-	PostgresConf PostgresConfig `yaml:"postgres"`
-	LogConf      LoggerConfig   `yaml:"logger"`
-	RedisConf    RedisConfig    `yaml:"redis"`
+	Type         string          `yaml:"type"`
+	RestConf     RestAPIConfig   `yaml:"restapi"`
+	GrpcConf     GRPCConfig      `yaml:"grpc"`
+	PostgresConf PostgresConfig  `yaml:"postgres"`
+	LogConf      LoggerConfig    `yaml:"logger"`
+	RedisConf    RedisConfig     `yaml:"redis"`
+	PassConf     PasswordConfig  `yaml:"password"`
+	NotifyConf   NotifierConfig  `yaml:"notifier"`
+	VerifyConf   VerifyConfig    `yaml:"verification"`
+	LoginConf    LoginConfig     `yaml:"login_protection"`
+	RateConf     RateLimitConfig `yaml:"rate_limit"`
+	JWTConf      JWTConfig       `yaml:"jwt"`
 }
 
 type RestAPIConfig struct {
@@ -30,7 +50,7 @@ type RestAPIConfig struct {
 	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
 	RequestTimeout  time.Duration `yaml:"request_timeout"`
 	Mode            string        `yaml:"mode"`
-	CookieTTL       time.Duration `yaml:"cookie-ttl"`
+	TrustedProxies  []string      `yaml:"trusted_proxies"`
 }
 
 type GRPCConfig struct {
@@ -48,42 +68,101 @@ type PostgresConfig struct {
 }
 
 type LoggerConfig struct {
-	Level string `yaml:"level"`
+	Level  string `yaml:"level"`
+	Format string `yaml:"format"`
 }
 
 type RedisConfig struct {
+	Host        string `yaml:"host"`
+	Port        uint32 `yaml:"port"`
+	Password    string
+	DB          int           `yaml:"db"`
+	TTL         time.Duration `yaml:"ttl"`
+	Sliding     bool          `yaml:"sliding"`
+	MaxLifetime time.Duration `yaml:"max_lifetime"`
+
+	InvalidationChannel string `yaml:"invalidation_channel"`
+}
+
+type PasswordConfig struct {
+	ResetTokenTTL time.Duration `yaml:"reset_token_ttl"`
+}
+
+type NotifierConfig struct {
+	Type     string     `yaml:"type"`
+	FilePath string     `yaml:"file_path"`
+	SMTP     SMTPConfig `yaml:"smtp"`
+}
+
+type SMTPConfig struct {
 	Host     string `yaml:"host"`
 	Port     uint32 `yaml:"port"`
+	Username string `yaml:"username"`
 	Password string
-	DB       int           `yaml:"db"`
-	TTL      time.Duration `yaml:"ttl"`
+	From     string `yaml:"from"`
 }
 
-func MustLoad() Config {
-	confPath := fetchConfigPath()
+type VerifyConfig struct {
+	TokenTTL        time.Duration `yaml:"token_ttl"`
+	RequireVerified bool          `yaml:"require_verified"`
+}
 
-	if confPath == "" {
-		panic("config path is empty")
-	}
+type LoginConfig struct {
+	MaxEmailAttempts int64         `yaml:"max_email_attempts"`
+	MaxIPAttempts    int64         `yaml:"max_ip_attempts"`
+	Window           time.Duration `yaml:"window"`
+	Lockout          time.Duration `yaml:"lockout"`
+	MaxLockout       time.Duration `yaml:"max_lockout"`
+}
 
-	if _, err := os.Stat(confPath); err != nil {
-		panic("cannot open config path")
-	}
+type RateLimitConfig struct {
+	Backend string             `yaml:"backend"`
+	Prefix  string             `yaml:"prefix"`
+	Default LimitConfig        `yaml:"default"`
+	Routes  []RouteLimitConfig `yaml:"routes"`
+}
 
-	data, err := os.ReadFile(confPath)
-	if err != nil {
-		panic("cannot read config path")
-	}
+type LimitConfig struct {
+	Requests int64         `yaml:"requests"`
+	Period   time.Duration `yaml:"period"`
+	Burst    int64         `yaml:"burst"`
+}
 
-	var config Config
+type RouteLimitConfig struct {
+	Method      string `yaml:"method"`
+	Path        string `yaml:"path"`
+	LimitConfig `yaml:",inline"`
+}
 
-	err = yaml.Unmarshal(data, &config)
+type JWTConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	Algorithm      string        `yaml:"algorithm"`
+	Issuer         string        `yaml:"issuer"`
+	Audience       []string      `yaml:"audience"`
+	AccessTTL      time.Duration `yaml:"access_ttl"`
+	RefreshTTL     time.Duration `yaml:"refresh_ttl"`
+	RotationPeriod time.Duration `yaml:"rotation_period"`
+}
 
-	if err != nil {
-		panic("cannot parse config path")
+func (r *RedisConfig) SessionLifetime() time.Duration {
+	if r.Sliding {
+		return r.MaxLifetime
 	}
+	return r.TTL
+}
+
+func MustLoad() Config {
+	var config Config
+	platformconfig.MustLoad(&config)
 
 	loadSecrets(&config)
+	mustValidateRedisConfig(&config)
+	mustValidatePasswordConfig(&config)
+	mustValidateNotifierConfig(&config)
+	mustValidateVerifyConfig(&config)
+	mustValidateLoginConfig(&config)
+	mustValidateRateLimitConfig(&config)
+	mustValidateJWTConfig(&config)
 
 	return config
 }
@@ -102,6 +181,9 @@ func loadSecrets(cfg *Config) {
 		mustLoadPostgresConfig(cfg)
 		mustLoadRedisConfig(cfg)
 	}
+	if cfg.NotifyConf.Type == SMTPNotifier {
+		cfg.NotifyConf.SMTP.Password = os.Getenv("SMTP_PASS")
+	}
 }
 
 func mustLoadPostgresConfig(cfg *Config) {
@@ -146,15 +228,100 @@ func mustLoadRedisConfig(cfg *Config) {
 	cfg.RedisConf.TTL = time.Second * time.Duration(h)
 }
 
-func fetchConfigPath() string {
-	var confPath string
+func mustValidateRedisConfig(cfg *Config) {
+	if cfg.RedisConf.TTL <= 0 {
+		panic("RedisConf ttl must be positive")
+	}
+	if cfg.RedisConf.Sliding && cfg.RedisConf.MaxLifetime < cfg.RedisConf.TTL {
+		panic("RedisConf max_lifetime must not be less than ttl in sliding mode")
+	}
+}
+
+func mustValidatePasswordConfig(cfg *Config) {
+	if cfg.PassConf.ResetTokenTTL <= 0 {
+		panic("PasswordConf reset_token_ttl must be positive")
+	}
+}
 
-	flag.StringVar(&confPath, "config", "", "path to config")
-	flag.Parse()
+func mustValidateNotifierConfig(cfg *Config) {
+	switch cfg.NotifyConf.Type {
+	case "":
+		cfg.NotifyConf.Type = LocalNotifier
+	case LocalNotifier, MemoryNotifier:
+	case SMTPNotifier:
+		if cfg.NotifyConf.SMTP.Host == "" || cfg.NotifyConf.SMTP.From == "" {
+			panic("NotifierConf smtp host and from fields must be set")
+		}
+		if cfg.NotifyConf.SMTP.Username != "" && cfg.NotifyConf.SMTP.Password == "" {
+			panic("NotifierConf smtp password field empty")
+		}
+	default:
+		panic("NotifierConf unknown type: " + cfg.NotifyConf.Type)
+	}
+}
 
-	if confPath == "" {
-		confPath = os.Getenv("CONFIG")
+func mustValidateVerifyConfig(cfg *Config) {
+	if cfg.VerifyConf.TokenTTL <= 0 {
+		panic("VerifyConf token_ttl must be positive")
 	}
+}
 
-	return confPath
+func mustValidateLoginConfig(cfg *Config) {
+	if cfg.LoginConf.Window <= 0 {
+		panic("LoginConf window must be positive")
+	}
+	if cfg.LoginConf.Lockout <= 0 {
+		panic("LoginConf lockout must be positive")
+	}
+	if cfg.LoginConf.MaxLockout < cfg.LoginConf.Lockout {
+		panic("LoginConf max_lockout must not be less than lockout")
+	}
+}
+
+func mustValidateRateLimitConfig(cfg *Config) {
+	switch cfg.RateConf.Backend {
+	case "":
+		cfg.RateConf.Backend = MemoryRateLimiter
+	case MemoryRateLimiter, RedisRateLimiter:
+	default:
+		panic("RateLimitConf unknown backend: " + cfg.RateConf.Backend)
+	}
+	mustValidateLimitConfig("default", cfg.RateConf.Default)
+	for _, route := range cfg.RateConf.Routes {
+		if route.Method == "" || route.Path == "" {
+			panic("RateLimitConf route method and path fields must be set")
+		}
+		mustValidateLimitConfig(route.Method+" "+route.Path, route.LimitConfig)
+	}
+}
+
+func mustValidateLimitConfig(name string, cfg LimitConfig) {
+	if cfg.Requests <= 0 || cfg.Period <= 0 || cfg.Burst <= 0 {
+		panic("RateLimitConf " + name + " requests, period and burst must be positive")
+	}
+}
+
+func mustValidateJWTConfig(cfg *Config) {
+	if !cfg.JWTConf.Enabled {
+		return
+	}
+	switch cfg.JWTConf.Algorithm {
+	case "":
+		cfg.JWTConf.Algorithm = EdDSAAlgorithm
+	case EdDSAAlgorithm, RS256Algorithm:
+	default:
+		panic("JWTConf unknown algorithm: " + cfg.JWTConf.Algorithm)
+	}
+	if cfg.JWTConf.Issuer == "" {
+		panic("JWTConf issuer field empty")
+	}
+	if len(cfg.JWTConf.Audience) == 0 {
+		panic("JWTConf audience field empty")
+	}
+	if cfg.JWTConf.AccessTTL <= 0 || cfg.JWTConf.RefreshTTL <= 0 || cfg.JWTConf.RotationPeriod <= 0 {
+		panic("JWTConf access_ttl, refresh_ttl and rotation_period must be positive")
+	}
+	if cfg.JWTConf.RotationPeriod < cfg.JWTConf.AccessTTL {
+		panic("JWTConf rotation_period must not be less than access_ttl")
+	}
 }
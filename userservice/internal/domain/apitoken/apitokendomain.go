@@ -0,0 +1,61 @@
+package apitokendomain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const (
+	TokenPrefix = "pat_"
+
+	maxNameLen     = 100
+	displayLen     = 12
+	secretRandLen  = 32
+	secretTotalLen = len(TokenPrefix) + secretRandLen
+)
+
+type ApiTokenDomain struct {
+	Id         uint32
+	UserId     uint32
+	Name       string
+	Hash       string
+	Prefix     string
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+}
+
+func NewApiTokenDomain(userId uint32, name, secret string, createdAt time.Time, expiresAt *time.Time) (*ApiTokenDomain, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > maxNameLen {
+		return nil, ErrInvalidName
+	}
+
+	return &ApiTokenDomain{
+		UserId:    userId,
+		Name:      name,
+		Hash:      HashSecret(secret),
+		Prefix:    secret[:displayLen],
+		CreatedAt: createdAt,
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+func (t *ApiTokenDomain) IsExpired(now time.Time) bool {
+	return t.ExpiresAt != nil && !now.Before(*t.ExpiresAt)
+}
+
+func NewSecret(random string) string {
+	return TokenPrefix + strings.ReplaceAll(random, "-", "")
+}
+
+func IsSecret(secret string) bool {
+	return len(secret) == secretTotalLen && strings.HasPrefix(secret, TokenPrefix)
+}
+
+func HashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,71 @@
+package apitokendomain
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewApiTokenDomain(t *testing.T) {
+	secret := NewSecret("01234567-89ab-cdef-0123-456789abcdef")
+	now := time.Now()
+
+	tests := []struct {
+		testName string
+		name     string
+
+		expName string
+		expErr  error
+	}{
+		{
+			testName: "Success",
+			name:     " ci ",
+			expName:  "ci",
+			expErr:   nil,
+		}, {
+			testName: "Empty name",
+			name:     "   ",
+			expErr:   ErrInvalidName,
+		}, {
+			testName: "Too long name",
+			name:     strings.Repeat("a", maxNameLen+1),
+			expErr:   ErrInvalidName,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			td, err := NewApiTokenDomain(1, tt.name, secret, now, nil)
+			require.Equal(t, tt.expErr, err)
+			if tt.expErr != nil {
+				return
+			}
+			require.Equal(t, tt.expName, td.Name)
+			require.Equal(t, "pat_01234567", td.Prefix)
+			require.Equal(t, HashSecret(secret), td.Hash)
+			require.NotContains(t, td.Hash, secret)
+		})
+	}
+}
+
+func TestSecret(t *testing.T) {
+	secret := NewSecret("01234567-89ab-cdef-0123-456789abcdef")
+
+	require.Equal(t, "pat_0123456789abcdef0123456789abcdef", secret)
+	require.True(t, IsSecret(secret))
+	require.False(t, IsSecret("0123456789abcdef0123456789abcdef"))
+	require.False(t, IsSecret("pat_short"))
+}
+
+func TestApiTokenDomain_IsExpired(t *testing.T) {
+	now := time.Now()
+	past := now.Add(-time.Second)
+	future := now.Add(time.Second)
+
+	require.False(t, (&ApiTokenDomain{}).IsExpired(now))
+	require.True(t, (&ApiTokenDomain{ExpiresAt: &past}).IsExpired(now))
+	require.True(t, (&ApiTokenDomain{ExpiresAt: &now}).IsExpired(now))
+	require.False(t, (&ApiTokenDomain{ExpiresAt: &future}).IsExpired(now))
+}
@@ -0,0 +1,7 @@
+package apitokendomain
+
+import "errors"
+
+var (
+	ErrInvalidName = errors.New("invalid token name")
+)
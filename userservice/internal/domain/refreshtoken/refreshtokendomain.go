@@ -0,0 +1,24 @@
+package refreshtokendomain
+
+import "time"
+
+// RefreshTokenDomain is bound to the session that issued it. ExpiresAt is
+// fixed when the session first asks for a token and carried over on every
+// rotation, so rotating never extends the lifetime.
+type RefreshTokenDomain struct {
+	UserId    uint32
+	SessionId string
+	ExpiresAt time.Time
+}
+
+func NewRefreshTokenDomain(userId uint32, sessionId string, expiresAt time.Time) *RefreshTokenDomain {
+	return &RefreshTokenDomain{
+		UserId:    userId,
+		SessionId: sessionId,
+		ExpiresAt: expiresAt,
+	}
+}
+
+func (r *RefreshTokenDomain) Expired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
@@ -0,0 +1,33 @@
+package sessiondomain
+
+import "time"
+
+type SessionDomain struct {
+	Id        string
+	UserId    uint32
+	UserAgent string
+	IP        string
+	CreatedAt time.Time
+	LastSeen  time.Time
+}
+
+func NewSessionDomain(id string, userId uint32, userAgent, ip string, createdAt, lastSeen time.Time) *SessionDomain {
+	return &SessionDomain{
+		Id:        id,
+		UserId:    userId,
+		UserAgent: userAgent,
+		IP:        ip,
+		CreatedAt: createdAt,
+		LastSeen:  lastSeen,
+	}
+}
+
+// SlidingTTL is how long a sliding session may live from now: ttl, cut short
+// by maxLifetime counted from creation. Zero or less means it is over.
+func (s *SessionDomain) SlidingTTL(now time.Time, ttl, maxLifetime time.Duration) time.Duration {
+	remaining := s.CreatedAt.Add(maxLifetime).Sub(now)
+	if remaining < ttl {
+		return remaining
+	}
+	return ttl
+}
@@ -0,0 +1,7 @@
+package signingkeydomain
+
+import "errors"
+
+var (
+	ErrUnsupportedAlgorithm = errors.New("unsupported signing algorithm")
+)
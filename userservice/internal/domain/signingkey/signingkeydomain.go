@@ -0,0 +1,64 @@
+package signingkeydomain
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"time"
+)
+
+const (
+	AlgEdDSA = "EdDSA"
+	AlgRS256 = "RS256"
+
+	rsaKeyBits = 2048
+)
+
+type SigningKeyDomain struct {
+	Kid        string
+	Algorithm  string
+	PrivateKey crypto.Signer
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+}
+
+func NewSigningKeyDomain(kid, algorithm string, createdAt, expiresAt time.Time) (*SigningKeyDomain, error) {
+	var key crypto.Signer
+	switch algorithm {
+	case AlgEdDSA:
+		_, priv, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		key = priv
+	case AlgRS256:
+		priv, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		key = priv
+	default:
+		return nil, ErrUnsupportedAlgorithm
+	}
+
+	return &SigningKeyDomain{
+		Kid:        kid,
+		Algorithm:  algorithm,
+		PrivateKey: key,
+		CreatedAt:  createdAt,
+		ExpiresAt:  expiresAt,
+	}, nil
+}
+
+func (k *SigningKeyDomain) PublicKey() crypto.PublicKey {
+	return k.PrivateKey.Public()
+}
+
+func (k *SigningKeyDomain) IsExpired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
+
+func IsSupportedAlgorithm(algorithm string) bool {
+	return algorithm == AlgEdDSA || algorithm == AlgRS256
+}
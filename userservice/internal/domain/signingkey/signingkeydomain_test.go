@@ -0,0 +1,62 @@
+package signingkeydomain
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSigningKeyDomain(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		testName  string
+		algorithm string
+
+		expErr error
+	}{
+		{
+			testName:  "EdDSA",
+			algorithm: AlgEdDSA,
+			expErr:    nil,
+		}, {
+			testName:  "RS256",
+			algorithm: AlgRS256,
+			expErr:    nil,
+		}, {
+			testName:  "Unsupported algorithm",
+			algorithm: "HS256",
+			expErr:    ErrUnsupportedAlgorithm,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			k, err := NewSigningKeyDomain("kid", tt.algorithm, now, now.Add(time.Hour))
+			require.Equal(t, tt.expErr, err)
+			if tt.expErr != nil {
+				return
+			}
+			require.Equal(t, "kid", k.Kid)
+			require.Equal(t, tt.algorithm, k.Algorithm)
+
+			switch tt.algorithm {
+			case AlgEdDSA:
+				require.IsType(t, ed25519.PublicKey{}, k.PublicKey())
+			case AlgRS256:
+				require.IsType(t, &rsa.PublicKey{}, k.PublicKey())
+			}
+		})
+	}
+}
+
+func TestSigningKeyDomain_IsExpired(t *testing.T) {
+	now := time.Now()
+
+	require.False(t, (&SigningKeyDomain{ExpiresAt: now.Add(time.Second)}).IsExpired(now))
+	require.True(t, (&SigningKeyDomain{ExpiresAt: now}).IsExpired(now))
+	require.True(t, (&SigningKeyDomain{ExpiresAt: now.Add(-time.Second)}).IsExpired(now))
+}
@@ -0,0 +1,10 @@
+package userdomain
+
+import "errors"
+
+var (
+	ErrInvalidFirstName  = errors.New("invalid first name")
+	ErrInvalidMiddleName = errors.New("invalid middle name")
+	ErrInvalidLastName   = errors.New("invalid last name")
+	ErrInvalidEmail      = errors.New("invalid email")
+)
@@ -1,5 +1,12 @@
 package userdomain
 
+import (
+	"net/mail"
+	"strings"
+)
+
+const maxFieldLen = 255
+
 type UserDomain struct {
 	Id           uint32
 	FirstName    string
@@ -7,6 +14,8 @@ type UserDomain struct {
 	LastName     string
 	HashPassword string
 	Email        string
+
+	EmailVerified bool
 }
 
 func NewUserDomain(id uint32, firstname, middlename, lastname, hashPassword, email string) *UserDomain {
@@ -19,3 +28,77 @@ func NewUserDomain(id uint32, firstname, middlename, lastname, hashPassword, ema
 		Email:        email,
 	}
 }
+
+func (u *UserDomain) Validate() error {
+	if err := validateName(u.FirstName, true, ErrInvalidFirstName); err != nil {
+		return err
+	}
+	if err := validateName(u.MiddleName, false, ErrInvalidMiddleName); err != nil {
+		return err
+	}
+	if err := validateName(u.LastName, true, ErrInvalidLastName); err != nil {
+		return err
+	}
+	return validateEmail(u.Email)
+}
+
+func (u *UserDomain) ChangeFirstName(firstName string) error {
+	if err := validateName(firstName, true, ErrInvalidFirstName); err != nil {
+		return err
+	}
+	u.FirstName = firstName
+	return nil
+}
+
+func (u *UserDomain) ChangeMiddleName(middleName string) error {
+	if err := validateName(middleName, false, ErrInvalidMiddleName); err != nil {
+		return err
+	}
+	u.MiddleName = middleName
+	return nil
+}
+
+func (u *UserDomain) ChangeLastName(lastName string) error {
+	if err := validateName(lastName, true, ErrInvalidLastName); err != nil {
+		return err
+	}
+	u.LastName = lastName
+	return nil
+}
+
+func (u *UserDomain) ChangeEmail(email string) error {
+	if err := validateEmail(email); err != nil {
+		return err
+	}
+	// ownership of the new address has not been proven yet
+	if email != u.Email {
+		u.EmailVerified = false
+	}
+	u.Email = email
+	return nil
+}
+
+func (u *UserDomain) VerifyEmail() {
+	u.EmailVerified = true
+}
+
+func validateName(name string, required bool, errInvalid error) error {
+	if required && strings.TrimSpace(name) == "" {
+		return errInvalid
+	}
+	if len([]rune(name)) > maxFieldLen {
+		return errInvalid
+	}
+	return nil
+}
+
+func validateEmail(email string) error {
+	if len([]rune(email)) > maxFieldLen {
+		return ErrInvalidEmail
+	}
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return ErrInvalidEmail
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package userdomain
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserDomain_Validate(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		firstName  string
+		middleName string
+		lastName   string
+		email      string
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			firstName:  "Ivan",
+			middleName: "Ivanovich",
+			lastName:   "Ivanov",
+			email:      "gmail@gmail.com",
+
+			expErr: nil,
+		}, {
+			testName: "Without middle name",
+
+			firstName: "Ivan",
+			lastName:  "Ivanov",
+			email:     "gmail@gmail.com",
+
+			expErr: nil,
+		}, {
+			testName: "Blank first name",
+
+			firstName: "  ",
+			lastName:  "Ivanov",
+			email:     "gmail@gmail.com",
+
+			expErr: ErrInvalidFirstName,
+		}, {
+			testName: "Too long middle name",
+
+			firstName:  "Ivan",
+			middleName: strings.Repeat("a", 256),
+			lastName:   "Ivanov",
+			email:      "gmail@gmail.com",
+
+			expErr: ErrInvalidMiddleName,
+		}, {
+			testName: "Empty last name",
+
+			firstName: "Ivan",
+			lastName:  "",
+			email:     "gmail@gmail.com",
+
+			expErr: ErrInvalidLastName,
+		}, {
+			testName: "Invalid email",
+
+			firstName: "Ivan",
+			lastName:  "Ivanov",
+			email:     "Ivan <gmail@gmail.com>",
+
+			expErr: ErrInvalidEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ud := NewUserDomain(1, tt.firstName, tt.middleName, tt.lastName, "hash", tt.email)
+			require.Equal(t, tt.expErr, ud.Validate())
+		})
+	}
+}
+
+func TestUserDomain_ChangeEmail(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		email string
+
+		expEmail    string
+		expVerified bool
+		expErr      error
+	}{
+		{
+			testName: "Success",
+
+			email: "new@gmail.com",
+
+			expEmail:    "new@gmail.com",
+			expVerified: false,
+			expErr:      nil,
+		}, {
+			testName: "Same email",
+
+			email: "old@gmail.com",
+
+			expEmail:    "old@gmail.com",
+			expVerified: true,
+			expErr:      nil,
+		}, {
+			testName: "Invalid email",
+
+			email: "not-an-email",
+
+			expEmail:    "old@gmail.com",
+			expVerified: true,
+			expErr:      ErrInvalidEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ud := NewUserDomain(1, "Ivan", "", "Ivanov", "hash", "old@gmail.com")
+			ud.VerifyEmail()
+			err := ud.ChangeEmail(tt.email)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expEmail, ud.Email)
+			require.Equal(t, tt.expVerified, ud.EmailVerified)
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package jwtissuer
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/signingkey"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// accessTokenType marks access tokens as RFC 9068 says, so resource services
+// can tell them apart from any other jwt signed with the same keys.
+const accessTokenType = "at+jwt"
+
+type Issuer struct {
+	keys  signingkey.SigningKeyRepo
+	idgen idgenerator.IDGenerator
+
+	algorithm string
+	issuer    string
+	audience  []string
+	accessTTL time.Duration
+	rotation  time.Duration
+
+	mu      sync.Mutex
+	current *signingkeydomain.SigningKeyDomain
+	now     func() time.Time
+}
+
+func NewIssuer(
+	keys signingkey.SigningKeyRepo,
+	idgen idgenerator.IDGenerator,
+	algorithm string,
+	issuer string,
+	audience []string,
+	accessTTL time.Duration,
+	rotation time.Duration,
+) *Issuer {
+	return &Issuer{
+		keys:      keys,
+		idgen:     idgen,
+		algorithm: algorithm,
+		issuer:    issuer,
+		audience:  audience,
+		accessTTL: accessTTL,
+		rotation:  rotation,
+		now:       time.Now,
+	}
+}
+
+func (i *Issuer) Issue(ctx context.Context, userId uint32) (string, time.Time, error) {
+	now := i.now().UTC()
+
+	key, err := i.signingKey(ctx, now)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := now.Add(i.accessTTL)
+	claims := jwt.RegisteredClaims{
+		Issuer:    i.issuer,
+		Subject:   strconv.FormatUint(uint64(userId), 10),
+		Audience:  i.audience,
+		IssuedAt:  jwt.NewNumericDate(now),
+		ExpiresAt: jwt.NewNumericDate(expiresAt),
+		ID:        i.idgen.New(),
+	}
+
+	token := jwt.NewWithClaims(jwt.GetSigningMethod(key.Algorithm), claims)
+	token.Header["kid"] = key.Kid
+	token.Header["typ"] = accessTokenType
+
+	signed, err := token.SignedString(key.PrivateKey)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	return signed, expiresAt, nil
+}
+
+func (i *Issuer) PublicKeys(ctx context.Context) ([]*signingkeydomain.SigningKeyDomain, error) {
+	return i.keys.FindSigningKeys(ctx, i.now().UTC())
+}
+
+// signingKey returns the newest key that is still inside its rotation period.
+// Keys are shared through postgres, so another instance may already have
+// rotated; a new key is only generated when none of the stored ones fit.
+func (i *Issuer) signingKey(ctx context.Context, now time.Time) (*signingkeydomain.SigningKeyDomain, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.usable(i.current, now) {
+		return i.current, nil
+	}
+
+	keys, err := i.keys.FindSigningKeys(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, k := range keys {
+		if i.usable(k, now) {
+			i.current = k
+			return k, nil
+		}
+	}
+
+	// tokens signed at the very end of the rotation period must stay
+	// verifiable until they expire, so the key outlives it by accessTTL
+	k, err := signingkeydomain.NewSigningKeyDomain(i.idgen.New(), i.algorithm, now, now.Add(i.rotation+i.accessTTL))
+	if err != nil {
+		return nil, err
+	}
+	if err := i.keys.SaveSigningKey(ctx, k); err != nil {
+		return nil, err
+	}
+
+	i.current = k
+	return k, nil
+}
+
+func (i *Issuer) usable(k *signingkeydomain.SigningKeyDomain, now time.Time) bool {
+	return k != nil && k.Algorithm == i.algorithm && now.Before(k.CreatedAt.Add(i.rotation))
+}
@@ -0,0 +1,143 @@
+package jwtissuer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+	jwtmocks "userservice/internal/infrastructure/jwt/mocks"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../repository/signingkey/signingkeyrepo.go -destination=./mocks/mock_signingkey.go -package=jwtmocks
+//go:generate mockgen -source=./../../repository/idgenerator/id_generator.go -destination=./mocks/mock_id_generator.go -package=jwtmocks
+func TestIssuer_Issue(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	rotation := 24 * time.Hour
+	accessTTL := 5 * time.Minute
+
+	stored, err := signingkeydomain.NewSigningKeyDomain("stored", signingkeydomain.AlgEdDSA, now.Add(-time.Hour), now.Add(rotation))
+	require.NoError(t, err)
+	rotated, err := signingkeydomain.NewSigningKeyDomain("rotated", signingkeydomain.AlgEdDSA, now.Add(-rotation), now.Add(accessTTL))
+	require.NoError(t, err)
+
+	tests := []struct {
+		testName string
+
+		findReturn    []*signingkeydomain.SigningKeyDomain
+		findReturnErr error
+
+		expSave       bool
+		saveReturnErr error
+		expKid        string
+		expErr        bool
+	}{
+		{
+			testName: "Stored key",
+
+			findReturn:    []*signingkeydomain.SigningKeyDomain{stored},
+			findReturnErr: nil,
+
+			expSave: false,
+			expKid:  "stored",
+			expErr:  false,
+		}, {
+			testName: "No keys",
+
+			findReturn:    []*signingkeydomain.SigningKeyDomain{},
+			findReturnErr: nil,
+
+			expSave:       true,
+			saveReturnErr: nil,
+			expKid:        "new",
+			expErr:        false,
+		}, {
+			testName: "Key due for rotation",
+
+			findReturn:    []*signingkeydomain.SigningKeyDomain{rotated},
+			findReturnErr: nil,
+
+			expSave:       true,
+			saveReturnErr: nil,
+			expKid:        "new",
+			expErr:        false,
+		}, {
+			testName: "Find error",
+
+			findReturn:    nil,
+			findReturnErr: errors.New("db down"),
+
+			expSave: false,
+			expErr:  true,
+		}, {
+			testName: "Save error",
+
+			findReturn:    []*signingkeydomain.SigningKeyDomain{},
+			findReturnErr: nil,
+
+			expSave:       true,
+			saveReturnErr: errors.New("db down"),
+			expErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			var saved *signingkeydomain.SigningKeyDomain
+
+			keysMock := jwtmocks.NewMockSigningKeyRepo(ctrl)
+			keysMock.EXPECT().FindSigningKeys(gomock.Any(), now).
+				Return(tt.findReturn, tt.findReturnErr)
+			if tt.expSave {
+				keysMock.EXPECT().SaveSigningKey(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, k *signingkeydomain.SigningKeyDomain) error {
+						require.Equal(t, now, k.CreatedAt)
+						require.Equal(t, now.Add(rotation+accessTTL), k.ExpiresAt)
+						saved = k
+						return tt.saveReturnErr
+					})
+			}
+
+			idgenMock := jwtmocks.NewMockIDGenerator(ctrl)
+			idgenMock.EXPECT().New().Return("new").AnyTimes()
+
+			issuer := NewIssuer(keysMock, idgenMock, signingkeydomain.AlgEdDSA, "userservice", []string{"projectservice", "taskservice"}, accessTTL, rotation)
+			issuer.now = func() time.Time { return now }
+
+			token, expiresAt, err := issuer.Issue(context.Background(), 7)
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, now.Add(accessTTL), expiresAt)
+
+			key := stored
+			if tt.expSave {
+				key = saved
+			}
+
+			var claims jwt.RegisteredClaims
+			parsed, err := jwt.ParseWithClaims(token, &claims, func(tok *jwt.Token) (any, error) {
+				return key.PublicKey(), nil
+			}, jwt.WithValidMethods([]string{signingkeydomain.AlgEdDSA}), jwt.WithTimeFunc(func() time.Time { return now }))
+			require.NoError(t, err)
+			require.Equal(t, tt.expKid, parsed.Header["kid"])
+			require.Equal(t, accessTokenType, parsed.Header["typ"])
+			require.Equal(t, "userservice", claims.Issuer)
+			require.Equal(t, "7", claims.Subject)
+			require.Equal(t, jwt.ClaimStrings{"projectservice", "taskservice"}, claims.Audience)
+
+			// the signing key is cached until its rotation period ends
+			_, _, err = issuer.Issue(context.Background(), 7)
+			require.NoError(t, err)
+		})
+	}
+}
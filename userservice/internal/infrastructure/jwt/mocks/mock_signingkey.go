@@ -0,0 +1,72 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../repository/signingkey/signingkeyrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../repository/signingkey/signingkeyrepo.go -destination=./mocks/mock_signingkey.go -package=jwtmocks
+//
+
+// Package jwtmocks is a generated GoMock package.
+package jwtmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSigningKeyRepo is a mock of SigningKeyRepo interface.
+type MockSigningKeyRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSigningKeyRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSigningKeyRepoMockRecorder is the mock recorder for MockSigningKeyRepo.
+type MockSigningKeyRepoMockRecorder struct {
+	mock *MockSigningKeyRepo
+}
+
+// NewMockSigningKeyRepo creates a new mock instance.
+func NewMockSigningKeyRepo(ctrl *gomock.Controller) *MockSigningKeyRepo {
+	mock := &MockSigningKeyRepo{ctrl: ctrl}
+	mock.recorder = &MockSigningKeyRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSigningKeyRepo) EXPECT() *MockSigningKeyRepoMockRecorder {
+	return m.recorder
+}
+
+// FindSigningKeys mocks base method.
+func (m *MockSigningKeyRepo) FindSigningKeys(ctx context.Context, now time.Time) ([]*signingkeydomain.SigningKeyDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSigningKeys", ctx, now)
+	ret0, _ := ret[0].([]*signingkeydomain.SigningKeyDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSigningKeys indicates an expected call of FindSigningKeys.
+func (mr *MockSigningKeyRepoMockRecorder) FindSigningKeys(ctx, now any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSigningKeys", reflect.TypeOf((*MockSigningKeyRepo)(nil).FindSigningKeys), ctx, now)
+}
+
+// SaveSigningKey mocks base method.
+func (m *MockSigningKeyRepo) SaveSigningKey(ctx context.Context, k *signingkeydomain.SigningKeyDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveSigningKey", ctx, k)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveSigningKey indicates an expected call of SaveSigningKey.
+func (mr *MockSigningKeyRepoMockRecorder) SaveSigningKey(ctx, k any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveSigningKey", reflect.TypeOf((*MockSigningKeyRepo)(nil).SaveSigningKey), ctx, k)
+}
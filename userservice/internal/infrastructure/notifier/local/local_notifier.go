@@ -0,0 +1,85 @@
+package localnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+)
+
+var (
+	passwordResetType     = "password_reset"
+	emailVerificationType = "email_verification"
+)
+
+type message struct {
+	Type      string    `json:"type"`
+	Email     string    `json:"email"`
+	Token     string    `json:"token"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type LocalNotifier struct {
+	log  *slog.Logger
+	path string
+	mu   sync.Mutex
+}
+
+func NewLocalNotifier(log *slog.Logger, path string) *LocalNotifier {
+	return &LocalNotifier{
+		log:  log,
+		path: path,
+	}
+}
+
+func (n *LocalNotifier) SendPasswordReset(ctx context.Context, email, token string) error {
+	return n.send(&message{
+		Type:      passwordResetType,
+		Email:     email,
+		Token:     token,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (n *LocalNotifier) SendEmailVerification(ctx context.Context, email, token string) error {
+	return n.send(&message{
+		Type:      emailVerificationType,
+		Email:     email,
+		Token:     token,
+		CreatedAt: time.Now().UTC(),
+	})
+}
+
+func (n *LocalNotifier) send(m *message) error {
+	const op = "localnotifier.send"
+	log := n.log.With(slog.String("op", op), slog.String("type", m.Type))
+
+	if n.path == "" {
+		log.Info("notification", slog.String("email", m.Email), slog.String("token", m.Token))
+		return nil
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	f, err := os.OpenFile(n.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return err
+	}
+
+	log.Info("notification written to file", slog.String("path", n.path))
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package localnotifier
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalNotifier_Send(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notifications.log")
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	n := NewLocalNotifier(log, path)
+
+	require.NoError(t, n.SendPasswordReset(context.Background(), "ivan@mail.ru", "token1"))
+	require.NoError(t, n.SendEmailVerification(context.Background(), "petr@mail.ru", "token2"))
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var msgs []message
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		var m message
+		require.NoError(t, json.Unmarshal(sc.Bytes(), &m))
+		msgs = append(msgs, m)
+	}
+	require.NoError(t, sc.Err())
+
+	require.Len(t, msgs, 2)
+	require.Equal(t, "password_reset", msgs[0].Type)
+	require.Equal(t, "ivan@mail.ru", msgs[0].Email)
+	require.Equal(t, "token1", msgs[0].Token)
+	require.Equal(t, "email_verification", msgs[1].Type)
+	require.Equal(t, "petr@mail.ru", msgs[1].Email)
+	require.Equal(t, "token2", msgs[1].Token)
+}
+
+func TestLocalNotifier_LogOnly(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	n := NewLocalNotifier(log, "")
+
+	require.NoError(t, n.SendPasswordReset(context.Background(), "ivan@mail.ru", "token"))
+}
@@ -0,0 +1,65 @@
+package memnotifier
+
+import (
+	"context"
+	"sync"
+)
+
+var (
+	PasswordResetType     = "password_reset"
+	EmailVerificationType = "email_verification"
+)
+
+type Message struct {
+	Type  string
+	Email string
+	Token string
+}
+
+type MemoryNotifier struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+func (n *MemoryNotifier) SendPasswordReset(ctx context.Context, email, token string) error {
+	n.add(Message{Type: PasswordResetType, Email: email, Token: token})
+	return nil
+}
+
+func (n *MemoryNotifier) SendEmailVerification(ctx context.Context, email, token string) error {
+	n.add(Message{Type: EmailVerificationType, Email: email, Token: token})
+	return nil
+}
+
+func (n *MemoryNotifier) Messages() []Message {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	res := make([]Message, len(n.messages))
+	copy(res, n.messages)
+	return res
+}
+
+func (n *MemoryNotifier) LastToken(msgType, email string) (string, bool) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	for i := len(n.messages) - 1; i >= 0; i-- {
+		m := n.messages[i]
+		if m.Type == msgType && m.Email == email {
+			return m.Token, true
+		}
+	}
+	return "", false
+}
+
+func (n *MemoryNotifier) add(m Message) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.messages = append(n.messages, m)
+}
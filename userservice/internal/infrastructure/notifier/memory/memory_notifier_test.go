@@ -0,0 +1,30 @@
+package memnotifier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryNotifier(t *testing.T) {
+	n := NewMemoryNotifier()
+	ctx := context.Background()
+
+	require.NoError(t, n.SendEmailVerification(ctx, "ivan@mail.ru", "verify1"))
+	require.NoError(t, n.SendPasswordReset(ctx, "ivan@mail.ru", "reset1"))
+	require.NoError(t, n.SendEmailVerification(ctx, "ivan@mail.ru", "verify2"))
+
+	require.Len(t, n.Messages(), 3)
+
+	token, ok := n.LastToken(EmailVerificationType, "ivan@mail.ru")
+	require.True(t, ok)
+	require.Equal(t, "verify2", token)
+
+	token, ok = n.LastToken(PasswordResetType, "ivan@mail.ru")
+	require.True(t, ok)
+	require.Equal(t, "reset1", token)
+
+	_, ok = n.LastToken(PasswordResetType, "petr@mail.ru")
+	require.False(t, ok)
+}
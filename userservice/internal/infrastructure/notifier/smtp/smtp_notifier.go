@@ -0,0 +1,82 @@
+package smtpnotifier
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/smtp"
+	"strings"
+)
+
+type sendMailFunc func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+
+type SMTPNotifier struct {
+	log  *slog.Logger
+	addr string
+	auth smtp.Auth
+	from string
+
+	sendMail sendMailFunc
+}
+
+func NewSMTPNotifier(log *slog.Logger, host string, port uint32, username, password, from string) *SMTPNotifier {
+	var auth smtp.Auth
+	if username != "" {
+		auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return &SMTPNotifier{
+		log:      log,
+		addr:     fmt.Sprintf("%s:%d", host, port),
+		auth:     auth,
+		from:     from,
+		sendMail: smtp.SendMail,
+	}
+}
+
+func (n *SMTPNotifier) SendPasswordReset(ctx context.Context, email, token string) error {
+	return n.send(ctx, email, "Password reset", fmt.Sprintf(
+		"Use the following token to reset your password:\r\n\r\n%s\r\n\r\nIf you did not request a reset, ignore this message.",
+		token,
+	))
+}
+
+func (n *SMTPNotifier) SendEmailVerification(ctx context.Context, email, token string) error {
+	return n.send(ctx, email, "Email verification", fmt.Sprintf(
+		"Use the following token to verify your email:\r\n\r\n%s",
+		token,
+	))
+}
+
+func (n *SMTPNotifier) send(ctx context.Context, to, subject, body string) error {
+	const op = "smtpnotifier.send"
+	log := n.log.With(slog.String("op", op), slog.String("subject", subject))
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := n.sendMail(n.addr, n.auth, n.from, []string{to}, buildMessage(n.from, to, subject, body)); err != nil {
+		log.WarnContext(ctx, "cannot send mail", slog.String("error", err.Error()))
+		return err
+	}
+
+	log.InfoContext(ctx, "mail sent")
+
+	return nil
+}
+
+func buildMessage(from, to, subject, body string) []byte {
+	var sb strings.Builder
+
+	sb.WriteString("From: " + from + "\r\n")
+	sb.WriteString("To: " + to + "\r\n")
+	sb.WriteString("Subject: " + subject + "\r\n")
+	sb.WriteString("MIME-Version: 1.0\r\n")
+	sb.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	sb.WriteString("\r\n")
+	sb.WriteString(body)
+	sb.WriteString("\r\n")
+
+	return []byte(sb.String())
+}
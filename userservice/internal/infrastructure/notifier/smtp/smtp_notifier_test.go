@@ -0,0 +1,51 @@
+package smtpnotifier
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/smtp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPNotifier_SendEmailVerification(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	n := NewSMTPNotifier(log, "smtp.mail.ru", 587, "user", "pass", "noreply@mail.ru")
+
+	var (
+		gotAddr string
+		gotFrom string
+		gotTo   []string
+		gotMsg  string
+	)
+	n.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		gotAddr, gotFrom, gotTo, gotMsg = addr, from, to, string(msg)
+		return nil
+	}
+
+	require.NoError(t, n.SendEmailVerification(context.Background(), "ivan@mail.ru", "token"))
+
+	require.Equal(t, "smtp.mail.ru:587", gotAddr)
+	require.Equal(t, "noreply@mail.ru", gotFrom)
+	require.Equal(t, []string{"ivan@mail.ru"}, gotTo)
+	require.True(t, strings.HasPrefix(gotMsg, "From: noreply@mail.ru\r\nTo: ivan@mail.ru\r\nSubject: Email verification\r\n"))
+	require.Contains(t, gotMsg, "token")
+}
+
+func TestSMTPNotifier_SendError(t *testing.T) {
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+	errSend := errors.New("send error")
+
+	n := NewSMTPNotifier(log, "smtp.mail.ru", 587, "", "", "noreply@mail.ru")
+	n.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+		require.Nil(t, a)
+		return errSend
+	}
+
+	require.ErrorIs(t, n.SendPasswordReset(context.Background(), "ivan@mail.ru", "token"), errSend)
+}
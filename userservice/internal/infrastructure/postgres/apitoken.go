@@ -0,0 +1,120 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	posmapper "userservice/internal/infrastructure/postgres/mapper"
+	posmodels "userservice/internal/infrastructure/postgres/models"
+	apitokenrepo "userservice/internal/repository/apitoken"
+)
+
+func (p *Postgres) SaveApiToken(ctx context.Context, td *apitokendomain.ApiTokenDomain) (uint32, error) {
+	tm := posmapper.ApiTokenDomainToModel(td)
+
+	row := p.db.QueryRowContext(
+		ctx,
+		QuerySaveApiToken,
+		tm.UserId,
+		tm.Name,
+		tm.TokenHash,
+		tm.Prefix,
+		tm.CreatedAt,
+		tm.ExpiresAt,
+	)
+
+	var tokenId uint32
+	if err := row.Scan(&tokenId); err != nil {
+		return invalidId, err
+	}
+	return tokenId, nil
+}
+
+func (p *Postgres) FindApiTokenByHash(ctx context.Context, hash string) (*apitokendomain.ApiTokenDomain, error) {
+	row := p.db.QueryRowContext(ctx, QueryFindApiTokenByHash, hash)
+
+	var tm posmodels.ApiTokenPosModel
+
+	err := row.Scan(
+		&tm.Id,
+		&tm.UserId,
+		&tm.Name,
+		&tm.TokenHash,
+		&tm.Prefix,
+		&tm.CreatedAt,
+		&tm.ExpiresAt,
+		&tm.LastUsedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apitokenrepo.ErrNotFound
+		}
+		return nil, err
+	}
+
+	return posmapper.ApiTokenModelToDomain(&tm), nil
+}
+
+func (p *Postgres) FindApiTokensForUser(ctx context.Context, userId uint32) ([]*apitokendomain.ApiTokenDomain, error) {
+	rows, err := p.db.QueryContext(ctx, QueryFindApiTokensForUser, userId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	tokens := make([]*apitokendomain.ApiTokenDomain, 0)
+	for rows.Next() {
+		var tm posmodels.ApiTokenPosModel
+
+		err := rows.Scan(
+			&tm.Id,
+			&tm.UserId,
+			&tm.Name,
+			&tm.TokenHash,
+			&tm.Prefix,
+			&tm.CreatedAt,
+			&tm.ExpiresAt,
+			&tm.LastUsedAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, posmapper.ApiTokenModelToDomain(&tm))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return tokens, nil
+}
+
+func (p *Postgres) DeleteApiTokenForUser(ctx context.Context, userId, tokenId uint32) error {
+	res, err := p.db.ExecContext(ctx, QueryDeleteApiTokenForUser, tokenId, userId)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return apitokenrepo.ErrNotFound
+	}
+
+	return nil
+}
+
+func (p *Postgres) DeleteApiTokensForUser(ctx context.Context, userId uint32) error {
+	_, err := p.db.ExecContext(ctx, QueryDeleteApiTokensForUser, userId)
+	return err
+}
+
+func (p *Postgres) TouchApiToken(ctx context.Context, tokenId uint32, lastUsedAt time.Time) error {
+	_, err := p.db.ExecContext(ctx, QueryTouchApiToken, tokenId, lastUsedAt)
+	return err
+}
@@ -0,0 +1,168 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"regexp"
+	"testing"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	apitokenrepo "userservice/internal/repository/apitoken"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_SaveApiToken(t *testing.T) {
+	createdAt := time.Now().UTC()
+	expiresAt := createdAt.Add(24 * time.Hour)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	td := &apitokendomain.ApiTokenDomain{
+		UserId:    1,
+		Name:      "ci",
+		Hash:      "hash",
+		Prefix:    "pat_12345678",
+		CreatedAt: createdAt,
+		ExpiresAt: &expiresAt,
+	}
+
+	mock.ExpectQuery(regexp.QuoteMeta(QuerySaveApiToken)).
+		WithArgs(td.UserId, td.Name, td.Hash, td.Prefix, createdAt, sql.NullTime{Time: expiresAt, Valid: true}).
+		WillReturnRows(mock.NewRows([]string{"id"}).
+			AddRow(7))
+
+	repo := NewPostgres(db)
+	id, err := repo.SaveApiToken(context.Background(), td)
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), id)
+}
+
+func TestPostgres_FindApiTokenByHash(t *testing.T) {
+	createdAt := time.Now().UTC()
+	lastUsedAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		testName string
+		hash     string
+
+		mockRows *sqlmock.Rows
+		mockErr  error
+
+		expToken *apitokendomain.ApiTokenDomain
+		expErr   error
+	}{
+		{
+			testName: "Success",
+			hash:     "hash",
+
+			mockRows: sqlmock.NewRows([]string{"id", "user_id", "name", "token_hash", "prefix", "created_at", "expires_at", "last_used_at"}).
+				AddRow(7, 1, "ci", "hash", "pat_12345678", createdAt, nil, lastUsedAt),
+			mockErr: nil,
+
+			expToken: &apitokendomain.ApiTokenDomain{
+				Id:         7,
+				UserId:     1,
+				Name:       "ci",
+				Hash:       "hash",
+				Prefix:     "pat_12345678",
+				CreatedAt:  createdAt,
+				ExpiresAt:  nil,
+				LastUsedAt: &lastUsedAt,
+			},
+			expErr: nil,
+		}, {
+			testName: "Token not found",
+			hash:     "hash",
+			mockErr:  sql.ErrNoRows,
+			expToken: nil,
+			expErr:   apitokenrepo.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			exp := mock.ExpectQuery(regexp.QuoteMeta(QueryFindApiTokenByHash)).
+				WithArgs(tt.hash)
+			if tt.mockErr != nil {
+				exp.WillReturnError(tt.mockErr)
+			} else {
+				exp.WillReturnRows(tt.mockRows)
+			}
+
+			repo := NewPostgres(db)
+			td, err := repo.FindApiTokenByHash(context.Background(), tt.hash)
+
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expToken, td)
+		})
+	}
+}
+
+func TestPostgres_DeleteApiTokenForUser(t *testing.T) {
+	tests := []struct {
+		testName string
+		userId   uint32
+		tokenId  uint32
+
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+			userId:   1,
+			tokenId:  7,
+
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName: "Token not found",
+			userId:   1,
+			tokenId:  7,
+
+			rowAffected: 0,
+
+			expErr: apitokenrepo.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QueryDeleteApiTokenForUser)).
+				WithArgs(tt.tokenId, tt.userId).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+
+			repo := NewPostgres(db)
+			err = repo.DeleteApiTokenForUser(context.Background(), tt.userId, tt.tokenId)
+			require.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestPostgres_DeleteApiTokensForUser(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(regexp.QuoteMeta(QueryDeleteApiTokensForUser)).
+		WithArgs(uint32(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	repo := NewPostgres(db)
+	err = repo.DeleteApiTokensForUser(context.Background(), 1)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,12 +1,18 @@
 package posmapper
 
 import (
+	"crypto"
+	"crypto/x509"
+	"database/sql"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	signingkeydomain "userservice/internal/domain/signingkey"
 	userdomain "userservice/internal/domain/user"
 	posmodels "userservice/internal/infrastructure/postgres/models"
 )
 
 func ModelToDomain(um *posmodels.UserPosModel) *userdomain.UserDomain {
-	return userdomain.NewUserDomain(
+	ud := userdomain.NewUserDomain(
 		um.Id,
 		um.FirstName,
 		um.MiddleName.String,
@@ -14,10 +20,12 @@ func ModelToDomain(um *posmodels.UserPosModel) *userdomain.UserDomain {
 		um.HashPassword,
 		um.Email,
 	)
+	ud.EmailVerified = um.EmailVerified
+	return ud
 }
 
 func DomainToModel(ud *userdomain.UserDomain) *posmodels.UserPosModel {
-	return posmodels.NewUserPosModel(
+	um := posmodels.NewUserPosModel(
 		0,
 		ud.FirstName,
 		ud.MiddleName,
@@ -25,4 +33,80 @@ func DomainToModel(ud *userdomain.UserDomain) *posmodels.UserPosModel {
 		ud.HashPassword,
 		ud.Email,
 	)
+	um.EmailVerified = ud.EmailVerified
+	return um
+}
+
+func ApiTokenModelToDomain(tm *posmodels.ApiTokenPosModel) *apitokendomain.ApiTokenDomain {
+	return &apitokendomain.ApiTokenDomain{
+		Id:         tm.Id,
+		UserId:     tm.UserId,
+		Name:       tm.Name,
+		Hash:       tm.TokenHash,
+		Prefix:     tm.Prefix,
+		CreatedAt:  tm.CreatedAt,
+		ExpiresAt:  nullTimeToPtr(tm.ExpiresAt),
+		LastUsedAt: nullTimeToPtr(tm.LastUsedAt),
+	}
+}
+
+func ApiTokenDomainToModel(td *apitokendomain.ApiTokenDomain) *posmodels.ApiTokenPosModel {
+	return &posmodels.ApiTokenPosModel{
+		Id:         td.Id,
+		UserId:     td.UserId,
+		Name:       td.Name,
+		TokenHash:  td.Hash,
+		Prefix:     td.Prefix,
+		CreatedAt:  td.CreatedAt,
+		ExpiresAt:  ptrToNullTime(td.ExpiresAt),
+		LastUsedAt: ptrToNullTime(td.LastUsedAt),
+	}
+}
+
+func SigningKeyModelToDomain(km *posmodels.SigningKeyPosModel) (*signingkeydomain.SigningKeyDomain, error) {
+	key, err := x509.ParsePKCS8PrivateKey(km.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, signingkeydomain.ErrUnsupportedAlgorithm
+	}
+
+	return &signingkeydomain.SigningKeyDomain{
+		Kid:        km.Kid,
+		Algorithm:  km.Algorithm,
+		PrivateKey: signer,
+		CreatedAt:  km.CreatedAt,
+		ExpiresAt:  km.ExpiresAt,
+	}, nil
+}
+
+func SigningKeyDomainToModel(kd *signingkeydomain.SigningKeyDomain) (*posmodels.SigningKeyPosModel, error) {
+	der, err := x509.MarshalPKCS8PrivateKey(kd.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &posmodels.SigningKeyPosModel{
+		Kid:        kd.Kid,
+		Algorithm:  kd.Algorithm,
+		PrivateKey: der,
+		CreatedAt:  kd.CreatedAt,
+		ExpiresAt:  kd.ExpiresAt,
+	}, nil
+}
+
+func nullTimeToPtr(nt sql.NullTime) *time.Time {
+	if !nt.Valid {
+		return nil
+	}
+	return &nt.Time
+}
+
+func ptrToNullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
 }
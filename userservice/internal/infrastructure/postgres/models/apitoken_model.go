@@ -0,0 +1,17 @@
+package posmodels
+
+import (
+	"database/sql"
+	"time"
+)
+
+type ApiTokenPosModel struct {
+	Id         uint32       `db:"id"`
+	UserId     uint32       `db:"user_id"`
+	Name       string       `db:"name"`
+	TokenHash  string       `db:"token_hash"`
+	Prefix     string       `db:"prefix"`
+	CreatedAt  time.Time    `db:"created_at"`
+	ExpiresAt  sql.NullTime `db:"expires_at"`
+	LastUsedAt sql.NullTime `db:"last_used_at"`
+}
@@ -0,0 +1,11 @@
+package posmodels
+
+import "time"
+
+type SigningKeyPosModel struct {
+	Kid        string    `db:"kid"`
+	Algorithm  string    `db:"algorithm"`
+	PrivateKey []byte    `db:"private_key"`
+	CreatedAt  time.Time `db:"created_at"`
+	ExpiresAt  time.Time `db:"expires_at"`
+}
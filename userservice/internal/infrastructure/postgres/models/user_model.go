@@ -9,6 +9,8 @@ type UserPosModel struct {
 	LastName     string         `db:"last_name"`
 	HashPassword string         `db:"hash_password"`
 	Email        string         `db:"email"`
+
+	EmailVerified bool `db:"email_verified"`
 }
 
 func NewUserPosModel(id uint32, firstName, middleName, lastName, hashPassword, email string) *UserPosModel {
@@ -8,6 +8,8 @@ import (
 	posmapper "userservice/internal/infrastructure/postgres/mapper"
 	posmodels "userservice/internal/infrastructure/postgres/models"
 	storagerepo "userservice/internal/repository/storage"
+
+	"github.com/lib/pq"
 )
 
 var (
@@ -40,11 +42,79 @@ func (p *Postgres) Save(ctx context.Context, ud *userdomain.UserDomain) (uint32,
 	var userId uint32
 	err := row.Scan(&userId)
 	if err != nil {
+		if isUniqueViolation(err) {
+			return invalidId, storagerepo.ErrAlreadyExists
+		}
 		return invalidId, err
 	}
 	return userId, err
 }
 
+func (p *Postgres) Update(ctx context.Context, ud *userdomain.UserDomain) error {
+	um := posmapper.DomainToModel(ud)
+
+	res, err := p.db.ExecContext(
+		ctx,
+		QueryUpdateUser,
+		ud.Id,
+		um.FirstName,
+		um.MiddleName,
+		um.LastName,
+		um.Email,
+		um.EmailVerified,
+	)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return storagerepo.ErrAlreadyExists
+		}
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storagerepo.ErrNoRows
+	}
+
+	return nil
+}
+
+func (p *Postgres) UpdatePassword(ctx context.Context, userId uint32, hashPassword string) error {
+	res, err := p.db.ExecContext(ctx, QueryUpdatePassword, userId, hashPassword)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storagerepo.ErrNoRows
+	}
+
+	return nil
+}
+
+func (p *Postgres) VerifyEmail(ctx context.Context, userId uint32, email string) error {
+	res, err := p.db.ExecContext(ctx, QueryVerifyEmail, userId, email)
+	if err != nil {
+		return err
+	}
+
+	ra, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if ra == 0 {
+		return storagerepo.ErrNoRows
+	}
+
+	return nil
+}
+
 func (p *Postgres) FindByEmail(ctx context.Context, email string) (*userdomain.UserDomain, error) {
 	row := p.db.QueryRowContext(ctx, QueryFindByEmail, email)
 
@@ -57,6 +127,7 @@ func (p *Postgres) FindByEmail(ctx context.Context, email string) (*userdomain.U
 		&um.LastName,
 		&um.HashPassword,
 		&um.Email,
+		&um.EmailVerified,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -67,3 +138,71 @@ func (p *Postgres) FindByEmail(ctx context.Context, email string) (*userdomain.U
 
 	return posmapper.ModelToDomain(&um), nil
 }
+
+func (p *Postgres) FindById(ctx context.Context, userId uint32) (*userdomain.UserDomain, error) {
+	row := p.db.QueryRowContext(ctx, QueryFindById, userId)
+
+	var um posmodels.UserPosModel
+
+	err := row.Scan(
+		&um.Id,
+		&um.FirstName,
+		&um.MiddleName,
+		&um.LastName,
+		&um.HashPassword,
+		&um.Email,
+		&um.EmailVerified,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, storagerepo.ErrNoRows
+		}
+		return nil, err
+	}
+
+	return posmapper.ModelToDomain(&um), nil
+}
+
+func (p *Postgres) FindByIds(ctx context.Context, userIds []uint32) ([]*userdomain.UserDomain, error) {
+	ids := make([]int64, 0, len(userIds))
+	for _, id := range userIds {
+		ids = append(ids, int64(id))
+	}
+
+	rows, err := p.db.QueryContext(ctx, QueryFindByIds, pq.Array(ids))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	users := make([]*userdomain.UserDomain, 0, len(userIds))
+	for rows.Next() {
+		var um posmodels.UserPosModel
+
+		err := rows.Scan(
+			&um.Id,
+			&um.FirstName,
+			&um.MiddleName,
+			&um.LastName,
+			&um.HashPassword,
+			&um.Email,
+			&um.EmailVerified,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, posmapper.ModelToDomain(&um))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pq.Error
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
@@ -9,6 +9,7 @@ import (
 	storagerepo "userservice/internal/repository/storage"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 )
 
@@ -27,13 +28,14 @@ func TestPostgres_FindByEmail(t *testing.T) {
 			testName: "Success",
 			email:    "gmail@gmail.com",
 
-			mockRows: sqlmock.NewRows([]string{"id", "first_name", "middle_name", "last_name", "hash_password", "email"}).
+			mockRows: sqlmock.NewRows([]string{"id", "first_name", "middle_name", "last_name", "hash_password", "email", "email_verified"}).
 				AddRow(1,
 					"Ivan",
 					"Ivanovich",
 					"Ivanov",
 					"somePass",
 					"gmail@gmail.com",
+					false,
 				),
 			mockErr: nil,
 
@@ -121,3 +123,283 @@ func TestPostgres_Save(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgres_FindById(t *testing.T) {
+	tests := []struct {
+		testName string
+		userId   uint32
+
+		mockRows *sqlmock.Rows
+		mockErr  error
+
+		expUser *userdomain.UserDomain
+		expErr  error
+	}{
+		{
+			testName: "Success",
+			userId:   1,
+
+			mockRows: sqlmock.NewRows([]string{"id", "first_name", "middle_name", "last_name", "hash_password", "email", "email_verified"}).
+				AddRow(1,
+					"Ivan",
+					nil,
+					"Ivanov",
+					"somePass",
+					"gmail@gmail.com",
+					true,
+				),
+			mockErr: nil,
+
+			expUser: &userdomain.UserDomain{
+				Id:            1,
+				FirstName:     "Ivan",
+				LastName:      "Ivanov",
+				HashPassword:  "somePass",
+				Email:         "gmail@gmail.com",
+				EmailVerified: true,
+			},
+			expErr: nil,
+		}, {
+			testName: "User not found",
+			userId:   1,
+			mockErr:  sql.ErrNoRows,
+			expUser:  nil,
+			expErr:   storagerepo.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			if tt.mockErr != nil {
+				mock.ExpectQuery(regexp.QuoteMeta(QueryFindById)).
+					WithArgs(tt.userId).
+					WillReturnError(tt.mockErr)
+			} else {
+				mock.ExpectQuery(regexp.QuoteMeta(QueryFindById)).
+					WithArgs(tt.userId).
+					WillReturnRows(tt.mockRows)
+			}
+
+			repo := NewPostgres(db)
+			ud, err := repo.FindById(context.Background(), tt.userId)
+
+			require.ErrorIs(t, tt.expErr, err)
+			require.Equal(t, tt.expUser, ud)
+		})
+	}
+}
+
+func TestPostgres_FindByIds(t *testing.T) {
+	tests := []struct {
+		testName string
+		userIds  []uint32
+
+		mockRows *sqlmock.Rows
+
+		expUsers []*userdomain.UserDomain
+	}{
+		{
+			testName: "Success",
+			userIds:  []uint32{1, 2, 3},
+
+			mockRows: sqlmock.NewRows([]string{"id", "first_name", "middle_name", "last_name", "hash_password", "email", "email_verified"}).
+				AddRow(1, "Ivan", "Ivanovich", "Ivanov", "somePass", "ivan@gmail.com", false).
+				AddRow(2, "Petr", nil, "Petrov", "somePass", "petr@gmail.com", false),
+
+			expUsers: []*userdomain.UserDomain{
+				userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "somePass", "ivan@gmail.com"),
+				userdomain.NewUserDomain(2, "Petr", "", "Petrov", "somePass", "petr@gmail.com"),
+			},
+		}, {
+			testName: "Nothing found",
+			userIds:  []uint32{1},
+
+			mockRows: sqlmock.NewRows([]string{"id", "first_name", "middle_name", "last_name", "hash_password", "email", "email_verified"}),
+
+			expUsers: []*userdomain.UserDomain{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			ids := make([]int64, 0, len(tt.userIds))
+			for _, id := range tt.userIds {
+				ids = append(ids, int64(id))
+			}
+
+			mock.ExpectQuery(regexp.QuoteMeta(QueryFindByIds)).
+				WithArgs(pq.Array(ids)).
+				WillReturnRows(tt.mockRows)
+
+			repo := NewPostgres(db)
+			users, err := repo.FindByIds(context.Background(), tt.userIds)
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expUsers, users)
+		})
+	}
+}
+
+func TestPostgres_Update(t *testing.T) {
+	tests := []struct {
+		testName string
+		user     *userdomain.UserDomain
+
+		execErr     error
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+			user:     userdomain.NewUserDomain(1, "Ivan", "", "Ivanov", "", "new@gmail.com"),
+
+			execErr:     nil,
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName: "User not found",
+			user:     userdomain.NewUserDomain(1, "Ivan", "", "Ivanov", "", "new@gmail.com"),
+
+			execErr:     nil,
+			rowAffected: 0,
+
+			expErr: storagerepo.ErrNoRows,
+		}, {
+			testName: "Email already taken",
+			user:     userdomain.NewUserDomain(1, "Ivan", "", "Ivanov", "", "new@gmail.com"),
+
+			execErr: &pq.Error{Code: "23505"},
+
+			expErr: storagerepo.ErrAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			exp := mock.ExpectExec(regexp.QuoteMeta(QueryUpdateUser)).
+				WithArgs(tt.user.Id, tt.user.FirstName, sql.NullString{}, tt.user.LastName, tt.user.Email, tt.user.EmailVerified)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+			}
+
+			repo := NewPostgres(db)
+			err = repo.Update(context.Background(), tt.user)
+			require.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestPostgres_UpdatePassword(t *testing.T) {
+	tests := []struct {
+		testName     string
+		userId       uint32
+		hashPassword string
+
+		execErr     error
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName:     "Success",
+			userId:       1,
+			hashPassword: "hash",
+
+			execErr:     nil,
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName:     "User not found",
+			userId:       1,
+			hashPassword: "hash",
+
+			execErr:     nil,
+			rowAffected: 0,
+
+			expErr: storagerepo.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			exp := mock.ExpectExec(regexp.QuoteMeta(QueryUpdatePassword)).
+				WithArgs(tt.userId, tt.hashPassword)
+			if tt.execErr != nil {
+				exp.WillReturnError(tt.execErr)
+			} else {
+				exp.WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+			}
+
+			repo := NewPostgres(db)
+			err = repo.UpdatePassword(context.Background(), tt.userId, tt.hashPassword)
+			require.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
+
+func TestPostgres_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		testName string
+		userId   uint32
+		email    string
+
+		rowAffected int64
+
+		expErr error
+	}{
+		{
+			testName: "Success",
+			userId:   1,
+			email:    "ivan@gmail.com",
+
+			rowAffected: 1,
+
+			expErr: nil,
+		}, {
+			testName: "User not found or email changed",
+			userId:   1,
+			email:    "ivan@gmail.com",
+
+			rowAffected: 0,
+
+			expErr: storagerepo.ErrNoRows,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectExec(regexp.QuoteMeta(QueryVerifyEmail)).
+				WithArgs(tt.userId, tt.email).
+				WillReturnResult(sqlmock.NewResult(0, tt.rowAffected))
+
+			repo := NewPostgres(db)
+			err = repo.VerifyEmail(context.Background(), tt.userId, tt.email)
+			require.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
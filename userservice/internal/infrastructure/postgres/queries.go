@@ -8,10 +8,36 @@ const (
 		middle_name, 
 		last_name, 
 		hash_password, 
-		email 
+		email,
+		email_verified
 	FROM users 
 	WHERE email = $1`
 
+	QueryFindById = `
+	SELECT 
+		id, 
+		first_name, 
+		middle_name, 
+		last_name, 
+		hash_password, 
+		email,
+		email_verified
+	FROM users 
+	WHERE id = $1`
+
+	QueryFindByIds = `
+	SELECT 
+		id, 
+		first_name, 
+		middle_name, 
+		last_name, 
+		hash_password, 
+		email,
+		email_verified
+	FROM users 
+	WHERE id = ANY($1)
+	ORDER BY id`
+
 	QuerySaveUser = `
 	INSERT INTO users (
 		first_name,
@@ -20,4 +46,93 @@ const (
 		hash_password,
 		email
 	) VALUES ($1, $2, $3, $4, $5) RETURNING id`
+
+	QueryUpdateUser = `
+	UPDATE users SET
+		first_name = $2,
+		middle_name = $3,
+		last_name = $4,
+		email = $5,
+		email_verified = $6
+	WHERE id = $1`
+
+	QueryUpdatePassword = `
+	UPDATE users SET
+		hash_password = $2
+	WHERE id = $1`
+
+	QueryVerifyEmail = `
+	UPDATE users SET
+		email_verified = TRUE
+	WHERE id = $1 AND email = $2`
+
+	QuerySaveApiToken = `
+	INSERT INTO api_tokens (
+		user_id,
+		name,
+		token_hash,
+		prefix,
+		created_at,
+		expires_at
+	) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+
+	QueryFindApiTokenByHash = `
+	SELECT
+		id,
+		user_id,
+		name,
+		token_hash,
+		prefix,
+		created_at,
+		expires_at,
+		last_used_at
+	FROM api_tokens
+	WHERE token_hash = $1`
+
+	QueryFindApiTokensForUser = `
+	SELECT
+		id,
+		user_id,
+		name,
+		token_hash,
+		prefix,
+		created_at,
+		expires_at,
+		last_used_at
+	FROM api_tokens
+	WHERE user_id = $1
+	ORDER BY id`
+
+	QueryDeleteApiTokenForUser = `
+	DELETE FROM api_tokens
+	WHERE id = $1 AND user_id = $2`
+
+	QueryDeleteApiTokensForUser = `
+	DELETE FROM api_tokens
+	WHERE user_id = $1`
+
+	QueryTouchApiToken = `
+	UPDATE api_tokens SET
+		last_used_at = $2
+	WHERE id = $1`
+
+	QuerySaveSigningKey = `
+	INSERT INTO signing_keys (
+		kid,
+		algorithm,
+		private_key,
+		created_at,
+		expires_at
+	) VALUES ($1, $2, $3, $4, $5)`
+
+	QueryFindSigningKeys = `
+	SELECT
+		kid,
+		algorithm,
+		private_key,
+		created_at,
+		expires_at
+	FROM signing_keys
+	WHERE expires_at > $1
+	ORDER BY created_at DESC`
 )
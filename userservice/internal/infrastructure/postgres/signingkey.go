@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+	posmapper "userservice/internal/infrastructure/postgres/mapper"
+	posmodels "userservice/internal/infrastructure/postgres/models"
+)
+
+func (p *Postgres) SaveSigningKey(ctx context.Context, kd *signingkeydomain.SigningKeyDomain) error {
+	km, err := posmapper.SigningKeyDomainToModel(kd)
+	if err != nil {
+		return err
+	}
+
+	_, err = p.db.ExecContext(
+		ctx,
+		QuerySaveSigningKey,
+		km.Kid,
+		km.Algorithm,
+		km.PrivateKey,
+		km.CreatedAt,
+		km.ExpiresAt,
+	)
+	return err
+}
+
+func (p *Postgres) FindSigningKeys(ctx context.Context, now time.Time) ([]*signingkeydomain.SigningKeyDomain, error) {
+	rows, err := p.db.QueryContext(ctx, QueryFindSigningKeys, now)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := make([]*signingkeydomain.SigningKeyDomain, 0)
+	for rows.Next() {
+		var km posmodels.SigningKeyPosModel
+
+		err := rows.Scan(
+			&km.Kid,
+			&km.Algorithm,
+			&km.PrivateKey,
+			&km.CreatedAt,
+			&km.ExpiresAt,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		kd, err := posmapper.SigningKeyModelToDomain(&km)
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, kd)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
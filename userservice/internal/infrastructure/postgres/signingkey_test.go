@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"crypto/x509"
+	"regexp"
+	"testing"
+	"time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgres_SaveSigningKey(t *testing.T) {
+	createdAt := time.Now().UTC()
+	expiresAt := createdAt.Add(24 * time.Hour)
+
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	kd, err := signingkeydomain.NewSigningKeyDomain("kid", signingkeydomain.AlgEdDSA, createdAt, expiresAt)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(kd.PrivateKey)
+	require.NoError(t, err)
+
+	mock.ExpectExec(regexp.QuoteMeta(QuerySaveSigningKey)).
+		WithArgs("kid", signingkeydomain.AlgEdDSA, der, createdAt, expiresAt).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	repo := NewPostgres(db)
+	err = repo.SaveSigningKey(context.Background(), kd)
+	require.NoError(t, err)
+	require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgres_FindSigningKeys(t *testing.T) {
+	now := time.Now().UTC()
+
+	kd, err := signingkeydomain.NewSigningKeyDomain("kid", signingkeydomain.AlgEdDSA, now, now.Add(time.Hour))
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKCS8PrivateKey(kd.PrivateKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		testName string
+
+		mockRows *sqlmock.Rows
+
+		expKeys []*signingkeydomain.SigningKeyDomain
+		expErr  bool
+	}{
+		{
+			testName: "Success",
+
+			mockRows: sqlmock.NewRows([]string{"kid", "algorithm", "private_key", "created_at", "expires_at"}).
+				AddRow("kid", signingkeydomain.AlgEdDSA, der, now, now.Add(time.Hour)),
+
+			expKeys: []*signingkeydomain.SigningKeyDomain{kd},
+			expErr:  false,
+		}, {
+			testName: "No keys",
+
+			mockRows: sqlmock.NewRows([]string{"kid", "algorithm", "private_key", "created_at", "expires_at"}),
+
+			expKeys: []*signingkeydomain.SigningKeyDomain{},
+			expErr:  false,
+		}, {
+			testName: "Corrupted key",
+
+			mockRows: sqlmock.NewRows([]string{"kid", "algorithm", "private_key", "created_at", "expires_at"}).
+				AddRow("kid", signingkeydomain.AlgEdDSA, []byte("broken"), now, now.Add(time.Hour)),
+
+			expKeys: nil,
+			expErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			db, mock, err := sqlmock.New()
+			require.NoError(t, err)
+			defer db.Close()
+
+			mock.ExpectQuery(regexp.QuoteMeta(QueryFindSigningKeys)).
+				WithArgs(now).
+				WillReturnRows(tt.mockRows)
+
+			repo := NewPostgres(db)
+			keys, err := repo.FindSigningKeys(context.Background(), now)
+			if tt.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tt.expKeys, keys)
+		})
+	}
+}
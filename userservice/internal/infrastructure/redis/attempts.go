@@ -0,0 +1,62 @@
+package myredis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type LoginAttempts struct {
+	client *redis.Client
+	window time.Duration
+}
+
+func NewLoginAttempts(client *redis.Client, window time.Duration) *LoginAttempts {
+	return &LoginAttempts{
+		client: client,
+		window: window,
+	}
+}
+
+func (l *LoginAttempts) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	d, err := l.client.PTTL(ctx, lockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+	if d <= 0 {
+		return 0, nil
+	}
+	return d, nil
+}
+
+func (l *LoginAttempts) RegisterFailure(ctx context.Context, key string) (int64, error) {
+	failKey := failuresKey(key)
+
+	cnt, err := l.client.Incr(ctx, failKey).Result()
+	if err != nil {
+		return 0, err
+	}
+	if cnt == 1 {
+		if err := l.client.Expire(ctx, failKey, l.window).Err(); err != nil {
+			return 0, err
+		}
+	}
+	return cnt, nil
+}
+
+func (l *LoginAttempts) Lock(ctx context.Context, key string, d time.Duration) error {
+	return l.client.Set(ctx, lockKey(key), 1, d).Err()
+}
+
+func (l *LoginAttempts) Reset(ctx context.Context, key string) error {
+	return l.client.Del(ctx, failuresKey(key), lockKey(key)).Err()
+}
+
+func failuresKey(key string) string {
+	return "login_failures:" + key
+}
+
+func lockKey(key string) string {
+	return "login_lock:" + key
+}
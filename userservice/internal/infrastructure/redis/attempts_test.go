@@ -0,0 +1,72 @@
+package myredis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLoginAttempts(t *testing.T) (*LoginAttempts, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	return NewLoginAttempts(client, 15*time.Minute), mr
+}
+
+func TestLoginAttempts_RegisterFailure(t *testing.T) {
+	la, mr := newTestLoginAttempts(t)
+	ctx := context.Background()
+
+	for i := int64(1); i <= 3; i++ {
+		cnt, err := la.RegisterFailure(ctx, "email:ivan@mail.ru")
+		require.NoError(t, err)
+		require.Equal(t, i, cnt)
+	}
+	require.Equal(t, 15*time.Minute, mr.TTL("login_failures:email:ivan@mail.ru"))
+
+	mr.FastForward(16 * time.Minute)
+
+	cnt, err := la.RegisterFailure(ctx, "email:ivan@mail.ru")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), cnt)
+}
+
+func TestLoginAttempts_Lock(t *testing.T) {
+	la, mr := newTestLoginAttempts(t)
+	ctx := context.Background()
+
+	d, err := la.LockedFor(ctx, "ip:127.0.0.1")
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	require.NoError(t, la.Lock(ctx, "ip:127.0.0.1", time.Minute))
+
+	d, err = la.LockedFor(ctx, "ip:127.0.0.1")
+	require.NoError(t, err)
+	require.Equal(t, time.Minute, d)
+
+	mr.FastForward(time.Minute)
+
+	d, err = la.LockedFor(ctx, "ip:127.0.0.1")
+	require.NoError(t, err)
+	require.Zero(t, d)
+}
+
+func TestLoginAttempts_Reset(t *testing.T) {
+	la, mr := newTestLoginAttempts(t)
+	ctx := context.Background()
+
+	_, err := la.RegisterFailure(ctx, "email:ivan@mail.ru")
+	require.NoError(t, err)
+	require.NoError(t, la.Lock(ctx, "email:ivan@mail.ru", time.Minute))
+
+	require.NoError(t, la.Reset(ctx, "email:ivan@mail.ru"))
+
+	require.False(t, mr.Exists("login_failures:email:ivan@mail.ru"))
+	require.False(t, mr.Exists("login_lock:email:ivan@mail.ru"))
+}
@@ -0,0 +1,45 @@
+package redismapper
+
+import (
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	sessiondomain "userservice/internal/domain/session"
+	redismodels "userservice/internal/infrastructure/redis/models"
+)
+
+func ModelToDomain(sm *redismodels.SessionRedisModel) *sessiondomain.SessionDomain {
+	return sessiondomain.NewSessionDomain(
+		sm.Id,
+		sm.UserId,
+		sm.UserAgent,
+		sm.IP,
+		sm.CreatedAt,
+		sm.LastSeen,
+	)
+}
+
+func DomainToModel(sd *sessiondomain.SessionDomain) *redismodels.SessionRedisModel {
+	return redismodels.NewSessionRedisModel(
+		sd.Id,
+		sd.UserId,
+		sd.UserAgent,
+		sd.IP,
+		sd.CreatedAt,
+		sd.LastSeen,
+	)
+}
+
+func RefreshTokenModelToDomain(rm *redismodels.RefreshTokenRedisModel) *refreshtokendomain.RefreshTokenDomain {
+	return refreshtokendomain.NewRefreshTokenDomain(
+		rm.UserId,
+		rm.SessionId,
+		rm.ExpiresAt,
+	)
+}
+
+func RefreshTokenDomainToModel(rd *refreshtokendomain.RefreshTokenDomain) *redismodels.RefreshTokenRedisModel {
+	return redismodels.NewRefreshTokenRedisModel(
+		rd.UserId,
+		rd.SessionId,
+		rd.ExpiresAt,
+	)
+}
@@ -0,0 +1,17 @@
+package redismodels
+
+import "time"
+
+type RefreshTokenRedisModel struct {
+	UserId    uint32    `json:"user_id"`
+	SessionId string    `json:"session_id"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewRefreshTokenRedisModel(userId uint32, sessionId string, expiresAt time.Time) *RefreshTokenRedisModel {
+	return &RefreshTokenRedisModel{
+		UserId:    userId,
+		SessionId: sessionId,
+		ExpiresAt: expiresAt,
+	}
+}
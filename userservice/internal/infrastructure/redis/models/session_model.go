@@ -0,0 +1,23 @@
+package redismodels
+
+import "time"
+
+type SessionRedisModel struct {
+	Id        string    `json:"id"`
+	UserId    uint32    `json:"user_id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+}
+
+func NewSessionRedisModel(id string, userId uint32, userAgent, ip string, createdAt, lastSeen time.Time) *SessionRedisModel {
+	return &SessionRedisModel{
+		Id:        id,
+		UserId:    userId,
+		UserAgent: userAgent,
+		IP:        ip,
+		CreatedAt: createdAt,
+		LastSeen:  lastSeen,
+	}
+}
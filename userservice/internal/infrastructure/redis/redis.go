@@ -2,8 +2,15 @@ package myredis
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
+	sessiondomain "userservice/internal/domain/session"
+	redismapper "userservice/internal/infrastructure/redis/mapper"
+	redismodels "userservice/internal/infrastructure/redis/models"
 	"userservice/internal/repository/session"
 
 	"github.com/redis/go-redis/v9"
@@ -14,28 +21,229 @@ var (
 )
 
 type Redis struct {
-	client *redis.Client
-	ttl    *time.Duration
+	client  *redis.Client
+	ttl     *time.Duration
+	channel string
 }
 
-func NewRedis(client *redis.Client, ttl *time.Duration) *Redis {
+func NewRedis(client *redis.Client, ttl *time.Duration, channel string) *Redis {
 	return &Redis{
-		client: client,
-		ttl:    ttl,
+		client:  client,
+		ttl:     ttl,
+		channel: channel,
 	}
 }
 
-func (r *Redis) Save(ctx context.Context, sessionId string, userId uint32) error {
-	return r.client.Set(ctx, sessionId, userId, *r.ttl).Err()
+func (r *Redis) Save(ctx context.Context, sessionId string, s *sessiondomain.SessionDomain) error {
+	data, err := json.Marshal(redismapper.DomainToModel(s))
+	if err != nil {
+		return err
+	}
+
+	indexKey := userSessionsKey(s.UserId)
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, sessionId, data, *r.ttl)
+		pipe.HSet(ctx, indexKey, s.Id, sessionId)
+		pipe.Expire(ctx, indexKey, *r.ttl)
+		return nil
+	})
+	return err
 }
 
 func (r *Redis) Get(ctx context.Context, sessionId string) (uint32, error) {
-	id, err := r.client.Get(ctx, sessionId).Int()
+	s, err := r.GetSession(ctx, sessionId)
+	if err != nil {
+		return invalidId, err
+	}
+	return s.UserId, nil
+}
+
+func (r *Redis) GetSession(ctx context.Context, sessionId string) (*sessiondomain.SessionDomain, error) {
+	data, err := r.client.Get(ctx, sessionId).Bytes()
 	if err != nil {
 		if errors.Is(err, redis.Nil) {
-			return invalidId, session.ErrKeyNotFound
+			return nil, session.ErrKeyNotFound
 		}
-		return invalidId, err
+		return nil, err
+	}
+	return unmarshalSession(data)
+}
+
+func (r *Redis) GetAllForUser(ctx context.Context, userId uint32) ([]*sessiondomain.SessionDomain, error) {
+	indexKey := userSessionsKey(userId)
+
+	index, err := r.client.HGetAll(ctx, indexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(index) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(index))
+	sessionIds := make([]string, 0, len(index))
+	for id, sessionId := range index {
+		ids = append(ids, id)
+		sessionIds = append(sessionIds, sessionId)
+	}
+
+	values, err := r.client.MGet(ctx, sessionIds...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*sessiondomain.SessionDomain, 0, len(values))
+	expired := make([]string, 0)
+	for i, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			expired = append(expired, ids[i])
+			continue
+		}
+		s, err := unmarshalSession([]byte(data))
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, s)
+	}
+
+	if len(expired) > 0 {
+		if err := r.client.HDel(ctx, indexKey, expired...).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return sessions, nil
+}
+
+func (r *Redis) Touch(ctx context.Context, sessionId string, lastSeen time.Time, ttl time.Duration) error {
+	s, err := r.GetSession(ctx, sessionId)
+	if err != nil {
+		return err
+	}
+	s.LastSeen = lastSeen
+
+	data, err := json.Marshal(redismapper.DomainToModel(s))
+	if err != nil {
+		return err
+	}
+
+	args := redis.SetArgs{Mode: "XX", KeepTTL: true}
+	if ttl > 0 {
+		args = redis.SetArgs{Mode: "XX", TTL: ttl}
+	}
+
+	err = r.client.SetArgs(ctx, sessionId, data, args).Err()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return session.ErrKeyNotFound
+		}
+		return err
 	}
-	return uint32(id), nil
+
+	if ttl > 0 {
+		return r.client.Expire(ctx, userSessionsKey(s.UserId), *r.ttl).Err()
+	}
+	return nil
+}
+
+func (r *Redis) Delete(ctx context.Context, sessionId string) error {
+	s, err := r.GetSession(ctx, sessionId)
+	if err != nil {
+		return err
+	}
+
+	tokens, err := r.client.SMembers(ctx, sessionRefreshTokensKey(sessionId)).Result()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionId)
+		pipe.HDel(ctx, userSessionsKey(s.UserId), s.Id)
+		deleteSessionRefreshTokens(ctx, pipe, s.UserId, sessionId, tokens)
+		r.publishInvalidation(ctx, pipe, sessionId)
+		return nil
+	})
+	return err
+}
+
+func (r *Redis) DeleteForUser(ctx context.Context, userId uint32, id string) error {
+	indexKey := userSessionsKey(userId)
+
+	sessionId, err := r.client.HGet(ctx, indexKey, id).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return session.ErrKeyNotFound
+		}
+		return err
+	}
+
+	tokens, err := r.client.SMembers(ctx, sessionRefreshTokensKey(sessionId)).Result()
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, sessionId)
+		pipe.HDel(ctx, indexKey, id)
+		deleteSessionRefreshTokens(ctx, pipe, userId, sessionId, tokens)
+		r.publishInvalidation(ctx, pipe, sessionId)
+		return nil
+	})
+	return err
+}
+
+func (r *Redis) DeleteAllForUser(ctx context.Context, userId uint32) error {
+	indexKey := userSessionsKey(userId)
+
+	sessionIds, err := r.client.HVals(ctx, indexKey).Result()
+	if err != nil {
+		return err
+	}
+
+	refreshKey := userRefreshTokensKey(userId)
+
+	tokens, err := r.client.SMembers(ctx, refreshKey).Result()
+	if err != nil {
+		return err
+	}
+
+	keys := append(sessionIds, indexKey, refreshKey)
+	keys = append(keys, refreshTokenKeys(tokens)...)
+	for _, sessionId := range sessionIds {
+		keys = append(keys, sessionRefreshTokensKey(sessionId))
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, keys...)
+		for _, sessionId := range sessionIds {
+			r.publishInvalidation(ctx, pipe, sessionId)
+		}
+		return nil
+	})
+	return err
+}
+
+// publishInvalidation tells downstream session caches to drop the session.
+// Only the hash is sent so session ids never leave the session store.
+func (r *Redis) publishInvalidation(ctx context.Context, pipe redis.Pipeliner, sessionId string) {
+	if r.channel == "" {
+		return
+	}
+	sum := sha256.Sum256([]byte(sessionId))
+	pipe.Publish(ctx, r.channel, hex.EncodeToString(sum[:]))
+}
+
+func unmarshalSession(data []byte) (*sessiondomain.SessionDomain, error) {
+	var sm redismodels.SessionRedisModel
+	if err := json.Unmarshal(data, &sm); err != nil {
+		return nil, err
+	}
+	return redismapper.ModelToDomain(&sm), nil
+}
+
+func userSessionsKey(userId uint32) string {
+	return fmt.Sprintf("user_sessions:%d", userId)
 }
@@ -0,0 +1,190 @@
+package myredis
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/session"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRedis(t *testing.T) (*Redis, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	ttl := time.Hour
+	return NewRedis(client, &ttl, "session_invalidation"), mr
+}
+
+func TestRedis_SaveAndGet(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	s := sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)
+	require.NoError(t, r.Save(ctx, "sessionId", s))
+
+	userId, err := r.Get(ctx, "sessionId")
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), userId)
+
+	got, err := r.GetSession(ctx, "sessionId")
+	require.NoError(t, err)
+	require.Equal(t, s, got)
+
+	require.Equal(t, time.Hour, mr.TTL("sessionId"))
+	require.Equal(t, time.Hour, mr.TTL("user_sessions:7"))
+
+	_, err = r.Get(ctx, "unknown")
+	require.Equal(t, session.ErrKeyNotFound, err)
+}
+
+func TestRedis_Touch(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	s := sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)
+	require.NoError(t, r.Save(ctx, "sessionId", s))
+
+	mr.FastForward(10 * time.Minute)
+
+	lastSeen := timeNow.Add(10 * time.Minute)
+	require.NoError(t, r.Touch(ctx, "sessionId", lastSeen, 0))
+
+	got, err := r.GetSession(ctx, "sessionId")
+	require.NoError(t, err)
+	require.Equal(t, lastSeen, got.LastSeen)
+	require.Equal(t, timeNow, got.CreatedAt)
+	require.Equal(t, 50*time.Minute, mr.TTL("sessionId"))
+
+	require.Equal(t, session.ErrKeyNotFound, r.Touch(ctx, "unknown", lastSeen, 0))
+}
+
+func TestRedis_TouchWithTTL(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	s := sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)
+	require.NoError(t, r.Save(ctx, "sessionId", s))
+
+	mr.FastForward(10 * time.Minute)
+
+	require.NoError(t, r.Touch(ctx, "sessionId", timeNow.Add(10*time.Minute), 30*time.Minute))
+	require.Equal(t, 30*time.Minute, mr.TTL("sessionId"))
+	require.Equal(t, time.Hour, mr.TTL("user_sessions:7"))
+}
+
+func TestRedis_GetAllForUser(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	require.NoError(t, r.Save(ctx, "first", sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "second", sessiondomain.NewSessionDomain("2", 7, "other", "10.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "foreign", sessiondomain.NewSessionDomain("3", 8, "agent", "127.0.0.1", timeNow, timeNow)))
+
+	mr.Del("second")
+
+	sessions, err := r.GetAllForUser(ctx, 7)
+	require.NoError(t, err)
+	require.Equal(t, []*sessiondomain.SessionDomain{
+		sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow),
+	}, sessions)
+	require.Equal(t, []string{"1"}, mustHKeys(t, mr, "user_sessions:7"))
+
+	sessions, err = r.GetAllForUser(ctx, 9)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestRedis_Delete(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	require.NoError(t, r.Save(ctx, "first", sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "second", sessiondomain.NewSessionDomain("2", 7, "other", "10.0.0.1", timeNow, timeNow)))
+
+	require.NoError(t, r.Delete(ctx, "first"))
+	require.False(t, mr.Exists("first"))
+	require.Equal(t, []string{"2"}, mustHKeys(t, mr, "user_sessions:7"))
+
+	require.Equal(t, session.ErrKeyNotFound, r.Delete(ctx, "first"))
+}
+
+func TestRedis_DeleteForUser(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	require.NoError(t, r.Save(ctx, "first", sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "foreign", sessiondomain.NewSessionDomain("3", 8, "agent", "127.0.0.1", timeNow, timeNow)))
+
+	require.Equal(t, session.ErrKeyNotFound, r.DeleteForUser(ctx, 7, "3"))
+	require.True(t, mr.Exists("foreign"))
+
+	require.NoError(t, r.DeleteForUser(ctx, 7, "1"))
+	require.False(t, mr.Exists("first"))
+	require.False(t, mr.Exists("user_sessions:7"))
+}
+
+func TestRedis_DeleteAllForUser(t *testing.T) {
+	r, mr := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	require.NoError(t, r.Save(ctx, "first", sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "second", sessiondomain.NewSessionDomain("2", 7, "other", "10.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "foreign", sessiondomain.NewSessionDomain("3", 8, "agent", "127.0.0.1", timeNow, timeNow)))
+
+	require.NoError(t, r.DeleteAllForUser(ctx, 7))
+	require.False(t, mr.Exists("first"))
+	require.False(t, mr.Exists("second"))
+	require.False(t, mr.Exists("user_sessions:7"))
+	require.True(t, mr.Exists("foreign"))
+}
+
+func mustHKeys(t *testing.T, mr *miniredis.Miniredis, key string) []string {
+	keys, err := mr.HKeys(key)
+	require.NoError(t, err)
+	return keys
+}
+
+func TestRedis_DeletePublishesInvalidation(t *testing.T) {
+	r, _ := newTestRedis(t)
+	ctx := context.Background()
+	timeNow := time.Now().UTC().Round(0)
+
+	sub := r.client.Subscribe(ctx, "session_invalidation")
+	defer sub.Close()
+	_, err := sub.Receive(ctx)
+	require.NoError(t, err)
+	messages := sub.Channel()
+
+	require.NoError(t, r.Save(ctx, "first", sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "second", sessiondomain.NewSessionDomain("2", 7, "other", "10.0.0.1", timeNow, timeNow)))
+	require.NoError(t, r.Save(ctx, "third", sessiondomain.NewSessionDomain("3", 7, "other", "10.0.0.1", timeNow, timeNow)))
+
+	require.NoError(t, r.Delete(ctx, "first"))
+	require.NoError(t, r.DeleteForUser(ctx, 7, "2"))
+	require.NoError(t, r.DeleteAllForUser(ctx, 7))
+
+	for _, sessionId := range []string{"first", "second", "third"} {
+		select {
+		case msg := <-messages:
+			sum := sha256.Sum256([]byte(sessionId))
+			require.Equal(t, hex.EncodeToString(sum[:]), msg.Payload)
+		case <-time.After(time.Second):
+			t.Fatalf("no invalidation for %s", sessionId)
+		}
+	}
+}
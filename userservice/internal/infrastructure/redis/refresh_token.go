@@ -0,0 +1,146 @@
+package myredis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	redismapper "userservice/internal/infrastructure/redis/mapper"
+	redismodels "userservice/internal/infrastructure/redis/models"
+	"userservice/internal/repository/token"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// RefreshTokenStore keeps every refresh token indexed by its session and its
+// user, so the session store can drop them together with the sessions.
+type RefreshTokenStore struct {
+	client *redis.Client
+}
+
+func NewRefreshTokenStore(client *redis.Client) *RefreshTokenStore {
+	return &RefreshTokenStore{
+		client: client,
+	}
+}
+
+func (r *RefreshTokenStore) Save(ctx context.Context, tkn string, rt *refreshtokendomain.RefreshTokenDomain) error {
+	ttl := time.Until(rt.ExpiresAt)
+	if ttl <= 0 {
+		return token.ErrTokenExpired
+	}
+
+	data, err := json.Marshal(redismapper.RefreshTokenDomainToModel(rt))
+	if err != nil {
+		return err
+	}
+
+	sessionKey := sessionRefreshTokensKey(rt.SessionId)
+	userKey := userRefreshTokensKey(rt.UserId)
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, refreshTokenKey(tkn), data, ttl)
+		pipe.SAdd(ctx, sessionKey, tkn)
+		pipe.SAdd(ctx, userKey, tkn)
+		// the indexes live as long as their longest token
+		for _, key := range []string{sessionKey, userKey} {
+			pipe.ExpireNX(ctx, key, ttl)
+			pipe.ExpireGT(ctx, key, ttl)
+		}
+		return nil
+	})
+	return err
+}
+
+func (r *RefreshTokenStore) Consume(ctx context.Context, tkn string) (*refreshtokendomain.RefreshTokenDomain, error) {
+	data, err := r.client.GetDel(ctx, refreshTokenKey(tkn)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, token.ErrTokenNotFound
+		}
+		return nil, err
+	}
+
+	var rm redismodels.RefreshTokenRedisModel
+	if err := json.Unmarshal(data, &rm); err != nil {
+		return nil, err
+	}
+
+	_, err = r.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SRem(ctx, sessionRefreshTokensKey(rm.SessionId), tkn)
+		pipe.SRem(ctx, userRefreshTokensKey(rm.UserId), tkn)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return redismapper.RefreshTokenModelToDomain(&rm), nil
+}
+
+func (r *RefreshTokenStore) DeleteAllForUser(ctx context.Context, userId uint32) error {
+	userKey := userRefreshTokensKey(userId)
+
+	tokens, err := r.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		return nil
+	}
+
+	keys := refreshTokenKeys(tokens)
+	values, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return err
+	}
+
+	// the session indexes go too, the sessions themselves stay
+	for _, v := range values {
+		data, ok := v.(string)
+		if !ok {
+			continue
+		}
+		var rm redismodels.RefreshTokenRedisModel
+		if err := json.Unmarshal([]byte(data), &rm); err != nil {
+			return err
+		}
+		keys = append(keys, sessionRefreshTokensKey(rm.SessionId))
+	}
+
+	keys = append(keys, userKey)
+	return r.client.Del(ctx, keys...).Err()
+}
+
+// deleteSessionRefreshTokens queues the removal of the tokens issued for a
+// session; tokens are read beforehand with SMembers on sessionRefreshTokensKey.
+func deleteSessionRefreshTokens(ctx context.Context, pipe redis.Pipeliner, userId uint32, sessionId string, tokens []string) {
+	keys := refreshTokenKeys(tokens)
+	keys = append(keys, sessionRefreshTokensKey(sessionId))
+	pipe.Del(ctx, keys...)
+	if len(tokens) > 0 {
+		pipe.SRem(ctx, userRefreshTokensKey(userId), tokens)
+	}
+}
+
+func refreshTokenKeys(tokens []string) []string {
+	keys := make([]string, 0, len(tokens)+1)
+	for _, tkn := range tokens {
+		keys = append(keys, refreshTokenKey(tkn))
+	}
+	return keys
+}
+
+func refreshTokenKey(tkn string) string {
+	return "refresh_token:" + tkn
+}
+
+func sessionRefreshTokensKey(sessionId string) string {
+	return "session_refresh_tokens:" + sessionId
+}
+
+func userRefreshTokensKey(userId uint32) string {
+	return fmt.Sprintf("user_refresh_tokens:%d", userId)
+}
@@ -0,0 +1,99 @@
+package myredis
+
+import (
+	"context"
+	"testing"
+	"time"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/token"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRefreshTokenStore_SaveAndConsume(t *testing.T) {
+	r, mr := newTestRedis(t)
+	store := NewRefreshTokenStore(r.client)
+	ctx := context.Background()
+	expiresAt := time.Now().Add(time.Hour).UTC().Round(0)
+
+	rt := refreshtokendomain.NewRefreshTokenDomain(7, "sessionId", expiresAt)
+	require.NoError(t, store.Save(ctx, "token", rt))
+
+	require.InDelta(t, time.Hour.Seconds(), mr.TTL("refresh_token:token").Seconds(), 1)
+	require.True(t, mr.Exists("session_refresh_tokens:sessionId"))
+	require.True(t, mr.Exists("user_refresh_tokens:7"))
+
+	got, err := store.Consume(ctx, "token")
+	require.NoError(t, err)
+	require.Equal(t, rt, got)
+
+	_, err = store.Consume(ctx, "token")
+	require.Equal(t, token.ErrTokenNotFound, err)
+	require.False(t, mr.Exists("session_refresh_tokens:sessionId"))
+	require.False(t, mr.Exists("user_refresh_tokens:7"))
+}
+
+func TestRefreshTokenStore_Expired(t *testing.T) {
+	r, _ := newTestRedis(t)
+	store := NewRefreshTokenStore(r.client)
+
+	rt := refreshtokendomain.NewRefreshTokenDomain(7, "sessionId", time.Now().Add(-time.Second))
+	require.Equal(t, token.ErrTokenExpired, store.Save(context.Background(), "token", rt))
+}
+
+func TestRefreshTokenStore_RevokedWithSessions(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		revoke func(ctx context.Context, r *Redis, store *RefreshTokenStore) error
+	}{
+		{
+			testName: "Logout",
+
+			revoke: func(ctx context.Context, r *Redis, store *RefreshTokenStore) error {
+				return r.Delete(ctx, "sessionId")
+			},
+		}, {
+			testName: "Revoke session",
+
+			revoke: func(ctx context.Context, r *Redis, store *RefreshTokenStore) error {
+				return r.DeleteForUser(ctx, 7, "1")
+			},
+		}, {
+			testName: "Logout all",
+
+			revoke: func(ctx context.Context, r *Redis, store *RefreshTokenStore) error {
+				return r.DeleteAllForUser(ctx, 7)
+			},
+		}, {
+			testName: "Password change",
+
+			// the current session survives a password change, its refresh tokens do not
+			revoke: func(ctx context.Context, r *Redis, store *RefreshTokenStore) error {
+				return store.DeleteAllForUser(ctx, 7)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			r, mr := newTestRedis(t)
+			store := NewRefreshTokenStore(r.client)
+			ctx := context.Background()
+			timeNow := time.Now().UTC().Round(0)
+
+			s := sessiondomain.NewSessionDomain("1", 7, "agent", "127.0.0.1", timeNow, timeNow)
+			require.NoError(t, r.Save(ctx, "sessionId", s))
+			rt := refreshtokendomain.NewRefreshTokenDomain(7, "sessionId", timeNow.Add(time.Hour))
+			require.NoError(t, store.Save(ctx, "token", rt))
+
+			require.NoError(t, tt.revoke(ctx, r, store))
+
+			_, err := store.Consume(ctx, "token")
+			require.Equal(t, token.ErrTokenNotFound, err)
+			require.False(t, mr.Exists("session_refresh_tokens:sessionId"))
+			require.False(t, mr.Exists("user_refresh_tokens:7"))
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package myredis
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"userservice/internal/repository/token"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type TokenStore struct {
+	client *redis.Client
+	prefix string
+	ttl    time.Duration
+}
+
+func NewTokenStore(client *redis.Client, prefix string, ttl time.Duration) *TokenStore {
+	return &TokenStore{
+		client: client,
+		prefix: prefix,
+		ttl:    ttl,
+	}
+}
+
+func (t *TokenStore) Save(ctx context.Context, tkn string, userId uint32) error {
+	return t.client.Set(ctx, t.key(tkn), userId, t.ttl).Err()
+}
+
+func (t *TokenStore) Consume(ctx context.Context, tkn string) (uint32, error) {
+	data, err := t.client.GetDel(ctx, t.key(tkn)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return invalidId, token.ErrTokenNotFound
+		}
+		return invalidId, err
+	}
+
+	userId, err := strconv.ParseUint(data, 10, 32)
+	if err != nil {
+		return invalidId, err
+	}
+	return uint32(userId), nil
+}
+
+func (t *TokenStore) key(tkn string) string {
+	return t.prefix + ":" + tkn
+}
+
+type EmailTokenStore struct {
+	tokens *TokenStore
+}
+
+func NewEmailTokenStore(client *redis.Client, prefix string, ttl time.Duration) *EmailTokenStore {
+	return &EmailTokenStore{
+		tokens: NewTokenStore(client, prefix, ttl),
+	}
+}
+
+func (e *EmailTokenStore) Save(ctx context.Context, tkn string, userId uint32, email string) error {
+	data := strconv.FormatUint(uint64(userId), 10) + ":" + email
+	return e.tokens.client.Set(ctx, e.tokens.key(tkn), data, e.tokens.ttl).Err()
+}
+
+func (e *EmailTokenStore) Consume(ctx context.Context, tkn string) (uint32, string, error) {
+	data, err := e.tokens.client.GetDel(ctx, e.tokens.key(tkn)).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return invalidId, "", token.ErrTokenNotFound
+		}
+		return invalidId, "", err
+	}
+
+	// the id never contains a colon, the email may
+	id, email, ok := strings.Cut(data, ":")
+	if !ok {
+		return invalidId, "", token.ErrTokenNotFound
+	}
+
+	userId, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return invalidId, "", err
+	}
+	return uint32(userId), email, nil
+}
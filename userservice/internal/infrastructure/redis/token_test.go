@@ -0,0 +1,66 @@
+package myredis
+
+import (
+	"context"
+	"testing"
+	"time"
+	"userservice/internal/repository/token"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenStore_SaveAndConsume(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	ts := NewTokenStore(client, "password_reset", 15*time.Minute)
+	ctx := context.Background()
+
+	require.NoError(t, ts.Save(ctx, "token", 7))
+	require.Equal(t, 15*time.Minute, mr.TTL("password_reset:token"))
+
+	userId, err := ts.Consume(ctx, "token")
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), userId)
+
+	_, err = ts.Consume(ctx, "token")
+	require.Equal(t, token.ErrTokenNotFound, err)
+}
+
+func TestTokenStore_Expired(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	ts := NewTokenStore(client, "password_reset", 15*time.Minute)
+	ctx := context.Background()
+
+	require.NoError(t, ts.Save(ctx, "token", 7))
+	mr.FastForward(16 * time.Minute)
+
+	_, err := ts.Consume(ctx, "token")
+	require.Equal(t, token.ErrTokenNotFound, err)
+}
+
+func TestEmailTokenStore_SaveAndConsume(t *testing.T) {
+	mr := miniredis.RunT(t)
+	client := redis.NewClient(&redis.Options{Addr: mr.Addr()})
+	t.Cleanup(func() { client.Close() })
+
+	ts := NewEmailTokenStore(client, "email_verification", 24*time.Hour)
+	ctx := context.Background()
+
+	require.NoError(t, ts.Save(ctx, "token", 7, "ivan@gmail.com"))
+	require.Equal(t, 24*time.Hour, mr.TTL("email_verification:token"))
+
+	userId, email, err := ts.Consume(ctx, "token")
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), userId)
+	require.Equal(t, "ivan@gmail.com", email)
+
+	_, _, err = ts.Consume(ctx, "token")
+	require.Equal(t, token.ErrTokenNotFound, err)
+}
@@ -0,0 +1,12 @@
+package accesstoken
+
+import (
+	"context"
+	"time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+)
+
+type AccessTokenIssuer interface {
+	Issue(ctx context.Context, userId uint32) (string, time.Time, error)
+	PublicKeys(ctx context.Context) ([]*signingkeydomain.SigningKeyDomain, error)
+}
@@ -0,0 +1,16 @@
+package apitoken
+
+import (
+	"context"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+)
+
+type ApiTokenRepo interface {
+	SaveApiToken(ctx context.Context, t *apitokendomain.ApiTokenDomain) (uint32, error)
+	FindApiTokenByHash(ctx context.Context, hash string) (*apitokendomain.ApiTokenDomain, error)
+	FindApiTokensForUser(ctx context.Context, userId uint32) ([]*apitokendomain.ApiTokenDomain, error)
+	DeleteApiTokenForUser(ctx context.Context, userId, tokenId uint32) error
+	DeleteApiTokensForUser(ctx context.Context, userId uint32) error
+	TouchApiToken(ctx context.Context, tokenId uint32, lastUsedAt time.Time) error
+}
@@ -0,0 +1,7 @@
+package apitoken
+
+import "errors"
+
+var (
+	ErrNotFound = errors.New("api token not found")
+)
@@ -0,0 +1,13 @@
+package attempts
+
+import (
+	"context"
+	"time"
+)
+
+type AttemptsRepo interface {
+	LockedFor(ctx context.Context, key string) (time.Duration, error)
+	RegisterFailure(ctx context.Context, key string) (int64, error)
+	Lock(ctx context.Context, key string, d time.Duration) error
+	Reset(ctx context.Context, key string) error
+}
@@ -0,0 +1,8 @@
+package notifier
+
+import "context"
+
+type Notifier interface {
+	SendPasswordReset(ctx context.Context, email, token string) error
+	SendEmailVerification(ctx context.Context, email, token string) error
+}
@@ -1,8 +1,18 @@
 package session
 
-import "context"
+import (
+	"context"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
+)
 
 type SessionRepo interface {
-	Save(ctx context.Context, sessionId string, userId uint32) error
+	Save(ctx context.Context, sessionId string, s *sessiondomain.SessionDomain) error
 	Get(ctx context.Context, sessionId string) (uint32, error)
+	GetSession(ctx context.Context, sessionId string) (*sessiondomain.SessionDomain, error)
+	GetAllForUser(ctx context.Context, userId uint32) ([]*sessiondomain.SessionDomain, error)
+	Touch(ctx context.Context, sessionId string, lastSeen time.Time, ttl time.Duration) error
+	Delete(ctx context.Context, sessionId string) error
+	DeleteForUser(ctx context.Context, userId uint32, id string) error
+	DeleteAllForUser(ctx context.Context, userId uint32) error
 }
@@ -0,0 +1,12 @@
+package signingkey
+
+import (
+	"context"
+	"time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+)
+
+type SigningKeyRepo interface {
+	SaveSigningKey(ctx context.Context, k *signingkeydomain.SigningKeyDomain) error
+	FindSigningKeys(ctx context.Context, now time.Time) ([]*signingkeydomain.SigningKeyDomain, error)
+}
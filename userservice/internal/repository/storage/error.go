@@ -3,5 +3,6 @@ package storagerepo
 import "errors"
 
 var (
-	ErrNoRows = errors.New("no rows found")
+	ErrNoRows        = errors.New("no rows found")
+	ErrAlreadyExists = errors.New("entry already exists")
 )
@@ -8,4 +8,9 @@ import (
 type StorageRepo interface {
 	Save(ctx context.Context, ud *userdomain.UserDomain) (uint32, error)
 	FindByEmail(ctx context.Context, email string) (*userdomain.UserDomain, error)
+	FindById(ctx context.Context, userId uint32) (*userdomain.UserDomain, error)
+	FindByIds(ctx context.Context, userIds []uint32) ([]*userdomain.UserDomain, error)
+	Update(ctx context.Context, ud *userdomain.UserDomain) error
+	UpdatePassword(ctx context.Context, userId uint32, hashPassword string) error
+	VerifyEmail(ctx context.Context, userId uint32, email string) error
 }
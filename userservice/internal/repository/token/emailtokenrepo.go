@@ -0,0 +1,10 @@
+package token
+
+import "context"
+
+// EmailTokenRepo binds a token to the address it was sent to, so it cannot
+// verify an email the user switched to afterwards.
+type EmailTokenRepo interface {
+	Save(ctx context.Context, token string, userId uint32, email string) error
+	Consume(ctx context.Context, token string) (uint32, string, error)
+}
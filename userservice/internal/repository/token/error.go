@@ -0,0 +1,8 @@
+package token
+
+import "errors"
+
+var (
+	ErrTokenNotFound = errors.New("token not found")
+	ErrTokenExpired  = errors.New("token expired")
+)
@@ -0,0 +1,12 @@
+package token
+
+import (
+	"context"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+)
+
+type RefreshTokenRepo interface {
+	Save(ctx context.Context, token string, rt *refreshtokendomain.RefreshTokenDomain) error
+	Consume(ctx context.Context, token string) (*refreshtokendomain.RefreshTokenDomain, error)
+	DeleteAllForUser(ctx context.Context, userId uint32) error
+}
@@ -0,0 +1,8 @@
+package token
+
+import "context"
+
+type TokenRepo interface {
+	Save(ctx context.Context, token string, userId uint32) error
+	Consume(ctx context.Context, token string) (uint32, error)
+}
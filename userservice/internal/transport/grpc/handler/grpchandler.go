@@ -4,11 +4,18 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	userservicev1 "platform/proto/userservice"
 	"time"
+	userdomain "userservice/internal/domain/user"
 	autherr "userservice/internal/usecase/errors/authenticate"
+	authtokenerr "userservice/internal/usecase/errors/authenticatetoken"
+	batchgeterr "userservice/internal/usecase/errors/batchgetusers"
+	getusererr "userservice/internal/usecase/errors/getuser"
 	"userservice/internal/usecase/interfaces"
 	authmodel "userservice/internal/usecase/models/authenticate"
-	userservicev1 "userservice/proto/userservice"
+	authtokenmodel "userservice/internal/usecase/models/authenticatetoken"
+	batchgetmodel "userservice/internal/usecase/models/batchgetusers"
+	getusermodel "userservice/internal/usecase/models/getuser"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -19,13 +26,25 @@ type GRPCHandler struct {
 	timeout time.Duration
 	userservicev1.UnimplementedUserServiceServer
 
-	authUC interfaces.GetUserIDBySessionUsecase
+	authUC      interfaces.GetUserIDBySessionUsecase
+	authTokenUC interfaces.GetUserIDByTokenUsecase
+	getUserUC   interfaces.GetUserUsecase
+	batchGetUC  interfaces.BatchGetUsersUsecase
 }
 
-func NewGRPCHandler(log *slog.Logger, authUC interfaces.GetUserIDBySessionUsecase) *GRPCHandler {
+func NewGRPCHandler(
+	log *slog.Logger,
+	authUC interfaces.GetUserIDBySessionUsecase,
+	authTokenUC interfaces.GetUserIDByTokenUsecase,
+	getUserUC interfaces.GetUserUsecase,
+	batchGetUC interfaces.BatchGetUsersUsecase,
+) *GRPCHandler {
 	return &GRPCHandler{
-		log:    log,
-		authUC: authUC,
+		log:         log,
+		authUC:      authUC,
+		authTokenUC: authTokenUC,
+		getUserUC:   getUserUC,
+		batchGetUC:  batchGetUC,
 	}
 }
 
@@ -33,23 +52,116 @@ func (g *GRPCHandler) GetIdBySession(ctx context.Context, req *userservicev1.Get
 	const op = "grpchandler.GetIdBySession"
 	log := g.log.With(slog.String("op", op))
 
-	log.Info("start get id by session request")
+	log.InfoContext(ctx, "start get id by session request")
 
 	in := authmodel.NewAuthInput(req.SessionId)
 
 	out, err := g.authUC.Execute(ctx, in)
 	if err != nil {
 		if errors.Is(err, autherr.ErrSessionNotFound) {
-			log.Info("session not found")
+			log.InfoContext(ctx, "session not found")
 			return nil, status.Error(codes.NotFound, "session not found")
 		}
-		log.Warn("cannotfailed to get user id", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "cannotfailed to get user id", slog.String("error", err.Error()))
 		return nil, status.Error(codes.Internal, "internal server error")
 	}
 
-	log.Info("get id by session request completed successfully")
+	log.InfoContext(ctx, "get id by session request completed successfully")
 
 	return &userservicev1.GetIdBySessionResponse{
 		UserId: out.UserId,
 	}, nil
 }
+
+func (g *GRPCHandler) GetIdByToken(ctx context.Context, req *userservicev1.GetIdByTokenRequest) (*userservicev1.GetIdByTokenResponse, error) {
+	const op = "grpchandler.GetIdByToken"
+	log := g.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start get id by token request")
+
+	in := authtokenmodel.NewAuthTokenInput(req.Token)
+
+	out, err := g.authTokenUC.Execute(ctx, in)
+	if err != nil {
+		if errors.Is(err, authtokenerr.ErrInvalidToken) {
+			log.InfoContext(ctx, "invalid api token")
+			return nil, status.Error(codes.Unauthenticated, "invalid or expired token")
+		}
+		log.WarnContext(ctx, "failed to get user id by token", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	log.InfoContext(ctx, "get id by token request completed successfully")
+
+	return &userservicev1.GetIdByTokenResponse{
+		UserId: out.UserId,
+	}, nil
+}
+
+func (g *GRPCHandler) GetUser(ctx context.Context, req *userservicev1.GetUserRequest) (*userservicev1.GetUserResponse, error) {
+	const op = "grpchandler.GetUser"
+	log := g.log.With(slog.String("op", op), slog.Uint64("user_id", uint64(req.UserId)))
+
+	log.InfoContext(ctx, "start get user request")
+
+	in := getusermodel.NewGetUserInput(req.UserId)
+
+	out, err := g.getUserUC.Execute(ctx, in)
+	if err != nil {
+		if errors.Is(err, getusererr.ErrInvalidUserId) {
+			log.InfoContext(ctx, "invalid user id")
+			return nil, status.Error(codes.InvalidArgument, "invalid user id")
+		} else if errors.Is(err, getusererr.ErrUserNotFound) {
+			log.InfoContext(ctx, "user not found")
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		log.WarnContext(ctx, "failed to get user", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	log.InfoContext(ctx, "get user request completed successfully")
+
+	return &userservicev1.GetUserResponse{
+		User: userDomainToProto(out.User),
+	}, nil
+}
+
+func (g *GRPCHandler) BatchGetUsers(ctx context.Context, req *userservicev1.BatchGetUsersRequest) (*userservicev1.BatchGetUsersResponse, error) {
+	const op = "grpchandler.BatchGetUsers"
+	log := g.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start batch get users request")
+
+	in := batchgetmodel.NewBatchGetUsersInput(req.UserIds)
+
+	out, err := g.batchGetUC.Execute(ctx, in)
+	if err != nil {
+		if errors.Is(err, batchgeterr.ErrTooManyIds) {
+			log.InfoContext(ctx, "too many user ids")
+			return nil, status.Error(codes.InvalidArgument, "too many user ids")
+		}
+		log.WarnContext(ctx, "failed to batch get users", slog.String("error", err.Error()))
+		return nil, status.Error(codes.Internal, "internal server error")
+	}
+
+	users := make([]*userservicev1.User, 0, len(out.Users))
+	for _, ud := range out.Users {
+		users = append(users, userDomainToProto(ud))
+	}
+
+	log.InfoContext(ctx, "batch get users request completed successfully")
+
+	return &userservicev1.BatchGetUsersResponse{
+		Users: users,
+	}, nil
+}
+
+func userDomainToProto(ud *userdomain.UserDomain) *userservicev1.User {
+	return &userservicev1.User{
+		Id:         ud.Id,
+		FirstName:  ud.FirstName,
+		MiddleName: ud.MiddleName,
+		LastName:   ud.LastName,
+		Email:      ud.Email,
+	}
+}
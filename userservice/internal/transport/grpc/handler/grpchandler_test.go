@@ -4,11 +4,18 @@ import (
 	"context"
 	"io"
 	"log/slog"
+	userservicev1 "platform/proto/userservice"
 	"testing"
+	userdomain "userservice/internal/domain/user"
 	grpchandlmocks "userservice/internal/transport/grpc/handler/mocks"
 	autherr "userservice/internal/usecase/errors/authenticate"
+	authtokenerr "userservice/internal/usecase/errors/authenticatetoken"
+	batchgeterr "userservice/internal/usecase/errors/batchgetusers"
+	getusererr "userservice/internal/usecase/errors/getuser"
 	authmodel "userservice/internal/usecase/models/authenticate"
-	userservicev1 "userservice/proto/userservice"
+	authtokenmodel "userservice/internal/usecase/models/authenticatetoken"
+	batchgetmodel "userservice/internal/usecase/models/batchgetusers"
+	getusermodel "userservice/internal/usecase/models/getuser"
 
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -17,6 +24,9 @@ import (
 )
 
 //go:generate mockgen -source=./../../../usecase/interfaces/authenticate.go -destination=mocks/mock_authenticate.go -package=grpchandlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/authenticatetoken.go -destination=mocks/mock_authenticatetoken.go -package=grpchandlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/getuser.go -destination=mocks/mock_getuser.go -package=grpchandlmocks
+//go:generate mockgen -source=./../../../usecase/interfaces/batchgetusers.go -destination=mocks/mock_batchgetusers.go -package=grpchandlmocks
 
 func TestGRPCHandler(t *testing.T) {
 	tests := []struct {
@@ -74,10 +84,231 @@ func TestGRPCHandler(t *testing.T) {
 
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-			grpcHandl := NewGRPCHandler(log, authUCMock)
+			grpcHandl := NewGRPCHandler(log, authUCMock, nil, nil, nil)
 			res, err := grpcHandl.GetIdBySession(context.Background(), tt.handlReq)
 			require.ErrorIs(t, err, tt.expErr)
 			require.Equal(t, tt.expOutput, res)
 		})
 	}
 }
+
+func TestGRPCHandler_GetIdByToken(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		handlReq *userservicev1.GetIdByTokenRequest
+
+		authInput  *authtokenmodel.AuthTokenInput
+		authOutput *authtokenmodel.AuthTokenOutput
+		authErr    error
+
+		expOutput *userservicev1.GetIdByTokenResponse
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			handlReq: &userservicev1.GetIdByTokenRequest{
+				Token: "pat_token",
+			},
+
+			authInput:  authtokenmodel.NewAuthTokenInput("pat_token"),
+			authOutput: authtokenmodel.NewAuthTokenOutput(1),
+			authErr:    nil,
+
+			expOutput: &userservicev1.GetIdByTokenResponse{
+				UserId: 1,
+			},
+			expErr: nil,
+		}, {
+			testName: "Invalid token",
+
+			handlReq: &userservicev1.GetIdByTokenRequest{
+				Token: "pat_token",
+			},
+
+			authInput:  authtokenmodel.NewAuthTokenInput("pat_token"),
+			authOutput: authtokenmodel.NewAuthTokenOutput(0),
+			authErr:    authtokenerr.ErrInvalidToken,
+
+			expOutput: nil,
+			expErr:    status.Error(codes.Unauthenticated, "invalid or expired token"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			authTokenUCMock := grpchandlmocks.NewMockGetUserIDByTokenUsecase(ctrl)
+
+			authTokenUCMock.EXPECT().Execute(gomock.Any(), tt.authInput).
+				Return(tt.authOutput, tt.authErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			grpcHandl := NewGRPCHandler(log, nil, authTokenUCMock, nil, nil)
+			res, err := grpcHandl.GetIdByToken(context.Background(), tt.handlReq)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOutput, res)
+		})
+	}
+}
+
+func TestGRPCHandler_GetUser(t *testing.T) {
+	user := userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "somePass", "gmail@gmail.com")
+
+	tests := []struct {
+		testName string
+
+		handlReq *userservicev1.GetUserRequest
+
+		getUserInput  *getusermodel.GetUserInput
+		getUserOutput *getusermodel.GetUserOutput
+		getUserErr    error
+
+		expOutput *userservicev1.GetUserResponse
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			handlReq: &userservicev1.GetUserRequest{
+				UserId: 1,
+			},
+
+			getUserInput:  getusermodel.NewGetUserInput(1),
+			getUserOutput: getusermodel.NewGetUserOutput(user),
+			getUserErr:    nil,
+
+			expOutput: &userservicev1.GetUserResponse{
+				User: &userservicev1.User{
+					Id:         1,
+					FirstName:  "Ivan",
+					MiddleName: "Ivanovich",
+					LastName:   "Ivanov",
+					Email:      "gmail@gmail.com",
+				},
+			},
+			expErr: nil,
+		}, {
+			testName: "Invalid user id",
+
+			handlReq: &userservicev1.GetUserRequest{
+				UserId: 0,
+			},
+
+			getUserInput:  getusermodel.NewGetUserInput(0),
+			getUserOutput: nil,
+			getUserErr:    getusererr.ErrInvalidUserId,
+
+			expOutput: nil,
+			expErr:    status.Error(codes.InvalidArgument, "invalid user id"),
+		}, {
+			testName: "User not found",
+
+			handlReq: &userservicev1.GetUserRequest{
+				UserId: 2,
+			},
+
+			getUserInput:  getusermodel.NewGetUserInput(2),
+			getUserOutput: nil,
+			getUserErr:    getusererr.ErrUserNotFound,
+
+			expOutput: nil,
+			expErr:    status.Error(codes.NotFound, "user not found"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			getUserUCMock := grpchandlmocks.NewMockGetUserUsecase(ctrl)
+
+			getUserUCMock.EXPECT().Execute(gomock.Any(), tt.getUserInput).
+				Return(tt.getUserOutput, tt.getUserErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			grpcHandl := NewGRPCHandler(log, nil, nil, getUserUCMock, nil)
+			res, err := grpcHandl.GetUser(context.Background(), tt.handlReq)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOutput, res)
+		})
+	}
+}
+
+func TestGRPCHandler_BatchGetUsers(t *testing.T) {
+	users := []*userdomain.UserDomain{
+		userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "somePass", "ivan@gmail.com"),
+		userdomain.NewUserDomain(2, "Petr", "", "Petrov", "somePass", "petr@gmail.com"),
+	}
+
+	tests := []struct {
+		testName string
+
+		handlReq *userservicev1.BatchGetUsersRequest
+
+		batchInput  *batchgetmodel.BatchGetUsersInput
+		batchOutput *batchgetmodel.BatchGetUsersOutput
+		batchErr    error
+
+		expOutput *userservicev1.BatchGetUsersResponse
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			handlReq: &userservicev1.BatchGetUsersRequest{
+				UserIds: []uint32{1, 2, 3},
+			},
+
+			batchInput:  batchgetmodel.NewBatchGetUsersInput([]uint32{1, 2, 3}),
+			batchOutput: batchgetmodel.NewBatchGetUsersOutput(users),
+			batchErr:    nil,
+
+			expOutput: &userservicev1.BatchGetUsersResponse{
+				Users: []*userservicev1.User{
+					{Id: 1, FirstName: "Ivan", MiddleName: "Ivanovich", LastName: "Ivanov", Email: "ivan@gmail.com"},
+					{Id: 2, FirstName: "Petr", LastName: "Petrov", Email: "petr@gmail.com"},
+				},
+			},
+			expErr: nil,
+		}, {
+			testName: "Too many ids",
+
+			handlReq: &userservicev1.BatchGetUsersRequest{
+				UserIds: []uint32{1},
+			},
+
+			batchInput:  batchgetmodel.NewBatchGetUsersInput([]uint32{1}),
+			batchOutput: nil,
+			batchErr:    batchgeterr.ErrTooManyIds,
+
+			expOutput: nil,
+			expErr:    status.Error(codes.InvalidArgument, "too many user ids"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			batchGetUCMock := grpchandlmocks.NewMockBatchGetUsersUsecase(ctrl)
+
+			batchGetUCMock.EXPECT().Execute(gomock.Any(), tt.batchInput).
+				Return(tt.batchOutput, tt.batchErr)
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			grpcHandl := NewGRPCHandler(log, nil, nil, nil, batchGetUCMock)
+			res, err := grpcHandl.BatchGetUsers(context.Background(), tt.handlReq)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOutput, res)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/authenticatetoken.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/authenticatetoken.go -destination=mocks/mock_authenticatetoken.go -package=grpchandlmocks
+//
+
+// Package grpchandlmocks is a generated GoMock package.
+package grpchandlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	authtokenmodel "userservice/internal/usecase/models/authenticatetoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetUserIDByTokenUsecase is a mock of GetUserIDByTokenUsecase interface.
+type MockGetUserIDByTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserIDByTokenUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetUserIDByTokenUsecaseMockRecorder is the mock recorder for MockGetUserIDByTokenUsecase.
+type MockGetUserIDByTokenUsecaseMockRecorder struct {
+	mock *MockGetUserIDByTokenUsecase
+}
+
+// NewMockGetUserIDByTokenUsecase creates a new mock instance.
+func NewMockGetUserIDByTokenUsecase(ctrl *gomock.Controller) *MockGetUserIDByTokenUsecase {
+	mock := &MockGetUserIDByTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetUserIDByTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserIDByTokenUsecase) EXPECT() *MockGetUserIDByTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUserIDByTokenUsecase) Execute(ctx context.Context, in *authtokenmodel.AuthTokenInput) (*authtokenmodel.AuthTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*authtokenmodel.AuthTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUserIDByTokenUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUserIDByTokenUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/batchgetusers.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/batchgetusers.go -destination=mocks/mock_batchgetusers.go -package=grpchandlmocks
+//
+
+// Package grpchandlmocks is a generated GoMock package.
+package grpchandlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	batchgetmodel "userservice/internal/usecase/models/batchgetusers"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockBatchGetUsersUsecase is a mock of BatchGetUsersUsecase interface.
+type MockBatchGetUsersUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchGetUsersUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockBatchGetUsersUsecaseMockRecorder is the mock recorder for MockBatchGetUsersUsecase.
+type MockBatchGetUsersUsecaseMockRecorder struct {
+	mock *MockBatchGetUsersUsecase
+}
+
+// NewMockBatchGetUsersUsecase creates a new mock instance.
+func NewMockBatchGetUsersUsecase(ctrl *gomock.Controller) *MockBatchGetUsersUsecase {
+	mock := &MockBatchGetUsersUsecase{ctrl: ctrl}
+	mock.recorder = &MockBatchGetUsersUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchGetUsersUsecase) EXPECT() *MockBatchGetUsersUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockBatchGetUsersUsecase) Execute(ctx context.Context, in *batchgetmodel.BatchGetUsersInput) (*batchgetmodel.BatchGetUsersOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*batchgetmodel.BatchGetUsersOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockBatchGetUsersUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockBatchGetUsersUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/getuser.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/getuser.go -destination=mocks/mock_getuser.go -package=grpchandlmocks
+//
+
+// Package grpchandlmocks is a generated GoMock package.
+package grpchandlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	getusermodel "userservice/internal/usecase/models/getuser"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetUserUsecase is a mock of GetUserUsecase interface.
+type MockGetUserUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetUserUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetUserUsecaseMockRecorder is the mock recorder for MockGetUserUsecase.
+type MockGetUserUsecaseMockRecorder struct {
+	mock *MockGetUserUsecase
+}
+
+// NewMockGetUserUsecase creates a new mock instance.
+func NewMockGetUserUsecase(ctrl *gomock.Controller) *MockGetUserUsecase {
+	mock := &MockGetUserUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetUserUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetUserUsecase) EXPECT() *MockGetUserUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetUserUsecase) Execute(ctx context.Context, in *getusermodel.GetUserInput) (*getusermodel.GetUserOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*getusermodel.GetUserOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetUserUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetUserUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,6 @@
+package apitokendto
+
+type CreateTokenRequest struct {
+	Name          string `json:"name" binding:"required"`
+	ExpiresInDays uint32 `json:"expires_in_days" binding:"omitempty,max=365"`
+}
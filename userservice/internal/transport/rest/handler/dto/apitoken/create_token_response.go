@@ -0,0 +1,12 @@
+package apitokendto
+
+import "time"
+
+type CreateTokenResponse struct {
+	Id        uint32     `json:"id"`
+	Name      string     `json:"name"`
+	Token     string     `json:"token"`
+	Prefix    string     `json:"prefix"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
@@ -0,0 +1,5 @@
+package apitokendto
+
+type RevokeTokenResponse struct {
+	IsRevoked bool `json:"is_revoked"`
+}
@@ -0,0 +1,16 @@
+package apitokendto
+
+import "time"
+
+type TokenResponse struct {
+	Id         uint32     `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	CreatedAt  time.Time  `json:"created_at"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+}
+
+type TokensResponse struct {
+	Tokens []*TokenResponse `json:"tokens"`
+}
@@ -0,0 +1,5 @@
+package jwtdto
+
+type RefreshRequest struct {
+	RefreshToken string `json:"refresh_token" binding:"required"`
+}
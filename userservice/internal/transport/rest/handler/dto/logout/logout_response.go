@@ -0,0 +1,5 @@
+package logoutdto
+
+type LogoutResponse struct {
+	IsLoggedOut bool `json:"is_logged_out" binding:"required"`
+}
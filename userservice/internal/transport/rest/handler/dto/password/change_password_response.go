@@ -0,0 +1,5 @@
+package passworddto
+
+type ChangePasswordResponse struct {
+	IsChanged bool `json:"is_changed"`
+}
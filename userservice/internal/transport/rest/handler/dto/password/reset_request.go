@@ -0,0 +1,10 @@
+package passworddto
+
+type ResetRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetConfirmRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
@@ -0,0 +1,9 @@
+package passworddto
+
+type ResetResponse struct {
+	IsRequested bool `json:"is_requested"`
+}
+
+type ResetConfirmResponse struct {
+	IsReset bool `json:"is_reset"`
+}
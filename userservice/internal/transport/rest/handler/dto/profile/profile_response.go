@@ -0,0 +1,11 @@
+package profiledto
+
+type ProfileResponse struct {
+	Id         uint32 `json:"id"`
+	FirstName  string `json:"first_name"`
+	MiddleName string `json:"middle_name"`
+	LastName   string `json:"last_name"`
+	Email      string `json:"email"`
+
+	EmailVerified bool `json:"email_verified"`
+}
@@ -0,0 +1,8 @@
+package profiledto
+
+type UpdateProfileRequest struct {
+	FirstName  *string `json:"first_name"`
+	MiddleName *string `json:"middle_name"`
+	LastName   *string `json:"last_name"`
+	Email      *string `json:"email" binding:"omitempty,email"`
+}
@@ -0,0 +1,5 @@
+package revokedto
+
+type RevokeResponse struct {
+	IsRevoked bool `json:"is_revoked" binding:"required"`
+}
@@ -0,0 +1,16 @@
+package sessionsdto
+
+import "time"
+
+type SessionResponse struct {
+	Id        string    `json:"id"`
+	UserAgent string    `json:"user_agent"`
+	IP        string    `json:"ip"`
+	CreatedAt time.Time `json:"created_at"`
+	LastSeen  time.Time `json:"last_seen"`
+	Current   bool      `json:"current"`
+}
+
+type SessionsResponse struct {
+	Sessions []*SessionResponse `json:"sessions"`
+}
@@ -0,0 +1,9 @@
+package verifydto
+
+type VerifyRequest struct {
+	Token string `json:"token" binding:"required"`
+}
+
+type ResendRequest struct {
+	Email string `json:"email" binding:"required,email"`
+}
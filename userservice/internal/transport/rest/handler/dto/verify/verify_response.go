@@ -0,0 +1,9 @@
+package verifydto
+
+type VerifyResponse struct {
+	IsVerified bool `json:"is_verified"`
+}
+
+type ResendResponse struct {
+	IsRequested bool `json:"is_requested"`
+}
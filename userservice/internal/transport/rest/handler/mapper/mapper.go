@@ -1,12 +1,44 @@
 package handlmapper
 
 import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"time"
+	userdomain "userservice/internal/domain/user"
+	apitokendto "userservice/internal/transport/rest/handler/dto/apitoken"
+	jwtdto "userservice/internal/transport/rest/handler/dto/jwt"
 	logindto "userservice/internal/transport/rest/handler/dto/login"
+	logoutdto "userservice/internal/transport/rest/handler/dto/logout"
+	passworddto "userservice/internal/transport/rest/handler/dto/password"
+	profiledto "userservice/internal/transport/rest/handler/dto/profile"
 	regdto "userservice/internal/transport/rest/handler/dto/registration"
+	revokedto "userservice/internal/transport/rest/handler/dto/revokesession"
+	sessionsdto "userservice/internal/transport/rest/handler/dto/sessions"
+	verifydto "userservice/internal/transport/rest/handler/dto/verify"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+	changepassmodel "userservice/internal/usecase/models/changepassword"
+	confresetmodel "userservice/internal/usecase/models/confirmreset"
+	createtokenmodel "userservice/internal/usecase/models/createapitoken"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+	jwksmodel "userservice/internal/usecase/models/jwks"
 	logmodel "userservice/internal/usecase/models/login"
+	logoutmodel "userservice/internal/usecase/models/logout"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+	refreshmodel "userservice/internal/usecase/models/refreshtoken"
 	regmodel "userservice/internal/usecase/models/registration"
+	reqresetmodel "userservice/internal/usecase/models/requestreset"
+	resendmodel "userservice/internal/usecase/models/resendverification"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
+	updprofilemodel "userservice/internal/usecase/models/updateprofile"
+	verifymodel "userservice/internal/usecase/models/verifyemail"
 )
 
+const bearerTokenType = "Bearer"
+
 func RegRequestToInput(r *regdto.RegistrationRequest) *regmodel.RegInput {
 	return regmodel.NewRegInput(
 		r.FirstName,
@@ -23,10 +55,12 @@ func RegOutputToResponse(ro *regmodel.RegOutput) *regdto.RegistrationResponse {
 	}
 }
 
-func LogRequestToInput(l *logindto.LoginRequest) *logmodel.LoginInput {
+func LogRequestToInput(l *logindto.LoginRequest, userAgent, ip string) *logmodel.LoginInput {
 	return logmodel.NewLoginInput(
 		l.Email,
 		l.Password,
+		userAgent,
+		ip,
 	)
 }
 
@@ -37,3 +71,206 @@ func LogOutputToResponse(lo *logmodel.LoginOutput) *logindto.LoginResponse {
 		LastName:   lo.LastName,
 	}
 }
+
+func LogoutOutputToResponse(lo *logoutmodel.LogoutOutput) *logoutdto.LogoutResponse {
+	return &logoutdto.LogoutResponse{
+		IsLoggedOut: lo.IsLoggedOut,
+	}
+}
+
+func LogoutAllOutputToResponse(lo *logoutallmodel.LogoutAllOutput) *logoutdto.LogoutResponse {
+	return &logoutdto.LogoutResponse{
+		IsLoggedOut: lo.IsLoggedOut,
+	}
+}
+
+func SessionsOutputToResponse(so *sessionsmodel.SessionsOutput) *sessionsdto.SessionsResponse {
+	sessions := make([]*sessionsdto.SessionResponse, 0, len(so.Sessions))
+	for _, s := range so.Sessions {
+		sessions = append(sessions, &sessionsdto.SessionResponse{
+			Id:        s.Id,
+			UserAgent: s.UserAgent,
+			IP:        s.IP,
+			CreatedAt: s.CreatedAt,
+			LastSeen:  s.LastSeen,
+			Current:   s.Id == so.CurrentId,
+		})
+	}
+
+	return &sessionsdto.SessionsResponse{
+		Sessions: sessions,
+	}
+}
+
+func RevokeOutputToResponse(ro *revokemodel.RevokeOutput) *revokedto.RevokeResponse {
+	return &revokedto.RevokeResponse{
+		IsRevoked: ro.IsRevoked,
+	}
+}
+
+func UpdateProfileRequestToInput(r *profiledto.UpdateProfileRequest, sessionId string) *updprofilemodel.UpdateProfileInput {
+	return updprofilemodel.NewUpdateProfileInput(
+		sessionId,
+		r.FirstName,
+		r.MiddleName,
+		r.LastName,
+		r.Email,
+	)
+}
+
+func UserDomainToProfileResponse(ud *userdomain.UserDomain) *profiledto.ProfileResponse {
+	return &profiledto.ProfileResponse{
+		Id:         ud.Id,
+		FirstName:  ud.FirstName,
+		MiddleName: ud.MiddleName,
+		LastName:   ud.LastName,
+		Email:      ud.Email,
+
+		EmailVerified: ud.EmailVerified,
+	}
+}
+
+func ChangePasswordRequestToInput(r *passworddto.ChangePasswordRequest, sessionId string) *changepassmodel.ChangePasswordInput {
+	return changepassmodel.NewChangePasswordInput(sessionId, r.OldPassword, r.NewPassword)
+}
+
+func ChangePasswordOutputToResponse(co *changepassmodel.ChangePasswordOutput) *passworddto.ChangePasswordResponse {
+	return &passworddto.ChangePasswordResponse{
+		IsChanged: co.IsChanged,
+	}
+}
+
+func ResetRequestToInput(r *passworddto.ResetRequest) *reqresetmodel.RequestResetInput {
+	return reqresetmodel.NewRequestResetInput(r.Email)
+}
+
+func ResetOutputToResponse(ro *reqresetmodel.RequestResetOutput) *passworddto.ResetResponse {
+	return &passworddto.ResetResponse{
+		IsRequested: ro.IsRequested,
+	}
+}
+
+func ResetConfirmRequestToInput(r *passworddto.ResetConfirmRequest) *confresetmodel.ConfirmResetInput {
+	return confresetmodel.NewConfirmResetInput(r.Token, r.NewPassword)
+}
+
+func ResetConfirmOutputToResponse(co *confresetmodel.ConfirmResetOutput) *passworddto.ResetConfirmResponse {
+	return &passworddto.ResetConfirmResponse{
+		IsReset: co.IsReset,
+	}
+}
+
+func VerifyRequestToInput(r *verifydto.VerifyRequest) *verifymodel.VerifyEmailInput {
+	return verifymodel.NewVerifyEmailInput(r.Token)
+}
+
+func VerifyOutputToResponse(vo *verifymodel.VerifyEmailOutput) *verifydto.VerifyResponse {
+	return &verifydto.VerifyResponse{
+		IsVerified: vo.IsVerified,
+	}
+}
+
+func ResendRequestToInput(r *verifydto.ResendRequest) *resendmodel.ResendInput {
+	return resendmodel.NewResendInput(r.Email)
+}
+
+func ResendOutputToResponse(ro *resendmodel.ResendOutput) *verifydto.ResendResponse {
+	return &verifydto.ResendResponse{
+		IsRequested: ro.IsRequested,
+	}
+}
+
+func CreateTokenRequestToInput(r *apitokendto.CreateTokenRequest, sessionId string) *createtokenmodel.CreateTokenInput {
+	return createtokenmodel.NewCreateTokenInput(
+		sessionId,
+		r.Name,
+		time.Duration(r.ExpiresInDays)*24*time.Hour,
+	)
+}
+
+func CreateTokenOutputToResponse(co *createtokenmodel.CreateTokenOutput) *apitokendto.CreateTokenResponse {
+	return &apitokendto.CreateTokenResponse{
+		Id:        co.Token.Id,
+		Name:      co.Token.Name,
+		Token:     co.Secret,
+		Prefix:    co.Token.Prefix,
+		CreatedAt: co.Token.CreatedAt,
+		ExpiresAt: co.Token.ExpiresAt,
+	}
+}
+
+func TokensOutputToResponse(to *tokensmodel.TokensOutput) *apitokendto.TokensResponse {
+	tokens := make([]*apitokendto.TokenResponse, 0, len(to.Tokens))
+	for _, t := range to.Tokens {
+		tokens = append(tokens, &apitokendto.TokenResponse{
+			Id:         t.Id,
+			Name:       t.Name,
+			Prefix:     t.Prefix,
+			CreatedAt:  t.CreatedAt,
+			ExpiresAt:  t.ExpiresAt,
+			LastUsedAt: t.LastUsedAt,
+		})
+	}
+
+	return &apitokendto.TokensResponse{
+		Tokens: tokens,
+	}
+}
+
+func RevokeTokenOutputToResponse(ro *revoketokenmodel.RevokeTokenOutput) *apitokendto.RevokeTokenResponse {
+	return &apitokendto.RevokeTokenResponse{
+		IsRevoked: ro.IsRevoked,
+	}
+}
+
+func IssueTokenOutputToResponse(ito *issuetokenmodel.IssueTokenOutput) *jwtdto.TokenResponse {
+	return &jwtdto.TokenResponse{
+		AccessToken:  ito.AccessToken,
+		TokenType:    bearerTokenType,
+		ExpiresAt:    ito.ExpiresAt,
+		RefreshToken: ito.RefreshToken,
+	}
+}
+
+func RefreshRequestToInput(r *jwtdto.RefreshRequest) *refreshmodel.RefreshInput {
+	return refreshmodel.NewRefreshInput(r.RefreshToken)
+}
+
+func RefreshOutputToResponse(ro *refreshmodel.RefreshOutput) *jwtdto.TokenResponse {
+	return &jwtdto.TokenResponse{
+		AccessToken:  ro.AccessToken,
+		TokenType:    bearerTokenType,
+		ExpiresAt:    ro.ExpiresAt,
+		RefreshToken: ro.RefreshToken,
+	}
+}
+
+func JWKSOutputToResponse(jo *jwksmodel.JWKSOutput) *jwtdto.JWKSResponse {
+	keys := make([]*jwtdto.JWK, 0, len(jo.Keys))
+	for _, k := range jo.Keys {
+		jwk := &jwtdto.JWK{
+			Kid: k.Kid,
+			Alg: k.Algorithm,
+			Use: "sig",
+		}
+
+		switch pub := k.PublicKey().(type) {
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		default:
+			continue
+		}
+
+		keys = append(keys, jwk)
+	}
+
+	return &jwtdto.JWKSResponse{
+		Keys: keys,
+	}
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/apitokens.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/apitokens.go -destination=mocks/mock_apitokens.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetApiTokensUsecase is a mock of GetApiTokensUsecase interface.
+type MockGetApiTokensUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetApiTokensUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetApiTokensUsecaseMockRecorder is the mock recorder for MockGetApiTokensUsecase.
+type MockGetApiTokensUsecaseMockRecorder struct {
+	mock *MockGetApiTokensUsecase
+}
+
+// NewMockGetApiTokensUsecase creates a new mock instance.
+func NewMockGetApiTokensUsecase(ctrl *gomock.Controller) *MockGetApiTokensUsecase {
+	mock := &MockGetApiTokensUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetApiTokensUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetApiTokensUsecase) EXPECT() *MockGetApiTokensUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetApiTokensUsecase) Execute(ctx context.Context, in *tokensmodel.TokensInput) (*tokensmodel.TokensOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*tokensmodel.TokensOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetApiTokensUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetApiTokensUsecase)(nil).Execute), ctx, in)
+}
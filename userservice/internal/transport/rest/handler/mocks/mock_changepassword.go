@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/changepassword.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/changepassword.go -destination=mocks/mock_changepassword.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	changepassmodel "userservice/internal/usecase/models/changepassword"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockChangePasswordUsecase is a mock of ChangePasswordUsecase interface.
+type MockChangePasswordUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockChangePasswordUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockChangePasswordUsecaseMockRecorder is the mock recorder for MockChangePasswordUsecase.
+type MockChangePasswordUsecaseMockRecorder struct {
+	mock *MockChangePasswordUsecase
+}
+
+// NewMockChangePasswordUsecase creates a new mock instance.
+func NewMockChangePasswordUsecase(ctrl *gomock.Controller) *MockChangePasswordUsecase {
+	mock := &MockChangePasswordUsecase{ctrl: ctrl}
+	mock.recorder = &MockChangePasswordUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChangePasswordUsecase) EXPECT() *MockChangePasswordUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockChangePasswordUsecase) Execute(ctx context.Context, in *changepassmodel.ChangePasswordInput) (*changepassmodel.ChangePasswordOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*changepassmodel.ChangePasswordOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockChangePasswordUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockChangePasswordUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/confirmreset.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/confirmreset.go -destination=mocks/mock_confirmreset.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	confresetmodel "userservice/internal/usecase/models/confirmreset"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockConfirmResetUsecase is a mock of ConfirmResetUsecase interface.
+type MockConfirmResetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockConfirmResetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockConfirmResetUsecaseMockRecorder is the mock recorder for MockConfirmResetUsecase.
+type MockConfirmResetUsecaseMockRecorder struct {
+	mock *MockConfirmResetUsecase
+}
+
+// NewMockConfirmResetUsecase creates a new mock instance.
+func NewMockConfirmResetUsecase(ctrl *gomock.Controller) *MockConfirmResetUsecase {
+	mock := &MockConfirmResetUsecase{ctrl: ctrl}
+	mock.recorder = &MockConfirmResetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConfirmResetUsecase) EXPECT() *MockConfirmResetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockConfirmResetUsecase) Execute(ctx context.Context, in *confresetmodel.ConfirmResetInput) (*confresetmodel.ConfirmResetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*confresetmodel.ConfirmResetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockConfirmResetUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockConfirmResetUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/createapitoken.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/createapitoken.go -destination=mocks/mock_createapitoken.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	createtokenmodel "userservice/internal/usecase/models/createapitoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockCreateApiTokenUsecase is a mock of CreateApiTokenUsecase interface.
+type MockCreateApiTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockCreateApiTokenUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockCreateApiTokenUsecaseMockRecorder is the mock recorder for MockCreateApiTokenUsecase.
+type MockCreateApiTokenUsecaseMockRecorder struct {
+	mock *MockCreateApiTokenUsecase
+}
+
+// NewMockCreateApiTokenUsecase creates a new mock instance.
+func NewMockCreateApiTokenUsecase(ctrl *gomock.Controller) *MockCreateApiTokenUsecase {
+	mock := &MockCreateApiTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockCreateApiTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCreateApiTokenUsecase) EXPECT() *MockCreateApiTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockCreateApiTokenUsecase) Execute(ctx context.Context, in *createtokenmodel.CreateTokenInput) (*createtokenmodel.CreateTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*createtokenmodel.CreateTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockCreateApiTokenUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockCreateApiTokenUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/getprofile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/getprofile.go -destination=mocks/mock_getprofile.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	getprofilemodel "userservice/internal/usecase/models/getprofile"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetProfileUsecase is a mock of GetProfileUsecase interface.
+type MockGetProfileUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetProfileUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetProfileUsecaseMockRecorder is the mock recorder for MockGetProfileUsecase.
+type MockGetProfileUsecaseMockRecorder struct {
+	mock *MockGetProfileUsecase
+}
+
+// NewMockGetProfileUsecase creates a new mock instance.
+func NewMockGetProfileUsecase(ctrl *gomock.Controller) *MockGetProfileUsecase {
+	mock := &MockGetProfileUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetProfileUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetProfileUsecase) EXPECT() *MockGetProfileUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetProfileUsecase) Execute(ctx context.Context, in *getprofilemodel.GetProfileInput) (*getprofilemodel.GetProfileOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*getprofilemodel.GetProfileOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetProfileUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetProfileUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/interfaces/issuetoken.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/interfaces/issuetoken.go -destination=internal/transport/rest/handler/mocks/mock_issuetoken.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIssueTokenUsecase is a mock of IssueTokenUsecase interface.
+type MockIssueTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssueTokenUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockIssueTokenUsecaseMockRecorder is the mock recorder for MockIssueTokenUsecase.
+type MockIssueTokenUsecaseMockRecorder struct {
+	mock *MockIssueTokenUsecase
+}
+
+// NewMockIssueTokenUsecase creates a new mock instance.
+func NewMockIssueTokenUsecase(ctrl *gomock.Controller) *MockIssueTokenUsecase {
+	mock := &MockIssueTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockIssueTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssueTokenUsecase) EXPECT() *MockIssueTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockIssueTokenUsecase) Execute(ctx context.Context, in *issuetokenmodel.IssueTokenInput) (*issuetokenmodel.IssueTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*issuetokenmodel.IssueTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockIssueTokenUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockIssueTokenUsecase)(nil).Execute), ctx, in)
+}
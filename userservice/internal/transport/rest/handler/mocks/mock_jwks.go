@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/interfaces/jwks.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/interfaces/jwks.go -destination=internal/transport/rest/handler/mocks/mock_jwks.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	jwksmodel "userservice/internal/usecase/models/jwks"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetJWKSUsecase is a mock of GetJWKSUsecase interface.
+type MockGetJWKSUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetJWKSUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetJWKSUsecaseMockRecorder is the mock recorder for MockGetJWKSUsecase.
+type MockGetJWKSUsecaseMockRecorder struct {
+	mock *MockGetJWKSUsecase
+}
+
+// NewMockGetJWKSUsecase creates a new mock instance.
+func NewMockGetJWKSUsecase(ctrl *gomock.Controller) *MockGetJWKSUsecase {
+	mock := &MockGetJWKSUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetJWKSUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetJWKSUsecase) EXPECT() *MockGetJWKSUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetJWKSUsecase) Execute(ctx context.Context) (*jwksmodel.JWKSOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx)
+	ret0, _ := ret[0].(*jwksmodel.JWKSOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetJWKSUsecaseMockRecorder) Execute(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetJWKSUsecase)(nil).Execute), ctx)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/logout.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/logout.go -destination=mocks/mock_logout.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	logoutmodel "userservice/internal/usecase/models/logout"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLogoutUserUsecase is a mock of LogoutUserUsecase interface.
+type MockLogoutUserUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutUserUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLogoutUserUsecaseMockRecorder is the mock recorder for MockLogoutUserUsecase.
+type MockLogoutUserUsecaseMockRecorder struct {
+	mock *MockLogoutUserUsecase
+}
+
+// NewMockLogoutUserUsecase creates a new mock instance.
+func NewMockLogoutUserUsecase(ctrl *gomock.Controller) *MockLogoutUserUsecase {
+	mock := &MockLogoutUserUsecase{ctrl: ctrl}
+	mock.recorder = &MockLogoutUserUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutUserUsecase) EXPECT() *MockLogoutUserUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLogoutUserUsecase) Execute(ctx context.Context, in *logoutmodel.LogoutInput) (*logoutmodel.LogoutOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*logoutmodel.LogoutOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLogoutUserUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLogoutUserUsecase)(nil).Execute), ctx, in)
+}
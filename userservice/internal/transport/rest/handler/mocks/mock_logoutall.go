@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/logoutall.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/logoutall.go -destination=mocks/mock_logoutall.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockLogoutAllUsecase is a mock of LogoutAllUsecase interface.
+type MockLogoutAllUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockLogoutAllUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockLogoutAllUsecaseMockRecorder is the mock recorder for MockLogoutAllUsecase.
+type MockLogoutAllUsecaseMockRecorder struct {
+	mock *MockLogoutAllUsecase
+}
+
+// NewMockLogoutAllUsecase creates a new mock instance.
+func NewMockLogoutAllUsecase(ctrl *gomock.Controller) *MockLogoutAllUsecase {
+	mock := &MockLogoutAllUsecase{ctrl: ctrl}
+	mock.recorder = &MockLogoutAllUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLogoutAllUsecase) EXPECT() *MockLogoutAllUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockLogoutAllUsecase) Execute(ctx context.Context, in *logoutallmodel.LogoutAllInput) (*logoutallmodel.LogoutAllOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*logoutallmodel.LogoutAllOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockLogoutAllUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockLogoutAllUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./internal/usecase/interfaces/refreshtoken.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./internal/usecase/interfaces/refreshtoken.go -destination=internal/transport/rest/handler/mocks/mock_refreshtoken.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	refreshmodel "userservice/internal/usecase/models/refreshtoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenUsecase is a mock of RefreshTokenUsecase interface.
+type MockRefreshTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenUsecaseMockRecorder is the mock recorder for MockRefreshTokenUsecase.
+type MockRefreshTokenUsecaseMockRecorder struct {
+	mock *MockRefreshTokenUsecase
+}
+
+// NewMockRefreshTokenUsecase creates a new mock instance.
+func NewMockRefreshTokenUsecase(ctrl *gomock.Controller) *MockRefreshTokenUsecase {
+	mock := &MockRefreshTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenUsecase) EXPECT() *MockRefreshTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRefreshTokenUsecase) Execute(ctx context.Context, in *refreshmodel.RefreshInput) (*refreshmodel.RefreshOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*refreshmodel.RefreshOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRefreshTokenUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRefreshTokenUsecase)(nil).Execute), ctx, in)
+}
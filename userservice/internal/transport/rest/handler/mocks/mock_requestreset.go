@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/requestreset.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/requestreset.go -destination=mocks/mock_requestreset.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	reqresetmodel "userservice/internal/usecase/models/requestreset"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRequestResetUsecase is a mock of RequestResetUsecase interface.
+type MockRequestResetUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRequestResetUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRequestResetUsecaseMockRecorder is the mock recorder for MockRequestResetUsecase.
+type MockRequestResetUsecaseMockRecorder struct {
+	mock *MockRequestResetUsecase
+}
+
+// NewMockRequestResetUsecase creates a new mock instance.
+func NewMockRequestResetUsecase(ctrl *gomock.Controller) *MockRequestResetUsecase {
+	mock := &MockRequestResetUsecase{ctrl: ctrl}
+	mock.recorder = &MockRequestResetUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRequestResetUsecase) EXPECT() *MockRequestResetUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRequestResetUsecase) Execute(ctx context.Context, in *reqresetmodel.RequestResetInput) (*reqresetmodel.RequestResetOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*reqresetmodel.RequestResetOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRequestResetUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRequestResetUsecase)(nil).Execute), ctx, in)
+}
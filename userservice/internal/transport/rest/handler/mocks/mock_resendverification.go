@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/resendverification.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/resendverification.go -destination=mocks/mock_resendverification.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	resendmodel "userservice/internal/usecase/models/resendverification"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockResendVerificationUsecase is a mock of ResendVerificationUsecase interface.
+type MockResendVerificationUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockResendVerificationUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockResendVerificationUsecaseMockRecorder is the mock recorder for MockResendVerificationUsecase.
+type MockResendVerificationUsecaseMockRecorder struct {
+	mock *MockResendVerificationUsecase
+}
+
+// NewMockResendVerificationUsecase creates a new mock instance.
+func NewMockResendVerificationUsecase(ctrl *gomock.Controller) *MockResendVerificationUsecase {
+	mock := &MockResendVerificationUsecase{ctrl: ctrl}
+	mock.recorder = &MockResendVerificationUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockResendVerificationUsecase) EXPECT() *MockResendVerificationUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockResendVerificationUsecase) Execute(ctx context.Context, in *resendmodel.ResendInput) (*resendmodel.ResendOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*resendmodel.ResendOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockResendVerificationUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockResendVerificationUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/revokeapitoken.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/revokeapitoken.go -destination=mocks/mock_revokeapitoken.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevokeApiTokenUsecase is a mock of RevokeApiTokenUsecase interface.
+type MockRevokeApiTokenUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeApiTokenUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeApiTokenUsecaseMockRecorder is the mock recorder for MockRevokeApiTokenUsecase.
+type MockRevokeApiTokenUsecaseMockRecorder struct {
+	mock *MockRevokeApiTokenUsecase
+}
+
+// NewMockRevokeApiTokenUsecase creates a new mock instance.
+func NewMockRevokeApiTokenUsecase(ctrl *gomock.Controller) *MockRevokeApiTokenUsecase {
+	mock := &MockRevokeApiTokenUsecase{ctrl: ctrl}
+	mock.recorder = &MockRevokeApiTokenUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeApiTokenUsecase) EXPECT() *MockRevokeApiTokenUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeApiTokenUsecase) Execute(ctx context.Context, in *revoketokenmodel.RevokeTokenInput) (*revoketokenmodel.RevokeTokenOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*revoketokenmodel.RevokeTokenOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeApiTokenUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeApiTokenUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/revokesession.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/revokesession.go -destination=mocks/mock_revokesession.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRevokeSessionUsecase is a mock of RevokeSessionUsecase interface.
+type MockRevokeSessionUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockRevokeSessionUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockRevokeSessionUsecaseMockRecorder is the mock recorder for MockRevokeSessionUsecase.
+type MockRevokeSessionUsecaseMockRecorder struct {
+	mock *MockRevokeSessionUsecase
+}
+
+// NewMockRevokeSessionUsecase creates a new mock instance.
+func NewMockRevokeSessionUsecase(ctrl *gomock.Controller) *MockRevokeSessionUsecase {
+	mock := &MockRevokeSessionUsecase{ctrl: ctrl}
+	mock.recorder = &MockRevokeSessionUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRevokeSessionUsecase) EXPECT() *MockRevokeSessionUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockRevokeSessionUsecase) Execute(ctx context.Context, in *revokemodel.RevokeInput) (*revokemodel.RevokeOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*revokemodel.RevokeOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockRevokeSessionUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockRevokeSessionUsecase)(nil).Execute), ctx, in)
+}
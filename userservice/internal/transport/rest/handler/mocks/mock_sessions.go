@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/sessions.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/sessions.go -destination=mocks/mock_sessions.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGetSessionsUsecase is a mock of GetSessionsUsecase interface.
+type MockGetSessionsUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockGetSessionsUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockGetSessionsUsecaseMockRecorder is the mock recorder for MockGetSessionsUsecase.
+type MockGetSessionsUsecaseMockRecorder struct {
+	mock *MockGetSessionsUsecase
+}
+
+// NewMockGetSessionsUsecase creates a new mock instance.
+func NewMockGetSessionsUsecase(ctrl *gomock.Controller) *MockGetSessionsUsecase {
+	mock := &MockGetSessionsUsecase{ctrl: ctrl}
+	mock.recorder = &MockGetSessionsUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGetSessionsUsecase) EXPECT() *MockGetSessionsUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockGetSessionsUsecase) Execute(ctx context.Context, in *sessionsmodel.SessionsInput) (*sessionsmodel.SessionsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*sessionsmodel.SessionsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockGetSessionsUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockGetSessionsUsecase)(nil).Execute), ctx, in)
+}
@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/updateprofile.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/updateprofile.go -destination=mocks/mock_updateprofile.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	updprofilemodel "userservice/internal/usecase/models/updateprofile"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUpdateProfileUsecase is a mock of UpdateProfileUsecase interface.
+type MockUpdateProfileUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUpdateProfileUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUpdateProfileUsecaseMockRecorder is the mock recorder for MockUpdateProfileUsecase.
+type MockUpdateProfileUsecaseMockRecorder struct {
+	mock *MockUpdateProfileUsecase
+}
+
+// NewMockUpdateProfileUsecase creates a new mock instance.
+func NewMockUpdateProfileUsecase(ctrl *gomock.Controller) *MockUpdateProfileUsecase {
+	mock := &MockUpdateProfileUsecase{ctrl: ctrl}
+	mock.recorder = &MockUpdateProfileUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUpdateProfileUsecase) EXPECT() *MockUpdateProfileUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockUpdateProfileUsecase) Execute(ctx context.Context, in *updprofilemodel.UpdateProfileInput) (*updprofilemodel.UpdateProfileOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*updprofilemodel.UpdateProfileOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockUpdateProfileUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockUpdateProfileUsecase)(nil).Execute), ctx, in)
+}
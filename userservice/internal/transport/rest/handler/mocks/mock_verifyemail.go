@@ -0,0 +1,57 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../usecase/interfaces/verifyemail.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../usecase/interfaces/verifyemail.go -destination=mocks/mock_verifyemail.go -package=handlmocks
+//
+
+// Package handlmocks is a generated GoMock package.
+package handlmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	verifymodel "userservice/internal/usecase/models/verifyemail"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockVerifyEmailUsecase is a mock of VerifyEmailUsecase interface.
+type MockVerifyEmailUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockVerifyEmailUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockVerifyEmailUsecaseMockRecorder is the mock recorder for MockVerifyEmailUsecase.
+type MockVerifyEmailUsecaseMockRecorder struct {
+	mock *MockVerifyEmailUsecase
+}
+
+// NewMockVerifyEmailUsecase creates a new mock instance.
+func NewMockVerifyEmailUsecase(ctrl *gomock.Controller) *MockVerifyEmailUsecase {
+	mock := &MockVerifyEmailUsecase{ctrl: ctrl}
+	mock.recorder = &MockVerifyEmailUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVerifyEmailUsecase) EXPECT() *MockVerifyEmailUsecaseMockRecorder {
+	return m.recorder
+}
+
+// Execute mocks base method.
+func (m *MockVerifyEmailUsecase) Execute(ctx context.Context, in *verifymodel.VerifyEmailInput) (*verifymodel.VerifyEmailOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Execute", ctx, in)
+	ret0, _ := ret[0].(*verifymodel.VerifyEmailOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Execute indicates an expected call of Execute.
+func (mr *MockVerifyEmailUsecaseMockRecorder) Execute(ctx, in any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Execute", reflect.TypeOf((*MockVerifyEmailUsecase)(nil).Execute), ctx, in)
+}
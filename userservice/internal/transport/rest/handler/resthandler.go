@@ -3,33 +3,124 @@ package resthandler
 import (
 	"errors"
 	"log/slog"
+	"math"
 	"net/http"
+	"strconv"
 	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	userdomain "userservice/internal/domain/user"
+	apitokendto "userservice/internal/transport/rest/handler/dto/apitoken"
+	jwtdto "userservice/internal/transport/rest/handler/dto/jwt"
 	logindto "userservice/internal/transport/rest/handler/dto/login"
+	passworddto "userservice/internal/transport/rest/handler/dto/password"
+	profiledto "userservice/internal/transport/rest/handler/dto/profile"
 	regdto "userservice/internal/transport/rest/handler/dto/registration"
+	verifydto "userservice/internal/transport/rest/handler/dto/verify"
 	handlmapper "userservice/internal/transport/rest/handler/mapper"
 	handlvalidator "userservice/internal/transport/rest/handler/validator"
+	tokenserr "userservice/internal/usecase/errors/apitokens"
+	changepasserr "userservice/internal/usecase/errors/changepassword"
+	confreseterr "userservice/internal/usecase/errors/confirmreset"
+	createtokenerr "userservice/internal/usecase/errors/createapitoken"
+	getprofileerr "userservice/internal/usecase/errors/getprofile"
+	issuetokenerr "userservice/internal/usecase/errors/issuetoken"
 	logerr "userservice/internal/usecase/errors/login"
+	logouterr "userservice/internal/usecase/errors/logout"
+	logoutallerr "userservice/internal/usecase/errors/logoutall"
+	refresherr "userservice/internal/usecase/errors/refreshtoken"
 	regerr "userservice/internal/usecase/errors/registration"
+	revoketokenerr "userservice/internal/usecase/errors/revokeapitoken"
+	revokeerr "userservice/internal/usecase/errors/revokesession"
+	sessionserr "userservice/internal/usecase/errors/sessions"
+	updprofileerr "userservice/internal/usecase/errors/updateprofile"
+	verifyerr "userservice/internal/usecase/errors/verifyemail"
 	"userservice/internal/usecase/interfaces"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+	getprofilemodel "userservice/internal/usecase/models/getprofile"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+	logoutmodel "userservice/internal/usecase/models/logout"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
 
 	"github.com/gin-gonic/gin"
 )
 
+var (
+	sessionCookie = "sessionId"
+)
+
 type RestHandler struct {
 	log       *slog.Logger
 	cookieTTL time.Duration
 
-	regUC interfaces.RegisterUserUsecase
-	logUC interfaces.LoginUserUsecase
+	regUC        interfaces.RegisterUserUsecase
+	logUC        interfaces.LoginUserUsecase
+	logoutUC     interfaces.LogoutUserUsecase
+	logoutAllUC  interfaces.LogoutAllUsecase
+	sessionsUC   interfaces.GetSessionsUsecase
+	revokeUC     interfaces.RevokeSessionUsecase
+	profileUC    interfaces.GetProfileUsecase
+	updateUC     interfaces.UpdateProfileUsecase
+	changePassUC interfaces.ChangePasswordUsecase
+	reqResetUC   interfaces.RequestResetUsecase
+	confResetUC  interfaces.ConfirmResetUsecase
+	verifyUC     interfaces.VerifyEmailUsecase
+	resendUC     interfaces.ResendVerificationUsecase
+	createTokUC  interfaces.CreateApiTokenUsecase
+	tokensUC     interfaces.GetApiTokensUsecase
+	revokeTokUC  interfaces.RevokeApiTokenUsecase
+	issueTokUC   interfaces.IssueTokenUsecase
+	refreshUC    interfaces.RefreshTokenUsecase
+	jwksUC       interfaces.GetJWKSUsecase
 }
 
-func NewRestHandler(log *slog.Logger, cookieTTL time.Duration, regUC interfaces.RegisterUserUsecase, logUC interfaces.LoginUserUsecase) *RestHandler {
+func NewRestHandler(
+	log *slog.Logger,
+	cookieTTL time.Duration,
+	regUC interfaces.RegisterUserUsecase,
+	logUC interfaces.LoginUserUsecase,
+	logoutUC interfaces.LogoutUserUsecase,
+	logoutAllUC interfaces.LogoutAllUsecase,
+	sessionsUC interfaces.GetSessionsUsecase,
+	revokeUC interfaces.RevokeSessionUsecase,
+	profileUC interfaces.GetProfileUsecase,
+	updateUC interfaces.UpdateProfileUsecase,
+	changePassUC interfaces.ChangePasswordUsecase,
+	reqResetUC interfaces.RequestResetUsecase,
+	confResetUC interfaces.ConfirmResetUsecase,
+	verifyUC interfaces.VerifyEmailUsecase,
+	resendUC interfaces.ResendVerificationUsecase,
+	createTokUC interfaces.CreateApiTokenUsecase,
+	tokensUC interfaces.GetApiTokensUsecase,
+	revokeTokUC interfaces.RevokeApiTokenUsecase,
+	issueTokUC interfaces.IssueTokenUsecase,
+	refreshUC interfaces.RefreshTokenUsecase,
+	jwksUC interfaces.GetJWKSUsecase,
+) *RestHandler {
 	return &RestHandler{
-		log:       log,
-		cookieTTL: cookieTTL,
-		regUC:     regUC,
-		logUC:     logUC,
+		log:          log,
+		cookieTTL:    cookieTTL,
+		regUC:        regUC,
+		logUC:        logUC,
+		logoutUC:     logoutUC,
+		logoutAllUC:  logoutAllUC,
+		sessionsUC:   sessionsUC,
+		revokeUC:     revokeUC,
+		profileUC:    profileUC,
+		updateUC:     updateUC,
+		changePassUC: changePassUC,
+		reqResetUC:   reqResetUC,
+		confResetUC:  confResetUC,
+		verifyUC:     verifyUC,
+		resendUC:     resendUC,
+		createTokUC:  createTokUC,
+		tokensUC:     tokensUC,
+		revokeTokUC:  revokeTokUC,
+		issueTokUC:   issueTokUC,
+		refreshUC:    refreshUC,
+		jwksUC:       jwksUC,
 	}
 }
 
@@ -37,12 +128,12 @@ func (h *RestHandler) Registration(ctx *gin.Context) {
 	const op = "resthandler.Registration"
 	log := h.log.With(slog.String("op", op))
 
-	log.Info("start registration request")
+	log.InfoContext(ctx, "start registration request")
 
 	var regRequest regdto.RegistrationRequest
 
 	if err := ctx.ShouldBindJSON(&regRequest); err != nil {
-		log.Warn("error with request data", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
 		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"errors": errMap,
@@ -59,18 +150,23 @@ func (h *RestHandler) Registration(ctx *gin.Context) {
 
 	if ro, err := h.regUC.Execute(ctx.Request.Context(), in); err != nil {
 		if errors.Is(err, regerr.ErrUserAlreadyExists) {
-			log.Info("user already exists")
+			log.InfoContext(ctx, "user already exists")
 			ctx.JSON(http.StatusConflict, gin.H{
 				"error": err.Error(),
 			})
+		} else if isInvalidUserData(err) {
+			log.InfoContext(ctx, "invalid user data", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
 		} else {
-			log.Warn("an error occurred while executing the request", slog.String("error", err.Error()))
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
 			ctx.JSON(http.StatusInternalServerError, gin.H{
 				"error": "internal server error",
 			})
 		}
 	} else {
-		log.Info("registration request completed successfully")
+		log.InfoContext(ctx, "registration request completed successfully")
 		rr := handlmapper.RegOutputToResponse(ro)
 		ctx.JSON(http.StatusOK, rr)
 	}
@@ -80,12 +176,12 @@ func (h *RestHandler) Login(ctx *gin.Context) {
 	const op = "resthandler.Login"
 	log := h.log.With(slog.String("op", op))
 
-	log.Info("start login request")
+	log.InfoContext(ctx, "start login request")
 
 	var logRequest logindto.LoginRequest
 
 	if err := ctx.ShouldBindJSON(&logRequest); err != nil {
-		log.Warn("error with request data", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
 		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
 			ctx.JSON(http.StatusBadRequest, gin.H{
 				"errors": errMap,
@@ -98,33 +194,802 @@ func (h *RestHandler) Login(ctx *gin.Context) {
 		return
 	}
 
-	in := handlmapper.LogRequestToInput(&logRequest)
+	in := handlmapper.LogRequestToInput(&logRequest, ctx.Request.UserAgent(), ctx.ClientIP())
 
 	if lo, err := h.logUC.Execute(ctx.Request.Context(), in); err != nil {
 		if err != nil {
-			if errors.Is(err, logerr.ErrUserNotFound) {
-				log.Info("user not found")
-				ctx.JSON(http.StatusNotFound, gin.H{
+			if errors.Is(err, logerr.ErrInvalidCredentials) {
+				log.InfoContext(ctx, "invalid credentials")
+				ctx.JSON(http.StatusUnauthorized, gin.H{
 					"error": err.Error(),
 				})
-			} else if errors.Is(err, logerr.ErrWrongPassword) {
-				log.Info("wrong password")
-				ctx.JSON(http.StatusUnauthorized, gin.H{
+			} else if errors.Is(err, logerr.ErrTooManyAttempts) {
+				log.InfoContext(ctx, "too many login attempts")
+				ctx.Header("Retry-After", strconv.Itoa(int(math.Ceil(lo.RetryAfter.Seconds()))))
+				ctx.JSON(http.StatusTooManyRequests, gin.H{
+					"error": err.Error(),
+				})
+			} else if errors.Is(err, logerr.ErrEmailNotVerified) {
+				log.InfoContext(ctx, "email not verified")
+				ctx.JSON(http.StatusForbidden, gin.H{
 					"error": err.Error(),
 				})
 			} else {
-				log.Warn("an error occurred while executing the request", slog.String("error", err.Error()))
+				log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
 				ctx.JSON(http.StatusInternalServerError, gin.H{
 					"error": "internal server error",
 				})
 			}
 		}
 	} else {
-		log.Info("login request completed successfully")
-		ctx.SetCookie("sessionId", lo.SessionId, int(h.cookieTTL.Seconds()), "/", "", false, true)
+		log.InfoContext(ctx, "login request completed successfully")
+		ctx.SetCookie(sessionCookie, lo.SessionId, int(h.cookieTTL.Seconds()), "/", "", false, true)
 		lr := handlmapper.LogOutputToResponse(lo)
 		ctx.JSON(http.StatusOK, gin.H{
 			"user": lr,
 		})
 	}
 }
+
+func (h *RestHandler) Logout(ctx *gin.Context) {
+	const op = "resthandler.Logout"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start logout request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := logoutmodel.NewLogoutInput(sessionId)
+
+	if lo, err := h.logoutUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, logouterr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			h.clearSessionCookie(ctx)
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "logout request completed successfully")
+		h.clearSessionCookie(ctx)
+		lr := handlmapper.LogoutOutputToResponse(lo)
+		ctx.JSON(http.StatusOK, lr)
+	}
+}
+
+func (h *RestHandler) LogoutAll(ctx *gin.Context) {
+	const op = "resthandler.LogoutAll"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start logout all request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := logoutallmodel.NewLogoutAllInput(sessionId)
+
+	if lo, err := h.logoutAllUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, logoutallerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			h.clearSessionCookie(ctx)
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "logout all request completed successfully")
+		h.clearSessionCookie(ctx)
+		lr := handlmapper.LogoutAllOutputToResponse(lo)
+		ctx.JSON(http.StatusOK, lr)
+	}
+}
+
+func (h *RestHandler) GetSessions(ctx *gin.Context) {
+	const op = "resthandler.GetSessions"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start get sessions request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := sessionsmodel.NewSessionsInput(sessionId)
+
+	if so, err := h.sessionsUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, sessionserr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "get sessions request completed successfully")
+		sr := handlmapper.SessionsOutputToResponse(so)
+		ctx.JSON(http.StatusOK, sr)
+	}
+}
+
+func (h *RestHandler) RevokeSession(ctx *gin.Context) {
+	const op = "resthandler.RevokeSession"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start revoke session request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := revokemodel.NewRevokeInput(sessionId, ctx.Param("session_id"))
+
+	if ro, err := h.revokeUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, revokeerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, revokeerr.ErrTargetSessionNotFound) {
+			log.InfoContext(ctx, "target session not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, revokeerr.ErrInvalidTargetId) {
+			log.InfoContext(ctx, "invalid target session id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "revoke session request completed successfully")
+		if ro.IsCurrent {
+			h.clearSessionCookie(ctx)
+		}
+		rr := handlmapper.RevokeOutputToResponse(ro)
+		ctx.JSON(http.StatusOK, rr)
+	}
+}
+
+func (h *RestHandler) GetProfile(ctx *gin.Context) {
+	const op = "resthandler.GetProfile"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start get profile request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := getprofilemodel.NewGetProfileInput(sessionId)
+
+	if po, err := h.profileUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, getprofileerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, getprofileerr.ErrUserNotFound) {
+			log.InfoContext(ctx, "user not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "get profile request completed successfully")
+		pr := handlmapper.UserDomainToProfileResponse(po.User)
+		ctx.JSON(http.StatusOK, pr)
+	}
+}
+
+func (h *RestHandler) UpdateProfile(ctx *gin.Context) {
+	const op = "resthandler.UpdateProfile"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start update profile request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	var updRequest profiledto.UpdateProfileRequest
+
+	if err := ctx.ShouldBindJSON(&updRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.UpdateProfileRequestToInput(&updRequest, sessionId)
+
+	if uo, err := h.updateUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, updprofileerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updprofileerr.ErrUserNotFound) {
+			log.InfoContext(ctx, "user not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updprofileerr.ErrEmailAlreadyExists) {
+			log.InfoContext(ctx, "email already in use")
+			ctx.JSON(http.StatusConflict, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, updprofileerr.ErrNothingToUpdate) || isInvalidUserData(err) {
+			log.InfoContext(ctx, "invalid update data", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "update profile request completed successfully")
+		pr := handlmapper.UserDomainToProfileResponse(uo.User)
+		ctx.JSON(http.StatusOK, pr)
+	}
+}
+
+func (h *RestHandler) ChangePassword(ctx *gin.Context) {
+	const op = "resthandler.ChangePassword"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start change password request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	var changeRequest passworddto.ChangePasswordRequest
+
+	if err := ctx.ShouldBindJSON(&changeRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ChangePasswordRequestToInput(&changeRequest, sessionId)
+
+	if co, err := h.changePassUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, changepasserr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changepasserr.ErrWrongPassword) {
+			log.InfoContext(ctx, "wrong password")
+			ctx.JSON(http.StatusForbidden, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changepasserr.ErrSamePassword) {
+			log.InfoContext(ctx, "same password")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, changepasserr.ErrUserNotFound) {
+			log.InfoContext(ctx, "user not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "change password request completed successfully")
+		cr := handlmapper.ChangePasswordOutputToResponse(co)
+		ctx.JSON(http.StatusOK, cr)
+	}
+}
+
+func (h *RestHandler) RequestPasswordReset(ctx *gin.Context) {
+	const op = "resthandler.RequestPasswordReset"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start password reset request")
+
+	var resetRequest passworddto.ResetRequest
+
+	if err := ctx.ShouldBindJSON(&resetRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ResetRequestToInput(&resetRequest)
+
+	if ro, err := h.reqResetUC.Execute(ctx.Request.Context(), in); err != nil {
+		log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+	} else {
+		log.InfoContext(ctx, "password reset request completed successfully")
+		rr := handlmapper.ResetOutputToResponse(ro)
+		ctx.JSON(http.StatusAccepted, rr)
+	}
+}
+
+func (h *RestHandler) ConfirmPasswordReset(ctx *gin.Context) {
+	const op = "resthandler.ConfirmPasswordReset"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start password reset confirm request")
+
+	var confirmRequest passworddto.ResetConfirmRequest
+
+	if err := ctx.ShouldBindJSON(&confirmRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ResetConfirmRequestToInput(&confirmRequest)
+
+	if co, err := h.confResetUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, confreseterr.ErrInvalidToken) {
+			log.InfoContext(ctx, "invalid reset token")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, confreseterr.ErrUserNotFound) {
+			log.InfoContext(ctx, "user not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "password reset confirm request completed successfully")
+		cr := handlmapper.ResetConfirmOutputToResponse(co)
+		ctx.JSON(http.StatusOK, cr)
+	}
+}
+
+func (h *RestHandler) VerifyEmail(ctx *gin.Context) {
+	const op = "resthandler.VerifyEmail"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start verify email request")
+
+	var verifyRequest verifydto.VerifyRequest
+
+	if err := ctx.ShouldBindJSON(&verifyRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.VerifyRequestToInput(&verifyRequest)
+
+	if vo, err := h.verifyUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, verifyerr.ErrInvalidToken) {
+			log.InfoContext(ctx, "invalid verification token")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "verify email request completed successfully")
+		vr := handlmapper.VerifyOutputToResponse(vo)
+		ctx.JSON(http.StatusOK, vr)
+	}
+}
+
+func (h *RestHandler) ResendVerification(ctx *gin.Context) {
+	const op = "resthandler.ResendVerification"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start resend verification request")
+
+	var resendRequest verifydto.ResendRequest
+
+	if err := ctx.ShouldBindJSON(&resendRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.ResendRequestToInput(&resendRequest)
+
+	if ro, err := h.resendUC.Execute(ctx.Request.Context(), in); err != nil {
+		log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+	} else {
+		log.InfoContext(ctx, "resend verification request completed successfully")
+		rr := handlmapper.ResendOutputToResponse(ro)
+		ctx.JSON(http.StatusAccepted, rr)
+	}
+}
+
+func (h *RestHandler) CreateApiToken(ctx *gin.Context) {
+	const op = "resthandler.CreateApiToken"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start create api token request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	var createRequest apitokendto.CreateTokenRequest
+
+	if err := ctx.ShouldBindJSON(&createRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.CreateTokenRequestToInput(&createRequest, sessionId)
+
+	if co, err := h.createTokUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, createtokenerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, createtokenerr.ErrInvalidTTL) || errors.Is(err, apitokendomain.ErrInvalidName) {
+			log.InfoContext(ctx, "invalid api token data", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "create api token request completed successfully")
+		cr := handlmapper.CreateTokenOutputToResponse(co)
+		ctx.JSON(http.StatusCreated, cr)
+	}
+}
+
+func (h *RestHandler) GetApiTokens(ctx *gin.Context) {
+	const op = "resthandler.GetApiTokens"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start get api tokens request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := tokensmodel.NewTokensInput(sessionId)
+
+	if to, err := h.tokensUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, tokenserr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "get api tokens request completed successfully")
+		tr := handlmapper.TokensOutputToResponse(to)
+		ctx.JSON(http.StatusOK, tr)
+	}
+}
+
+func (h *RestHandler) RevokeApiToken(ctx *gin.Context) {
+	const op = "resthandler.RevokeApiToken"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start revoke api token request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	tokenId, err := strconv.ParseUint(ctx.Param("token_id"), 10, 32)
+	if err != nil {
+		log.InfoContext(ctx, "invalid token id", slog.String("error", err.Error()))
+		ctx.JSON(http.StatusBadRequest, gin.H{
+			"error": revoketokenerr.ErrInvalidTokenId.Error(),
+		})
+		return
+	}
+
+	in := revoketokenmodel.NewRevokeTokenInput(sessionId, uint32(tokenId))
+
+	if ro, err := h.revokeTokUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, revoketokenerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, revoketokenerr.ErrTokenNotFound) {
+			log.InfoContext(ctx, "api token not found")
+			ctx.JSON(http.StatusNotFound, gin.H{
+				"error": err.Error(),
+			})
+		} else if errors.Is(err, revoketokenerr.ErrInvalidTokenId) {
+			log.InfoContext(ctx, "invalid token id")
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "revoke api token request completed successfully")
+		rr := handlmapper.RevokeTokenOutputToResponse(ro)
+		ctx.JSON(http.StatusOK, rr)
+	}
+}
+
+func (h *RestHandler) clearSessionCookie(ctx *gin.Context) {
+	ctx.SetCookie(sessionCookie, "", -1, "/", "", false, true)
+}
+
+func (h *RestHandler) IssueToken(ctx *gin.Context) {
+	const op = "resthandler.IssueToken"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start issue token request")
+
+	sessionId, ok := getSessionId(ctx)
+	if !ok {
+		log.InfoContext(ctx, "session cookie not found")
+		ctx.JSON(http.StatusUnauthorized, gin.H{
+			"error": "session not found",
+		})
+		return
+	}
+
+	in := issuetokenmodel.NewIssueTokenInput(sessionId)
+
+	if io, err := h.issueTokUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, issuetokenerr.ErrSessionNotFound) {
+			log.InfoContext(ctx, "session not found")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "issue token request completed successfully")
+		ir := handlmapper.IssueTokenOutputToResponse(io)
+		ctx.JSON(http.StatusOK, ir)
+	}
+}
+
+func (h *RestHandler) RefreshToken(ctx *gin.Context) {
+	const op = "resthandler.RefreshToken"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start refresh token request")
+
+	var refreshRequest jwtdto.RefreshRequest
+
+	if err := ctx.ShouldBindJSON(&refreshRequest); err != nil {
+		log.WarnContext(ctx, "error with request data", slog.String("error", err.Error()))
+		if errMap, ok := handlvalidator.MapValidationErrors(err); ok {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"errors": errMap,
+			})
+		} else {
+			ctx.JSON(http.StatusBadRequest, gin.H{
+				"error": "bad request body",
+			})
+		}
+		return
+	}
+
+	in := handlmapper.RefreshRequestToInput(&refreshRequest)
+
+	if ro, err := h.refreshUC.Execute(ctx.Request.Context(), in); err != nil {
+		if errors.Is(err, refresherr.ErrInvalidRefreshToken) {
+			log.InfoContext(ctx, "invalid refresh token")
+			ctx.JSON(http.StatusUnauthorized, gin.H{
+				"error": err.Error(),
+			})
+		} else {
+			log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+			ctx.JSON(http.StatusInternalServerError, gin.H{
+				"error": "internal server error",
+			})
+		}
+	} else {
+		log.InfoContext(ctx, "refresh token request completed successfully")
+		rr := handlmapper.RefreshOutputToResponse(ro)
+		ctx.JSON(http.StatusOK, rr)
+	}
+}
+
+func (h *RestHandler) GetJWKS(ctx *gin.Context) {
+	const op = "resthandler.GetJWKS"
+	log := h.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "start get jwks request")
+
+	if jo, err := h.jwksUC.Execute(ctx.Request.Context()); err != nil {
+		log.WarnContext(ctx, "an error occurred while executing the request", slog.String("error", err.Error()))
+		ctx.JSON(http.StatusInternalServerError, gin.H{
+			"error": "internal server error",
+		})
+	} else {
+		log.InfoContext(ctx, "get jwks request completed successfully")
+		jr := handlmapper.JWKSOutputToResponse(jo)
+		ctx.JSON(http.StatusOK, jr)
+	}
+}
+
+func getSessionId(ctx *gin.Context) (string, bool) {
+	sessionId, err := ctx.Cookie(sessionCookie)
+	if err != nil || sessionId == "" {
+		return "", false
+	}
+	return sessionId, true
+}
+
+func isInvalidUserData(err error) bool {
+	return errors.Is(err, userdomain.ErrInvalidFirstName) ||
+		errors.Is(err, userdomain.ErrInvalidMiddleName) ||
+		errors.Is(err, userdomain.ErrInvalidLastName) ||
+		errors.Is(err, userdomain.ErrInvalidEmail)
+}
@@ -2,18 +2,57 @@ package resthandler
 
 import (
 	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"errors"
 	"io"
 	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"platform/middleware"
+	"platform/server/rest"
 	"testing"
 	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	sessiondomain "userservice/internal/domain/session"
+	signingkeydomain "userservice/internal/domain/signingkey"
+	userdomain "userservice/internal/domain/user"
 	handlmocks "userservice/internal/transport/rest/handler/mocks"
-	"userservice/internal/transport/rest/middleware"
+	tokenserr "userservice/internal/usecase/errors/apitokens"
+	changepasserr "userservice/internal/usecase/errors/changepassword"
+	confreseterr "userservice/internal/usecase/errors/confirmreset"
+	createtokenerr "userservice/internal/usecase/errors/createapitoken"
+	getprofileerr "userservice/internal/usecase/errors/getprofile"
+	issuetokenerr "userservice/internal/usecase/errors/issuetoken"
 	logerr "userservice/internal/usecase/errors/login"
+	logouterr "userservice/internal/usecase/errors/logout"
+	logoutallerr "userservice/internal/usecase/errors/logoutall"
+	refresherr "userservice/internal/usecase/errors/refreshtoken"
 	regerr "userservice/internal/usecase/errors/registration"
+	revoketokenerr "userservice/internal/usecase/errors/revokeapitoken"
+	revokeerr "userservice/internal/usecase/errors/revokesession"
+	sessionserr "userservice/internal/usecase/errors/sessions"
+	updprofileerr "userservice/internal/usecase/errors/updateprofile"
+	verifyerr "userservice/internal/usecase/errors/verifyemail"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+	changepassmodel "userservice/internal/usecase/models/changepassword"
+	confresetmodel "userservice/internal/usecase/models/confirmreset"
+	createtokenmodel "userservice/internal/usecase/models/createapitoken"
+	getprofilemodel "userservice/internal/usecase/models/getprofile"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+	jwksmodel "userservice/internal/usecase/models/jwks"
 	logmodel "userservice/internal/usecase/models/login"
+	logoutmodel "userservice/internal/usecase/models/logout"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+	refreshmodel "userservice/internal/usecase/models/refreshtoken"
 	regmodel "userservice/internal/usecase/models/registration"
+	reqresetmodel "userservice/internal/usecase/models/requestreset"
+	resendmodel "userservice/internal/usecase/models/resendverification"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
+	updprofilemodel "userservice/internal/usecase/models/updateprofile"
+	verifymodel "userservice/internal/usecase/models/verifyemail"
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
@@ -106,7 +145,7 @@ func TestRestHandler_Registration(t *testing.T) {
 
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-			handl := NewRestHandler(log, tt.cookieTTL, regMock, nil)
+			handl := NewRestHandler(log, tt.cookieTTL, regMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			gin.SetMode(gin.DebugMode)
 			router := gin.New()
@@ -143,6 +182,7 @@ func TestRestHandler_Login(t *testing.T) {
 
 		expBody       []byte
 		expStatusCode int
+		expRetryAfter string
 	}{
 		{
 			testName:  "Success",
@@ -165,35 +205,51 @@ func TestRestHandler_Login(t *testing.T) {
 			expBody:       []byte(`{"user":{"first_name":"Ivan","middle_name":"Ivanovich","last_name":"Ivanov"}}`),
 			expStatusCode: 200,
 		}, {
-			testName:  "User not found",
+			testName:  "Invalid credentials",
 			cookieTTL: time.Duration(3600) * time.Second,
 
 			expectLogin:    true,
 			loginOutReturn: &logmodel.LoginOutput{},
-			loginErrReturn: logerr.ErrUserNotFound,
+			loginErrReturn: logerr.ErrInvalidCredentials,
 
 			reqBody: []byte(`{
 				"email":"gmail@gmail.com",
 				"password":"somePass"
 			}`),
 
-			expBody:       []byte(`{"error":"user not found"}`),
-			expStatusCode: 404,
+			expBody:       []byte(`{"error":"invalid email or password"}`),
+			expStatusCode: 401,
 		}, {
-			testName:  "Wrong password",
+			testName:  "Too many attempts",
+			cookieTTL: time.Duration(3600) * time.Second,
+
+			expectLogin:    true,
+			loginOutReturn: &logmodel.LoginOutput{RetryAfter: 90500 * time.Millisecond},
+			loginErrReturn: logerr.ErrTooManyAttempts,
+
+			reqBody: []byte(`{
+				"email":"gmail@gmail.com",
+				"password":"somePass"
+			}`),
+
+			expBody:       []byte(`{"error":"too many login attempts"}`),
+			expStatusCode: 429,
+			expRetryAfter: "91",
+		}, {
+			testName:  "Email not verified",
 			cookieTTL: time.Duration(3600) * time.Second,
 
 			expectLogin:    true,
 			loginOutReturn: &logmodel.LoginOutput{},
-			loginErrReturn: logerr.ErrWrongPassword,
+			loginErrReturn: logerr.ErrEmailNotVerified,
 
 			reqBody: []byte(`{
 				"email":"gmail@gmail.com",
 				"password":"somePass"
 			}`),
 
-			expBody:       []byte(`{"error":"wrong password"}`),
-			expStatusCode: 401,
+			expBody:       []byte(`{"error":"email not verified"}`),
+			expStatusCode: 403,
 		}, {
 			testName:  "Empty field email",
 			cookieTTL: time.Duration(3600) * time.Second,
@@ -222,7 +278,7 @@ func TestRestHandler_Login(t *testing.T) {
 			}
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-			handl := NewRestHandler(log, tt.cookieTTL, nil, loginUCMock)
+			handl := NewRestHandler(log, tt.cookieTTL, nil, loginUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 			gin.SetMode(gin.DebugMode)
 			router := gin.New()
@@ -241,6 +297,1779 @@ func TestRestHandler_Login(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tt.expStatusCode, resp.StatusCode)
 			require.Equal(t, tt.expBody, body)
+			require.Equal(t, tt.expRetryAfter, resp.Header.Get("Retry-After"))
+		})
+	}
+}
+
+func TestRestHandler_Login_SpoofedForwardedFor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the login lockout is keyed by in.IP, so it must not follow the headers
+	loginUCMock := handlmocks.NewMockLoginUserUsecase(ctrl)
+	loginUCMock.EXPECT().Execute(gomock.Any(), logmodel.NewLoginInput("gmail@gmail.com", "somePass", "", "192.0.2.1")).
+		Return(&logmodel.LoginOutput{}, logerr.ErrInvalidCredentials).
+		Times(2)
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	handl := NewRestHandler(log, time.Hour, nil, loginUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	gin.SetMode(gin.TestMode)
+	router := rest.MustNewRouter(nil)
+	router.POST("/test", handl.Login)
+
+	for _, spoofed := range []string{"203.0.113.1", "203.0.113.2"} {
+		req := httptest.NewRequest(http.MethodPost, "/test", bytes.NewReader([]byte(`{
+			"email":"gmail@gmail.com",
+			"password":"somePass"
+		}`)))
+		req.RemoteAddr = "192.0.2.1:1234"
+		req.Header.Set("X-Forwarded-For", spoofed)
+		req.Header.Set("X-Real-IP", spoofed)
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		require.Equal(t, http.StatusUnauthorized, w.Code)
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/logout.go -destination=mocks/mock_logout.go -package=handlmocks
+func TestRestHandler_Logout(t *testing.T) {
+	tests := []struct {
+		testName  string
+		sessionId string
+
+		expectLogout    bool
+		logoutOutReturn *logoutmodel.LogoutOutput
+		logoutErrReturn error
+
+		expBody         []byte
+		expStatusCode   int
+		expClearCookies bool
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+
+			expectLogout:    true,
+			logoutOutReturn: logoutmodel.NewLogoutOutput(true),
+			logoutErrReturn: nil,
+
+			expBody:         []byte(`{"is_logged_out":true}`),
+			expStatusCode:   200,
+			expClearCookies: true,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+
+			expectLogout:    true,
+			logoutOutReturn: logoutmodel.NewLogoutOutput(false),
+			logoutErrReturn: logouterr.ErrSessionNotFound,
+
+			expBody:         []byte(`{"error":"session not found"}`),
+			expStatusCode:   401,
+			expClearCookies: true,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+
+			expectLogout: false,
+
+			expBody:         []byte(`{"error":"session not found"}`),
+			expStatusCode:   401,
+			expClearCookies: false,
+		}, {
+			testName:  "Internal error",
+			sessionId: "sessionId",
+
+			expectLogout:    true,
+			logoutOutReturn: logoutmodel.NewLogoutOutput(false),
+			logoutErrReturn: errors.New("redis error"),
+
+			expBody:         []byte(`{"error":"internal server error"}`),
+			expStatusCode:   500,
+			expClearCookies: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logoutUCMock := handlmocks.NewMockLogoutUserUsecase(ctrl)
+			if tt.expectLogout {
+				logoutUCMock.EXPECT().Execute(gomock.Any(), logoutmodel.NewLogoutInput(tt.sessionId)).
+					Return(tt.logoutOutReturn, tt.logoutErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, logoutUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.Logout)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodPost, serv.URL+"/test", nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+			require.Equal(t, tt.expClearCookies, hasClearedSessionCookie(resp))
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/logoutall.go -destination=mocks/mock_logoutall.go -package=handlmocks
+func TestRestHandler_LogoutAll(t *testing.T) {
+	tests := []struct {
+		testName  string
+		sessionId string
+
+		expectLogoutAll    bool
+		logoutAllOutReturn *logoutallmodel.LogoutAllOutput
+		logoutAllErrReturn error
+
+		expBody         []byte
+		expStatusCode   int
+		expClearCookies bool
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+
+			expectLogoutAll:    true,
+			logoutAllOutReturn: logoutallmodel.NewLogoutAllOutput(true),
+			logoutAllErrReturn: nil,
+
+			expBody:         []byte(`{"is_logged_out":true}`),
+			expStatusCode:   200,
+			expClearCookies: true,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+
+			expectLogoutAll:    true,
+			logoutAllOutReturn: logoutallmodel.NewLogoutAllOutput(false),
+			logoutAllErrReturn: logoutallerr.ErrSessionNotFound,
+
+			expBody:         []byte(`{"error":"session not found"}`),
+			expStatusCode:   401,
+			expClearCookies: true,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+
+			expectLogoutAll: false,
+
+			expBody:         []byte(`{"error":"session not found"}`),
+			expStatusCode:   401,
+			expClearCookies: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			logoutAllUCMock := handlmocks.NewMockLogoutAllUsecase(ctrl)
+			if tt.expectLogoutAll {
+				logoutAllUCMock.EXPECT().Execute(gomock.Any(), logoutallmodel.NewLogoutAllInput(tt.sessionId)).
+					Return(tt.logoutAllOutReturn, tt.logoutAllErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, logoutAllUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.LogoutAll)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodPost, serv.URL+"/test", nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+			require.Equal(t, tt.expClearCookies, hasClearedSessionCookie(resp))
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/sessions.go -destination=mocks/mock_sessions.go -package=handlmocks
+func TestRestHandler_GetSessions(t *testing.T) {
+	timeNow := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName  string
+		sessionId string
+
+		expectSessions    bool
+		sessionsOutReturn *sessionsmodel.SessionsOutput
+		sessionsErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+
+			expectSessions: true,
+			sessionsOutReturn: sessionsmodel.NewSessionsOutput("1", []*sessiondomain.SessionDomain{
+				sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+				sessiondomain.NewSessionDomain("2", 1, "other", "10.0.0.1", timeNow, timeNow),
+			}),
+			sessionsErrReturn: nil,
+
+			expBody: []byte(`{"sessions":[` +
+				`{"id":"1","user_agent":"agent","ip":"127.0.0.1","created_at":"2025-01-01T12:00:00Z","last_seen":"2025-01-01T12:00:00Z","current":true},` +
+				`{"id":"2","user_agent":"other","ip":"10.0.0.1","created_at":"2025-01-01T12:00:00Z","last_seen":"2025-01-01T12:00:00Z","current":false}]}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+
+			expectSessions:    true,
+			sessionsOutReturn: nil,
+			sessionsErrReturn: sessionserr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+
+			expectSessions: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			sessionsUCMock := handlmocks.NewMockGetSessionsUsecase(ctrl)
+			if tt.expectSessions {
+				sessionsUCMock.EXPECT().Execute(gomock.Any(), sessionsmodel.NewSessionsInput(tt.sessionId)).
+					Return(tt.sessionsOutReturn, tt.sessionsErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, sessionsUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.GET("/test", handl.GetSessions)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodGet, serv.URL+"/test", nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/revokesession.go -destination=mocks/mock_revokesession.go -package=handlmocks
+func TestRestHandler_RevokeSession(t *testing.T) {
+	tests := []struct {
+		testName  string
+		sessionId string
+		targetId  string
+
+		expectRevoke    bool
+		revokeOutReturn *revokemodel.RevokeOutput
+		revokeErrReturn error
+
+		expBody         []byte
+		expStatusCode   int
+		expClearCookies bool
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+			targetId:  "2",
+
+			expectRevoke:    true,
+			revokeOutReturn: revokemodel.NewRevokeOutput(true, false),
+			revokeErrReturn: nil,
+
+			expBody:         []byte(`{"is_revoked":true}`),
+			expStatusCode:   200,
+			expClearCookies: false,
+		}, {
+			testName:  "Revoke current session",
+			sessionId: "sessionId",
+			targetId:  "1",
+
+			expectRevoke:    true,
+			revokeOutReturn: revokemodel.NewRevokeOutput(true, true),
+			revokeErrReturn: nil,
+
+			expBody:         []byte(`{"is_revoked":true}`),
+			expStatusCode:   200,
+			expClearCookies: true,
+		}, {
+			testName:  "Target session not found",
+			sessionId: "sessionId",
+			targetId:  "2",
+
+			expectRevoke:    true,
+			revokeOutReturn: revokemodel.NewRevokeOutput(false, false),
+			revokeErrReturn: revokeerr.ErrTargetSessionNotFound,
+
+			expBody:         []byte(`{"error":"target session not found"}`),
+			expStatusCode:   404,
+			expClearCookies: false,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+			targetId:  "2",
+
+			expectRevoke:    true,
+			revokeOutReturn: revokemodel.NewRevokeOutput(false, false),
+			revokeErrReturn: revokeerr.ErrSessionNotFound,
+
+			expBody:         []byte(`{"error":"session not found"}`),
+			expStatusCode:   401,
+			expClearCookies: false,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+			targetId:  "2",
+
+			expectRevoke: false,
+
+			expBody:         []byte(`{"error":"session not found"}`),
+			expStatusCode:   401,
+			expClearCookies: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revokeUCMock := handlmocks.NewMockRevokeSessionUsecase(ctrl)
+			if tt.expectRevoke {
+				revokeUCMock.EXPECT().Execute(gomock.Any(), revokemodel.NewRevokeInput(tt.sessionId, tt.targetId)).
+					Return(tt.revokeOutReturn, tt.revokeErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, revokeUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.DELETE("/test/:session_id", handl.RevokeSession)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, serv.URL+"/test/"+tt.targetId, nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+			require.Equal(t, tt.expClearCookies, hasClearedSessionCookie(resp))
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/getprofile.go -destination=mocks/mock_getprofile.go -package=handlmocks
+func TestRestHandler_GetProfile(t *testing.T) {
+	tests := []struct {
+		testName  string
+		sessionId string
+
+		expectProfile    bool
+		profileOutReturn *getprofilemodel.GetProfileOutput
+		profileErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+
+			expectProfile: true,
+			profileOutReturn: getprofilemodel.NewGetProfileOutput(&userdomain.UserDomain{
+				Id:         1,
+				FirstName:  "Ivan",
+				MiddleName: "Ivanovich",
+				LastName:   "Ivanov",
+				Email:      "ivan@mail.ru",
+			}),
+			profileErrReturn: nil,
+
+			expBody:       []byte(`{"id":1,"first_name":"Ivan","middle_name":"Ivanovich","last_name":"Ivanov","email":"ivan@mail.ru","email_verified":false}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+
+			expectProfile:    true,
+			profileOutReturn: nil,
+			profileErrReturn: getprofileerr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "User not found",
+			sessionId: "sessionId",
+
+			expectProfile:    true,
+			profileOutReturn: nil,
+			profileErrReturn: getprofileerr.ErrUserNotFound,
+
+			expBody:       []byte(`{"error":"user not found"}`),
+			expStatusCode: 404,
+		}, {
+			testName:  "Internal error",
+			sessionId: "sessionId",
+
+			expectProfile:    true,
+			profileOutReturn: nil,
+			profileErrReturn: errors.New("internal error"),
+
+			expBody:       []byte(`{"error":"internal server error"}`),
+			expStatusCode: 500,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+
+			expectProfile: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			profileUCMock := handlmocks.NewMockGetProfileUsecase(ctrl)
+			if tt.expectProfile {
+				profileUCMock.EXPECT().Execute(gomock.Any(), getprofilemodel.NewGetProfileInput(tt.sessionId)).
+					Return(tt.profileOutReturn, tt.profileErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, profileUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.GET("/test", handl.GetProfile)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodGet, serv.URL+"/test", nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/updateprofile.go -destination=mocks/mock_updateprofile.go -package=handlmocks
+func TestRestHandler_UpdateProfile(t *testing.T) {
+	newName := "Petr"
+	newEmail := "petr@mail.ru"
+
+	tests := []struct {
+		testName  string
+		sessionId string
+		body      []byte
+
+		expectUpdate    bool
+		updateIn        *updprofilemodel.UpdateProfileInput
+		updateOutReturn *updprofilemodel.UpdateProfileOutput
+		updateErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+			body:      []byte(`{"first_name":"Petr","email":"petr@mail.ru"}`),
+
+			expectUpdate: true,
+			updateIn:     updprofilemodel.NewUpdateProfileInput("sessionId", &newName, nil, nil, &newEmail),
+			updateOutReturn: updprofilemodel.NewUpdateProfileOutput(&userdomain.UserDomain{
+				Id:         1,
+				FirstName:  "Petr",
+				MiddleName: "Ivanovich",
+				LastName:   "Ivanov",
+				Email:      "petr@mail.ru",
+			}),
+			updateErrReturn: nil,
+
+			expBody:       []byte(`{"id":1,"first_name":"Petr","middle_name":"Ivanovich","last_name":"Ivanov","email":"petr@mail.ru","email_verified":false}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Invalid email format",
+			sessionId: "sessionId",
+			body:      []byte(`{"email":"petr"}`),
+
+			expectUpdate: false,
+
+			expBody:       []byte(`{"errors":{"Email":"invalid email"}}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Bad request body",
+			sessionId: "sessionId",
+			body:      []byte(`{"first_name":`),
+
+			expectUpdate: false,
+
+			expBody:       []byte(`{"error":"bad request body"}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Nothing to update",
+			sessionId: "sessionId",
+			body:      []byte(`{}`),
+
+			expectUpdate:    true,
+			updateIn:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, nil),
+			updateOutReturn: nil,
+			updateErrReturn: updprofileerr.ErrNothingToUpdate,
+
+			expBody:       []byte(`{"error":"nothing to update"}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Invalid first name",
+			sessionId: "sessionId",
+			body:      []byte(`{"first_name":"Petr"}`),
+
+			expectUpdate:    true,
+			updateIn:        updprofilemodel.NewUpdateProfileInput("sessionId", &newName, nil, nil, nil),
+			updateOutReturn: nil,
+			updateErrReturn: userdomain.ErrInvalidFirstName,
+
+			expBody:       []byte(`{"error":"invalid first name"}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Email already in use",
+			sessionId: "sessionId",
+			body:      []byte(`{"email":"petr@mail.ru"}`),
+
+			expectUpdate:    true,
+			updateIn:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, &newEmail),
+			updateOutReturn: nil,
+			updateErrReturn: updprofileerr.ErrEmailAlreadyExists,
+
+			expBody:       []byte(`{"error":"email already in use"}`),
+			expStatusCode: 409,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+			body:      []byte(`{"first_name":"Petr"}`),
+
+			expectUpdate:    true,
+			updateIn:        updprofilemodel.NewUpdateProfileInput("sessionId", &newName, nil, nil, nil),
+			updateOutReturn: nil,
+			updateErrReturn: updprofileerr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+			body:      []byte(`{"first_name":"Petr"}`),
+
+			expectUpdate: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			updateUCMock := handlmocks.NewMockUpdateProfileUsecase(ctrl)
+			if tt.expectUpdate {
+				updateUCMock.EXPECT().Execute(gomock.Any(), tt.updateIn).
+					Return(tt.updateOutReturn, tt.updateErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, updateUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.PATCH("/test", handl.UpdateProfile)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodPatch, serv.URL+"/test", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/changepassword.go -destination=mocks/mock_changepassword.go -package=handlmocks
+func TestRestHandler_ChangePassword(t *testing.T) {
+	tests := []struct {
+		testName  string
+		sessionId string
+		body      []byte
+
+		expectChange    bool
+		changeOutReturn *changepassmodel.ChangePasswordOutput
+		changeErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+			body:      []byte(`{"old_password":"old","new_password":"new"}`),
+
+			expectChange:    true,
+			changeOutReturn: changepassmodel.NewChangePasswordOutput(true),
+			changeErrReturn: nil,
+
+			expBody:       []byte(`{"is_changed":true}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Missing old password",
+			sessionId: "sessionId",
+			body:      []byte(`{"new_password":"new"}`),
+
+			expectChange: false,
+
+			expBody:       []byte(`{"errors":{"OldPassword":"field is required"}}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Wrong password",
+			sessionId: "sessionId",
+			body:      []byte(`{"old_password":"old","new_password":"new"}`),
+
+			expectChange:    true,
+			changeOutReturn: changepassmodel.NewChangePasswordOutput(false),
+			changeErrReturn: changepasserr.ErrWrongPassword,
+
+			expBody:       []byte(`{"error":"wrong password"}`),
+			expStatusCode: 403,
+		}, {
+			testName:  "Same password",
+			sessionId: "sessionId",
+			body:      []byte(`{"old_password":"old","new_password":"old"}`),
+
+			expectChange:    true,
+			changeOutReturn: changepassmodel.NewChangePasswordOutput(false),
+			changeErrReturn: changepasserr.ErrSamePassword,
+
+			expBody:       []byte(`{"error":"new password must differ from the old one"}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+			body:      []byte(`{"old_password":"old","new_password":"new"}`),
+
+			expectChange:    true,
+			changeOutReturn: changepassmodel.NewChangePasswordOutput(false),
+			changeErrReturn: changepasserr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+			body:      []byte(`{"old_password":"old","new_password":"new"}`),
+
+			expectChange: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			changePassUCMock := handlmocks.NewMockChangePasswordUsecase(ctrl)
+			if tt.expectChange {
+				changePassUCMock.EXPECT().Execute(gomock.Any(), gomock.Any()).
+					Return(tt.changeOutReturn, tt.changeErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, changePassUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.ChangePassword)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodPost, serv.URL+"/test", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			req.Header.Set("Content-Type", "application/json")
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/requestreset.go -destination=mocks/mock_requestreset.go -package=handlmocks
+func TestRestHandler_RequestPasswordReset(t *testing.T) {
+	tests := []struct {
+		testName string
+		body     []byte
+
+		expectReset    bool
+		resetOutReturn *reqresetmodel.RequestResetOutput
+		resetErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+			body:     []byte(`{"email":"ivan@mail.ru"}`),
+
+			expectReset:    true,
+			resetOutReturn: reqresetmodel.NewRequestResetOutput(true),
+			resetErrReturn: nil,
+
+			expBody:       []byte(`{"is_requested":true}`),
+			expStatusCode: 202,
+		}, {
+			testName: "Invalid email",
+			body:     []byte(`{"email":"ivan"}`),
+
+			expectReset: false,
+
+			expBody:       []byte(`{"errors":{"Email":"invalid email"}}`),
+			expStatusCode: 400,
+		}, {
+			testName: "Internal error",
+			body:     []byte(`{"email":"ivan@mail.ru"}`),
+
+			expectReset:    true,
+			resetOutReturn: reqresetmodel.NewRequestResetOutput(false),
+			resetErrReturn: errors.New("internal error"),
+
+			expBody:       []byte(`{"error":"internal server error"}`),
+			expStatusCode: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			reqResetUCMock := handlmocks.NewMockRequestResetUsecase(ctrl)
+			if tt.expectReset {
+				reqResetUCMock.EXPECT().Execute(gomock.Any(), reqresetmodel.NewRequestResetInput("ivan@mail.ru")).
+					Return(tt.resetOutReturn, tt.resetErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, reqResetUCMock, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.RequestPasswordReset)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			resp, err := http.Post(serv.URL+"/test", "application/json", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/confirmreset.go -destination=mocks/mock_confirmreset.go -package=handlmocks
+func TestRestHandler_ConfirmPasswordReset(t *testing.T) {
+	tests := []struct {
+		testName string
+		body     []byte
+
+		expectConfirm    bool
+		confirmOutReturn *confresetmodel.ConfirmResetOutput
+		confirmErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+			body:     []byte(`{"token":"token","new_password":"new"}`),
+
+			expectConfirm:    true,
+			confirmOutReturn: confresetmodel.NewConfirmResetOutput(true),
+			confirmErrReturn: nil,
+
+			expBody:       []byte(`{"is_reset":true}`),
+			expStatusCode: 200,
+		}, {
+			testName: "Missing token",
+			body:     []byte(`{"new_password":"new"}`),
+
+			expectConfirm: false,
+
+			expBody:       []byte(`{"errors":{"Token":"field is required"}}`),
+			expStatusCode: 400,
+		}, {
+			testName: "Invalid token",
+			body:     []byte(`{"token":"token","new_password":"new"}`),
+
+			expectConfirm:    true,
+			confirmOutReturn: confresetmodel.NewConfirmResetOutput(false),
+			confirmErrReturn: confreseterr.ErrInvalidToken,
+
+			expBody:       []byte(`{"error":"invalid or expired token"}`),
+			expStatusCode: 400,
+		}, {
+			testName: "User not found",
+			body:     []byte(`{"token":"token","new_password":"new"}`),
+
+			expectConfirm:    true,
+			confirmOutReturn: confresetmodel.NewConfirmResetOutput(false),
+			confirmErrReturn: confreseterr.ErrUserNotFound,
+
+			expBody:       []byte(`{"error":"user not found"}`),
+			expStatusCode: 404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			confResetUCMock := handlmocks.NewMockConfirmResetUsecase(ctrl)
+			if tt.expectConfirm {
+				confResetUCMock.EXPECT().Execute(gomock.Any(), confresetmodel.NewConfirmResetInput("token", "new")).
+					Return(tt.confirmOutReturn, tt.confirmErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, confResetUCMock, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.ConfirmPasswordReset)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			resp, err := http.Post(serv.URL+"/test", "application/json", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/verifyemail.go -destination=mocks/mock_verifyemail.go -package=handlmocks
+func TestRestHandler_VerifyEmail(t *testing.T) {
+	tests := []struct {
+		testName string
+		body     []byte
+
+		expectVerify    bool
+		verifyOutReturn *verifymodel.VerifyEmailOutput
+		verifyErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+			body:     []byte(`{"token":"token"}`),
+
+			expectVerify:    true,
+			verifyOutReturn: verifymodel.NewVerifyEmailOutput(true),
+			verifyErrReturn: nil,
+
+			expBody:       []byte(`{"is_verified":true}`),
+			expStatusCode: 200,
+		}, {
+			testName: "Missing token",
+			body:     []byte(`{}`),
+
+			expectVerify: false,
+
+			expBody:       []byte(`{"errors":{"Token":"field is required"}}`),
+			expStatusCode: 400,
+		}, {
+			testName: "Invalid token",
+			body:     []byte(`{"token":"token"}`),
+
+			expectVerify:    true,
+			verifyOutReturn: verifymodel.NewVerifyEmailOutput(false),
+			verifyErrReturn: verifyerr.ErrInvalidToken,
+
+			expBody:       []byte(`{"error":"invalid or expired token"}`),
+			expStatusCode: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			verifyUCMock := handlmocks.NewMockVerifyEmailUsecase(ctrl)
+			if tt.expectVerify {
+				verifyUCMock.EXPECT().Execute(gomock.Any(), verifymodel.NewVerifyEmailInput("token")).
+					Return(tt.verifyOutReturn, tt.verifyErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, verifyUCMock, nil, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.VerifyEmail)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			resp, err := http.Post(serv.URL+"/test", "application/json", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/resendverification.go -destination=mocks/mock_resendverification.go -package=handlmocks
+func TestRestHandler_ResendVerification(t *testing.T) {
+	tests := []struct {
+		testName string
+		body     []byte
+
+		expectResend    bool
+		resendOutReturn *resendmodel.ResendOutput
+		resendErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+			body:     []byte(`{"email":"ivan@mail.ru"}`),
+
+			expectResend:    true,
+			resendOutReturn: resendmodel.NewResendOutput(true),
+			resendErrReturn: nil,
+
+			expBody:       []byte(`{"is_requested":true}`),
+			expStatusCode: 202,
+		}, {
+			testName: "Invalid email",
+			body:     []byte(`{"email":"ivan"}`),
+
+			expectResend: false,
+
+			expBody:       []byte(`{"errors":{"Email":"invalid email"}}`),
+			expStatusCode: 400,
+		}, {
+			testName: "Internal error",
+			body:     []byte(`{"email":"ivan@mail.ru"}`),
+
+			expectResend:    true,
+			resendOutReturn: resendmodel.NewResendOutput(false),
+			resendErrReturn: errors.New("internal error"),
+
+			expBody:       []byte(`{"error":"internal server error"}`),
+			expStatusCode: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			resendUCMock := handlmocks.NewMockResendVerificationUsecase(ctrl)
+			if tt.expectResend {
+				resendUCMock.EXPECT().Execute(gomock.Any(), resendmodel.NewResendInput("ivan@mail.ru")).
+					Return(tt.resendOutReturn, tt.resendErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, resendUCMock, nil, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.ResendVerification)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			resp, err := http.Post(serv.URL+"/test", "application/json", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+func hasClearedSessionCookie(resp *http.Response) bool {
+	for _, c := range resp.Cookies() {
+		if c.Name == "sessionId" && c.Value == "" && c.MaxAge < 0 {
+			return true
+		}
+	}
+	return false
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/createapitoken.go -destination=mocks/mock_createapitoken.go -package=handlmocks
+func TestRestHandler_CreateApiToken(t *testing.T) {
+	timeNow := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	expiresAt := timeNow.Add(30 * 24 * time.Hour)
+
+	tests := []struct {
+		testName  string
+		sessionId string
+		body      []byte
+
+		expectCreate    bool
+		createIn        *createtokenmodel.CreateTokenInput
+		createOutReturn *createtokenmodel.CreateTokenOutput
+		createErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+			body:      []byte(`{"name":"ci","expires_in_days":30}`),
+
+			expectCreate: true,
+			createIn:     createtokenmodel.NewCreateTokenInput("sessionId", "ci", 30*24*time.Hour),
+			createOutReturn: createtokenmodel.NewCreateTokenOutput(
+				"pat_0123456789abcdef0123456789abcdef",
+				&apitokendomain.ApiTokenDomain{Id: 7, UserId: 1, Name: "ci", Prefix: "pat_01234567", CreatedAt: timeNow, ExpiresAt: &expiresAt},
+			),
+			createErrReturn: nil,
+
+			expBody:       []byte(`{"id":7,"name":"ci","token":"pat_0123456789abcdef0123456789abcdef","prefix":"pat_01234567","created_at":"2025-01-01T12:00:00Z","expires_at":"2025-01-31T12:00:00Z"}`),
+			expStatusCode: 201,
+		}, {
+			testName:  "Success without expiration",
+			sessionId: "sessionId",
+			body:      []byte(`{"name":"ci"}`),
+
+			expectCreate: true,
+			createIn:     createtokenmodel.NewCreateTokenInput("sessionId", "ci", 0),
+			createOutReturn: createtokenmodel.NewCreateTokenOutput(
+				"pat_0123456789abcdef0123456789abcdef",
+				&apitokendomain.ApiTokenDomain{Id: 7, UserId: 1, Name: "ci", Prefix: "pat_01234567", CreatedAt: timeNow},
+			),
+			createErrReturn: nil,
+
+			expBody:       []byte(`{"id":7,"name":"ci","token":"pat_0123456789abcdef0123456789abcdef","prefix":"pat_01234567","created_at":"2025-01-01T12:00:00Z","expires_at":null}`),
+			expStatusCode: 201,
+		}, {
+			testName:  "Missing name",
+			sessionId: "sessionId",
+			body:      []byte(`{}`),
+
+			expectCreate: false,
+
+			expBody:       []byte(`{"errors":{"Name":"field is required"}}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Invalid name",
+			sessionId: "sessionId",
+			body:      []byte(`{"name":"  "}`),
+
+			expectCreate:    true,
+			createIn:        createtokenmodel.NewCreateTokenInput("sessionId", "  ", 0),
+			createOutReturn: nil,
+			createErrReturn: apitokendomain.ErrInvalidName,
+
+			expBody:       []byte(`{"error":"invalid token name"}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+			body:      []byte(`{"name":"ci"}`),
+
+			expectCreate:    true,
+			createIn:        createtokenmodel.NewCreateTokenInput("sessionId", "ci", 0),
+			createOutReturn: nil,
+			createErrReturn: createtokenerr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+			body:      []byte(`{"name":"ci"}`),
+
+			expectCreate: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			createTokUCMock := handlmocks.NewMockCreateApiTokenUsecase(ctrl)
+			if tt.expectCreate {
+				createTokUCMock.EXPECT().Execute(gomock.Any(), tt.createIn).
+					Return(tt.createOutReturn, tt.createErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, createTokUCMock, nil, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.CreateApiToken)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodPost, serv.URL+"/test", bytes.NewBuffer(tt.body))
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/apitokens.go -destination=mocks/mock_apitokens.go -package=handlmocks
+func TestRestHandler_GetApiTokens(t *testing.T) {
+	timeNow := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName  string
+		sessionId string
+
+		expectTokens    bool
+		tokensOutReturn *tokensmodel.TokensOutput
+		tokensErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+
+			expectTokens: true,
+			tokensOutReturn: tokensmodel.NewTokensOutput([]*apitokendomain.ApiTokenDomain{
+				{Id: 7, UserId: 1, Name: "ci", Hash: "hash", Prefix: "pat_01234567", CreatedAt: timeNow, LastUsedAt: &timeNow},
+			}),
+			tokensErrReturn: nil,
+
+			expBody:       []byte(`{"tokens":[{"id":7,"name":"ci","prefix":"pat_01234567","created_at":"2025-01-01T12:00:00Z","expires_at":null,"last_used_at":"2025-01-01T12:00:00Z"}]}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+
+			expectTokens:    true,
+			tokensOutReturn: nil,
+			tokensErrReturn: tokenserr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+
+			expectTokens: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			tokensUCMock := handlmocks.NewMockGetApiTokensUsecase(ctrl)
+			if tt.expectTokens {
+				tokensUCMock.EXPECT().Execute(gomock.Any(), tokensmodel.NewTokensInput(tt.sessionId)).
+					Return(tt.tokensOutReturn, tt.tokensErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, tokensUCMock, nil, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.GET("/test", handl.GetApiTokens)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodGet, serv.URL+"/test", nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/revokeapitoken.go -destination=mocks/mock_revokeapitoken.go -package=handlmocks
+func TestRestHandler_RevokeApiToken(t *testing.T) {
+	tests := []struct {
+		testName  string
+		sessionId string
+		tokenId   string
+
+		expectRevoke    bool
+		revokeIn        *revoketokenmodel.RevokeTokenInput
+		revokeOutReturn *revoketokenmodel.RevokeTokenOutput
+		revokeErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+			tokenId:   "7",
+
+			expectRevoke:    true,
+			revokeIn:        revoketokenmodel.NewRevokeTokenInput("sessionId", 7),
+			revokeOutReturn: revoketokenmodel.NewRevokeTokenOutput(true),
+			revokeErrReturn: nil,
+
+			expBody:       []byte(`{"is_revoked":true}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Token not found",
+			sessionId: "sessionId",
+			tokenId:   "7",
+
+			expectRevoke:    true,
+			revokeIn:        revoketokenmodel.NewRevokeTokenInput("sessionId", 7),
+			revokeOutReturn: revoketokenmodel.NewRevokeTokenOutput(false),
+			revokeErrReturn: revoketokenerr.ErrTokenNotFound,
+
+			expBody:       []byte(`{"error":"api token not found"}`),
+			expStatusCode: 404,
+		}, {
+			testName:  "Invalid token id",
+			sessionId: "sessionId",
+			tokenId:   "abc",
+
+			expectRevoke: false,
+
+			expBody:       []byte(`{"error":"invalid api token id"}`),
+			expStatusCode: 400,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+			tokenId:   "7",
+
+			expectRevoke:    true,
+			revokeIn:        revoketokenmodel.NewRevokeTokenInput("sessionId", 7),
+			revokeOutReturn: revoketokenmodel.NewRevokeTokenOutput(false),
+			revokeErrReturn: revoketokenerr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+			tokenId:   "7",
+
+			expectRevoke: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			revokeTokUCMock := handlmocks.NewMockRevokeApiTokenUsecase(ctrl)
+			if tt.expectRevoke {
+				revokeTokUCMock.EXPECT().Execute(gomock.Any(), tt.revokeIn).
+					Return(tt.revokeOutReturn, tt.revokeErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, revokeTokUCMock, nil, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.DELETE("/test/:token_id", handl.RevokeApiToken)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodDelete, serv.URL+"/test/"+tt.tokenId, nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/issuetoken.go -destination=mocks/mock_issuetoken.go -package=handlmocks
+func TestRestHandler_IssueToken(t *testing.T) {
+	expiresAt := time.Date(2025, 1, 1, 12, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName  string
+		sessionId string
+
+		expectIssue    bool
+		issueIn        *issuetokenmodel.IssueTokenInput
+		issueOutReturn *issuetokenmodel.IssueTokenOutput
+		issueErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName:  "Success",
+			sessionId: "sessionId",
+
+			expectIssue:    true,
+			issueIn:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			issueOutReturn: issuetokenmodel.NewIssueTokenOutput("access", expiresAt, "refresh"),
+			issueErrReturn: nil,
+
+			expBody:       []byte(`{"access_token":"access","token_type":"Bearer","expires_at":"2025-01-01T12:05:00Z","refresh_token":"refresh"}`),
+			expStatusCode: 200,
+		}, {
+			testName:  "Session not found",
+			sessionId: "sessionId",
+
+			expectIssue:    true,
+			issueIn:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			issueOutReturn: nil,
+			issueErrReturn: issuetokenerr.ErrSessionNotFound,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Missing cookie",
+			sessionId: "",
+
+			expectIssue: false,
+
+			expBody:       []byte(`{"error":"session not found"}`),
+			expStatusCode: 401,
+		}, {
+			testName:  "Internal error",
+			sessionId: "sessionId",
+
+			expectIssue:    true,
+			issueIn:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			issueOutReturn: nil,
+			issueErrReturn: errors.New("db down"),
+
+			expBody:       []byte(`{"error":"internal server error"}`),
+			expStatusCode: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			issueTokUCMock := handlmocks.NewMockIssueTokenUsecase(ctrl)
+			if tt.expectIssue {
+				issueTokUCMock.EXPECT().Execute(gomock.Any(), tt.issueIn).
+					Return(tt.issueOutReturn, tt.issueErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, issueTokUCMock, nil, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.IssueToken)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			req, err := http.NewRequest(http.MethodPost, serv.URL+"/test", nil)
+			require.NoError(t, err)
+			if tt.sessionId != "" {
+				req.AddCookie(&http.Cookie{Name: "sessionId", Value: tt.sessionId})
+			}
+
+			resp, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/refreshtoken.go -destination=mocks/mock_refreshtoken.go -package=handlmocks
+func TestRestHandler_RefreshToken(t *testing.T) {
+	expiresAt := time.Date(2025, 1, 1, 12, 5, 0, 0, time.UTC)
+
+	tests := []struct {
+		testName string
+		body     []byte
+
+		expectRefresh    bool
+		refreshIn        *refreshmodel.RefreshInput
+		refreshOutReturn *refreshmodel.RefreshOutput
+		refreshErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+			body:     []byte(`{"refresh_token":"old"}`),
+
+			expectRefresh:    true,
+			refreshIn:        refreshmodel.NewRefreshInput("old"),
+			refreshOutReturn: refreshmodel.NewRefreshOutput("access", expiresAt, "new"),
+			refreshErrReturn: nil,
+
+			expBody:       []byte(`{"access_token":"access","token_type":"Bearer","expires_at":"2025-01-01T12:05:00Z","refresh_token":"new"}`),
+			expStatusCode: 200,
+		}, {
+			testName: "Invalid refresh token",
+			body:     []byte(`{"refresh_token":"old"}`),
+
+			expectRefresh:    true,
+			refreshIn:        refreshmodel.NewRefreshInput("old"),
+			refreshOutReturn: nil,
+			refreshErrReturn: refresherr.ErrInvalidRefreshToken,
+
+			expBody:       []byte(`{"error":"invalid or expired refresh token"}`),
+			expStatusCode: 401,
+		}, {
+			testName: "Missing refresh token",
+			body:     []byte(`{}`),
+
+			expectRefresh: false,
+
+			expBody:       []byte(`{"errors":{"RefreshToken":"field is required"}}`),
+			expStatusCode: 400,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			refreshUCMock := handlmocks.NewMockRefreshTokenUsecase(ctrl)
+			if tt.expectRefresh {
+				refreshUCMock.EXPECT().Execute(gomock.Any(), tt.refreshIn).
+					Return(tt.refreshOutReturn, tt.refreshErrReturn)
+			}
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, refreshUCMock, nil)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.POST("/test", handl.RefreshToken)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			resp, err := http.Post(serv.URL+"/test", "application/json", bytes.NewReader(tt.body))
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
+		})
+	}
+}
+
+//go:generate mockgen -source=./../../../usecase/interfaces/jwks.go -destination=mocks/mock_jwks.go -package=handlmocks
+func TestRestHandler_GetJWKS(t *testing.T) {
+	priv := ed25519.NewKeyFromSeed(make([]byte, ed25519.SeedSize))
+	x := base64.RawURLEncoding.EncodeToString(priv.Public().(ed25519.PublicKey))
+
+	tests := []struct {
+		testName string
+
+		jwksOutReturn *jwksmodel.JWKSOutput
+		jwksErrReturn error
+
+		expBody       []byte
+		expStatusCode int
+	}{
+		{
+			testName: "Success",
+
+			jwksOutReturn: jwksmodel.NewJWKSOutput([]*signingkeydomain.SigningKeyDomain{
+				{Kid: "kid", Algorithm: signingkeydomain.AlgEdDSA, PrivateKey: priv},
+			}),
+			jwksErrReturn: nil,
+
+			expBody:       []byte(`{"keys":[{"kty":"OKP","kid":"kid","alg":"EdDSA","use":"sig","crv":"Ed25519","x":"` + x + `"}]}`),
+			expStatusCode: 200,
+		}, {
+			testName: "No keys",
+
+			jwksOutReturn: jwksmodel.NewJWKSOutput([]*signingkeydomain.SigningKeyDomain{}),
+			jwksErrReturn: nil,
+
+			expBody:       []byte(`{"keys":[]}`),
+			expStatusCode: 200,
+		}, {
+			testName: "Internal error",
+
+			jwksOutReturn: nil,
+			jwksErrReturn: errors.New("db down"),
+
+			expBody:       []byte(`{"error":"internal server error"}`),
+			expStatusCode: 500,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			jwksUCMock := handlmocks.NewMockGetJWKSUsecase(ctrl)
+			jwksUCMock.EXPECT().Execute(gomock.Any()).
+				Return(tt.jwksOutReturn, tt.jwksErrReturn)
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			handl := NewRestHandler(log, time.Hour, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, jwksUCMock)
+
+			gin.SetMode(gin.DebugMode)
+			router := gin.New()
+			router.Use(gin.Recovery())
+			router.Use(middleware.TimeoutMiddleware(time.Duration(15) * time.Second))
+
+			router.GET("/test", handl.GetJWKS)
+
+			serv := httptest.NewServer(router)
+			defer serv.Close()
+
+			resp, err := http.Get(serv.URL + "/test")
+			require.NoError(t, err)
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			require.NoError(t, err)
+			require.Equal(t, tt.expStatusCode, resp.StatusCode)
+			require.Equal(t, tt.expBody, body)
 		})
 	}
 }
@@ -0,0 +1,7 @@
+package tokenserr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
@@ -0,0 +1,7 @@
+package authtokenerr
+
+import "errors"
+
+var (
+	ErrInvalidToken = errors.New("invalid or expired token")
+)
@@ -0,0 +1,7 @@
+package batchgeterr
+
+import "errors"
+
+var (
+	ErrTooManyIds = errors.New("too many user ids")
+)
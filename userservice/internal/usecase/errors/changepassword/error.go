@@ -0,0 +1,10 @@
+package changepasserr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrUserNotFound    = errors.New("user not found")
+	ErrWrongPassword   = errors.New("wrong password")
+	ErrSamePassword    = errors.New("new password must differ from the old one")
+)
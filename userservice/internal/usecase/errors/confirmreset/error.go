@@ -0,0 +1,8 @@
+package confreseterr
+
+import "errors"
+
+var (
+	ErrInvalidToken = errors.New("invalid or expired token")
+	ErrUserNotFound = errors.New("user not found")
+)
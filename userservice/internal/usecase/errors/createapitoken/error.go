@@ -0,0 +1,8 @@
+package createtokenerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrInvalidTTL      = errors.New("invalid token ttl")
+)
@@ -0,0 +1,8 @@
+package getprofileerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrUserNotFound    = errors.New("user not found")
+)
@@ -0,0 +1,8 @@
+package getusererr
+
+import "errors"
+
+var (
+	ErrInvalidUserId = errors.New("invalid user id")
+	ErrUserNotFound  = errors.New("user not found")
+)
@@ -0,0 +1,7 @@
+package issuetokenerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
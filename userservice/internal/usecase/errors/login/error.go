@@ -3,6 +3,8 @@ package logerr
 import "errors"
 
 var (
-	ErrUserNotFound  = errors.New("user not found")
-	ErrWrongPassword = errors.New("wrong password")
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	ErrTooManyAttempts    = errors.New("too many login attempts")
+
+	ErrEmailNotVerified = errors.New("email not verified")
 )
@@ -0,0 +1,7 @@
+package logouterr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
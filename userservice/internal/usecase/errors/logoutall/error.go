@@ -0,0 +1,7 @@
+package logoutallerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
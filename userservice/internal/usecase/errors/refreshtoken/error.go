@@ -0,0 +1,7 @@
+package refresherr
+
+import "errors"
+
+var (
+	ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+)
@@ -0,0 +1,9 @@
+package revoketokenerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+	ErrTokenNotFound   = errors.New("api token not found")
+	ErrInvalidTokenId  = errors.New("invalid api token id")
+)
@@ -0,0 +1,9 @@
+package revokeerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound       = errors.New("session not found")
+	ErrTargetSessionNotFound = errors.New("target session not found")
+	ErrInvalidTargetId       = errors.New("invalid target session id")
+)
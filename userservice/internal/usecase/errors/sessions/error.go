@@ -0,0 +1,7 @@
+package sessionserr
+
+import "errors"
+
+var (
+	ErrSessionNotFound = errors.New("session not found")
+)
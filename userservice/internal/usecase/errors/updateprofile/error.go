@@ -0,0 +1,10 @@
+package updprofileerr
+
+import "errors"
+
+var (
+	ErrSessionNotFound    = errors.New("session not found")
+	ErrUserNotFound       = errors.New("user not found")
+	ErrNothingToUpdate    = errors.New("nothing to update")
+	ErrEmailAlreadyExists = errors.New("email already in use")
+)
@@ -0,0 +1,7 @@
+package verifyerr
+
+import "errors"
+
+var (
+	ErrInvalidToken = errors.New("invalid or expired token")
+)
@@ -0,0 +1,55 @@
+package apitokens
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/apitoken"
+	"userservice/internal/repository/session"
+	tokenserr "userservice/internal/usecase/errors/apitokens"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+)
+
+type GetApiTokensUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	tokenRepo   apitoken.ApiTokenRepo
+}
+
+func NewGetApiTokensUC(log *slog.Logger, sessionRepo session.SessionRepo, tokenRepo apitoken.ApiTokenRepo) *GetApiTokensUC {
+	return &GetApiTokensUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		tokenRepo:   tokenRepo,
+	}
+}
+
+func (g *GetApiTokensUC) Execute(ctx context.Context, in *tokensmodel.TokensInput) (*tokensmodel.TokensOutput, error) {
+	const op = "apitokens.Execute"
+	log := g.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "get api tokens started")
+
+	current, err := g.sessionRepo.GetSession(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "get api tokens stopped: session not found")
+			return nil, tokenserr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "get api tokens stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(current.UserId)))
+
+	tokens, err := g.tokenRepo.FindApiTokensForUser(ctx, current.UserId)
+	if err != nil {
+		log.WarnContext(ctx, "get api tokens stopped: cannot get user tokens", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "get api tokens completed successfully")
+
+	return tokensmodel.NewTokensOutput(tokens), nil
+}
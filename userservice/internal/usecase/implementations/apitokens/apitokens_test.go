@@ -0,0 +1,98 @@
+package apitokens
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/session"
+	tokenserr "userservice/internal/usecase/errors/apitokens"
+	tokensmocks "userservice/internal/usecase/implementations/apitokens/mocks"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=tokensmocks
+//go:generate mockgen -source=./../../../repository/apitoken/apitokenrepo.go -destination=./mocks/mock_apitoken.go -package=tokensmocks
+func TestGetApiTokens(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		getSessionInput  string
+		getSessionOutput *sessiondomain.SessionDomain
+		getSessionErr    error
+
+		expFind    bool
+		findInput  uint32
+		findOutput []*apitokendomain.ApiTokenDomain
+		findErr    error
+
+		in        *tokensmodel.TokensInput
+		expOutput *tokensmodel.TokensOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			getSessionInput:  "sessionId",
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expFind:   true,
+			findInput: 1,
+			findOutput: []*apitokendomain.ApiTokenDomain{
+				{Id: 1, UserId: 1, Name: "ci", Prefix: "pat_01234567", CreatedAt: timeNow},
+			},
+			findErr: nil,
+
+			in: tokensmodel.NewTokensInput("sessionId"),
+			expOutput: tokensmodel.NewTokensOutput([]*apitokendomain.ApiTokenDomain{
+				{Id: 1, UserId: 1, Name: "ci", Prefix: "pat_01234567", CreatedAt: timeNow},
+			}),
+			expErr: nil,
+		}, {
+			testName: "Session not found",
+
+			getSessionInput:  "sessionId",
+			getSessionOutput: nil,
+			getSessionErr:    session.ErrKeyNotFound,
+
+			expFind: false,
+
+			in:        tokensmodel.NewTokensInput("sessionId"),
+			expOutput: nil,
+			expErr:    tokenserr.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := tokensmocks.NewMockSessionRepo(ctrl)
+			tokenMock := tokensmocks.NewMockApiTokenRepo(ctrl)
+
+			sessionMock.EXPECT().GetSession(gomock.Any(), tt.getSessionInput).
+				Return(tt.getSessionOutput, tt.getSessionErr)
+			if tt.expFind {
+				tokenMock.EXPECT().FindApiTokensForUser(gomock.Any(), tt.findInput).
+					Return(tt.findOutput, tt.findErr)
+			}
+
+			tokensUC := NewGetApiTokensUC(log, sessionMock, tokenMock)
+
+			out, err := tokensUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/apitoken/apitokenrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/apitoken/apitokenrepo.go -destination=./mocks/mock_apitoken.go -package=tokensmocks
+//
+
+// Package tokensmocks is a generated GoMock package.
+package tokensmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	apitokendomain "userservice/internal/domain/apitoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockApiTokenRepo is a mock of ApiTokenRepo interface.
+type MockApiTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockApiTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockApiTokenRepoMockRecorder is the mock recorder for MockApiTokenRepo.
+type MockApiTokenRepoMockRecorder struct {
+	mock *MockApiTokenRepo
+}
+
+// NewMockApiTokenRepo creates a new mock instance.
+func NewMockApiTokenRepo(ctrl *gomock.Controller) *MockApiTokenRepo {
+	mock := &MockApiTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockApiTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockApiTokenRepo) EXPECT() *MockApiTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// DeleteApiTokenForUser mocks base method.
+func (m *MockApiTokenRepo) DeleteApiTokenForUser(ctx context.Context, userId, tokenId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiTokenForUser", ctx, userId, tokenId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApiTokenForUser indicates an expected call of DeleteApiTokenForUser.
+func (mr *MockApiTokenRepoMockRecorder) DeleteApiTokenForUser(ctx, userId, tokenId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiTokenForUser", reflect.TypeOf((*MockApiTokenRepo)(nil).DeleteApiTokenForUser), ctx, userId, tokenId)
+}
+
+// DeleteApiTokensForUser mocks base method.
+func (m *MockApiTokenRepo) DeleteApiTokensForUser(ctx context.Context, userId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteApiTokensForUser", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteApiTokensForUser indicates an expected call of DeleteApiTokensForUser.
+func (mr *MockApiTokenRepoMockRecorder) DeleteApiTokensForUser(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteApiTokensForUser", reflect.TypeOf((*MockApiTokenRepo)(nil).DeleteApiTokensForUser), ctx, userId)
+}
+
+// FindApiTokenByHash mocks base method.
+func (m *MockApiTokenRepo) FindApiTokenByHash(ctx context.Context, hash string) (*apitokendomain.ApiTokenDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindApiTokenByHash", ctx, hash)
+	ret0, _ := ret[0].(*apitokendomain.ApiTokenDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApiTokenByHash indicates an expected call of FindApiTokenByHash.
+func (mr *MockApiTokenRepoMockRecorder) FindApiTokenByHash(ctx, hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApiTokenByHash", reflect.TypeOf((*MockApiTokenRepo)(nil).FindApiTokenByHash), ctx, hash)
+}
+
+// FindApiTokensForUser mocks base method.
+func (m *MockApiTokenRepo) FindApiTokensForUser(ctx context.Context, userId uint32) ([]*apitokendomain.ApiTokenDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindApiTokensForUser", ctx, userId)
+	ret0, _ := ret[0].([]*apitokendomain.ApiTokenDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApiTokensForUser indicates an expected call of FindApiTokensForUser.
+func (mr *MockApiTokenRepoMockRecorder) FindApiTokensForUser(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApiTokensForUser", reflect.TypeOf((*MockApiTokenRepo)(nil).FindApiTokensForUser), ctx, userId)
+}
+
+// SaveApiToken mocks base method.
+func (m *MockApiTokenRepo) SaveApiToken(ctx context.Context, t *apitokendomain.ApiTokenDomain) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveApiToken", ctx, t)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveApiToken indicates an expected call of SaveApiToken.
+func (mr *MockApiTokenRepoMockRecorder) SaveApiToken(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveApiToken", reflect.TypeOf((*MockApiTokenRepo)(nil).SaveApiToken), ctx, t)
+}
+
+// TouchApiToken mocks base method.
+func (m *MockApiTokenRepo) TouchApiToken(ctx context.Context, tokenId uint32, lastUsedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchApiToken", ctx, tokenId, lastUsedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchApiToken indicates an expected call of TouchApiToken.
+func (mr *MockApiTokenRepoMockRecorder) TouchApiToken(ctx, tokenId, lastUsedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchApiToken", reflect.TypeOf((*MockApiTokenRepo)(nil).TouchApiToken), ctx, tokenId, lastUsedAt)
+}
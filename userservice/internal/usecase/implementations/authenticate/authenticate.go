@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 	"userservice/internal/repository/session"
 	autherr "userservice/internal/usecase/errors/authenticate"
 	authmodel "userservice/internal/usecase/models/authenticate"
@@ -17,12 +18,25 @@ type GetUserIDBySessionUC struct {
 	log *slog.Logger
 
 	sessionRepo session.SessionRepo
+
+	sliding     bool
+	ttl         time.Duration
+	maxLifetime time.Duration
 }
 
-func NewGetUserIDBySessionUC(log *slog.Logger, sessionRepo session.SessionRepo) *GetUserIDBySessionUC {
+func NewGetUserIDBySessionUC(
+	log *slog.Logger,
+	sessionRepo session.SessionRepo,
+	sliding bool,
+	ttl time.Duration,
+	maxLifetime time.Duration,
+) *GetUserIDBySessionUC {
 	return &GetUserIDBySessionUC{
 		log:         log,
 		sessionRepo: sessionRepo,
+		sliding:     sliding,
+		ttl:         ttl,
+		maxLifetime: maxLifetime,
 	}
 }
 
@@ -30,17 +44,32 @@ func (a *GetUserIDBySessionUC) Execute(ctx context.Context, in *authmodel.AuthIn
 	const op = "authenticate.Execute"
 	log := a.log.With(slog.String("op", op))
 
-	log.Info("authenticate session starting")
+	log.InfoContext(ctx, "authenticate session starting")
 
-	userId, err := a.sessionRepo.Get(ctx, in.SessionId)
+	s, err := a.sessionRepo.GetSession(ctx, in.SessionId)
 	if err != nil {
 		if errors.Is(err, session.ErrKeyNotFound) {
-			log.Info("authenticate stopped: session not found")
+			log.InfoContext(ctx, "authenticate stopped: session not found")
 			return authmodel.NewAuthOutput(invalidId), autherr.ErrSessionNotFound
 		}
-		log.Warn("authenticate stopped", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "authenticate stopped", slog.String("error", err.Error()))
 		return authmodel.NewAuthOutput(invalidId), err
 	}
 
-	return authmodel.NewAuthOutput(userId), err
+	now := time.Now().UTC()
+
+	var ttl time.Duration
+	if a.sliding {
+		ttl = s.SlidingTTL(now, a.ttl, a.maxLifetime)
+		if ttl <= 0 {
+			log.InfoContext(ctx, "authenticate stopped: session lifetime exceeded")
+			return authmodel.NewAuthOutput(invalidId), autherr.ErrSessionNotFound
+		}
+	}
+
+	if err := a.sessionRepo.Touch(ctx, in.SessionId, now, ttl); err != nil {
+		log.WarnContext(ctx, "cannot refresh session", slog.String("error", err.Error()))
+	}
+
+	return authmodel.NewAuthOutput(s.UserId), nil
 }
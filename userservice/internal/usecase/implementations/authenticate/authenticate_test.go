@@ -5,6 +5,8 @@ import (
 	"io"
 	"log/slog"
 	"testing"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
 	"userservice/internal/repository/session"
 	autherr "userservice/internal/usecase/errors/authenticate"
 	authmocks "userservice/internal/usecase/implementations/authenticate/mocks"
@@ -16,13 +18,24 @@ import (
 
 //go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=authmocks
 func TestAuthenticate(t *testing.T) {
+	timeNow := time.Now().UTC()
+
 	tests := []struct {
 		testName string
 
+		sliding     bool
+		ttl         time.Duration
+		maxLifetime time.Duration
+
 		sessionInput  string
-		sessionOutput uint32
+		sessionOutput *sessiondomain.SessionDomain
 		sessionErr    error
 
+		expTouch    bool
+		touchMinTTL time.Duration
+		touchMaxTTL time.Duration
+		touchErr    error
+
 		authInput *authmodel.AuthInput
 		expOutput *authmodel.AuthOutput
 		expErr    error
@@ -30,20 +43,105 @@ func TestAuthenticate(t *testing.T) {
 		{
 			testName: "Success",
 
+			sliding: false,
+			ttl:     time.Hour,
+
 			sessionInput:  "sessionId",
-			sessionOutput: 1,
+			sessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
 			sessionErr:    nil,
 
+			expTouch:    true,
+			touchMinTTL: 0,
+			touchMaxTTL: 0,
+			touchErr:    nil,
+
 			authInput: authmodel.NewAuthInput("sessionId"),
 			expOutput: authmodel.NewAuthOutput(1),
 			expErr:    nil,
 		}, {
 			testName: "Session not found",
 
+			sliding: false,
+			ttl:     time.Hour,
+
 			sessionInput:  "sessionId",
-			sessionOutput: 0,
+			sessionOutput: nil,
 			sessionErr:    session.ErrKeyNotFound,
 
+			expTouch: false,
+
+			authInput: authmodel.NewAuthInput("sessionId"),
+			expOutput: authmodel.NewAuthOutput(0),
+			expErr:    autherr.ErrSessionNotFound,
+		}, {
+			testName: "Touch failed",
+
+			sliding: false,
+			ttl:     time.Hour,
+
+			sessionInput:  "sessionId",
+			sessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			sessionErr:    nil,
+
+			expTouch:    true,
+			touchMinTTL: 0,
+			touchMaxTTL: 0,
+			touchErr:    session.ErrKeyNotFound,
+
+			authInput: authmodel.NewAuthInput("sessionId"),
+			expOutput: authmodel.NewAuthOutput(1),
+			expErr:    nil,
+		}, {
+			testName: "Sliding refresh",
+
+			sliding:     true,
+			ttl:         time.Hour,
+			maxLifetime: 24 * time.Hour,
+
+			sessionInput:  "sessionId",
+			sessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow.Add(-time.Hour), timeNow),
+			sessionErr:    nil,
+
+			expTouch:    true,
+			touchMinTTL: time.Hour,
+			touchMaxTTL: time.Hour,
+			touchErr:    nil,
+
+			authInput: authmodel.NewAuthInput("sessionId"),
+			expOutput: authmodel.NewAuthOutput(1),
+			expErr:    nil,
+		}, {
+			testName: "Sliding refresh capped by max lifetime",
+
+			sliding:     true,
+			ttl:         time.Hour,
+			maxLifetime: 24 * time.Hour,
+
+			sessionInput:  "sessionId",
+			sessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow.Add(-23*time.Hour-30*time.Minute), timeNow),
+			sessionErr:    nil,
+
+			expTouch:    true,
+			touchMinTTL: 29 * time.Minute,
+			touchMaxTTL: 30 * time.Minute,
+			touchErr:    nil,
+
+			authInput: authmodel.NewAuthInput("sessionId"),
+			expOutput: authmodel.NewAuthOutput(1),
+			expErr:    nil,
+		}, {
+			testName: "Max lifetime exceeded",
+
+			sliding:     true,
+			ttl:         time.Hour,
+			maxLifetime: 24 * time.Hour,
+
+			sessionInput:  "sessionId",
+			sessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow.Add(-25*time.Hour), timeNow),
+			sessionErr:    nil,
+
+			expTouch: false,
+
 			authInput: authmodel.NewAuthInput("sessionId"),
 			expOutput: authmodel.NewAuthOutput(0),
 			expErr:    autherr.ErrSessionNotFound,
@@ -58,10 +156,16 @@ func TestAuthenticate(t *testing.T) {
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 			sessionMock := authmocks.NewMockSessionRepo(ctrl)
 
-			sessionMock.EXPECT().Get(gomock.Any(), tt.sessionInput).
+			sessionMock.EXPECT().GetSession(gomock.Any(), tt.sessionInput).
 				Return(tt.sessionOutput, tt.sessionErr)
+			if tt.expTouch {
+				sessionMock.EXPECT().Touch(gomock.Any(), tt.sessionInput, gomock.Any(), gomock.Cond(func(ttl time.Duration) bool {
+					return ttl >= tt.touchMinTTL && ttl <= tt.touchMaxTTL
+				})).
+					Return(tt.touchErr)
+			}
 
-			auth := NewGetUserIDBySessionUC(log, sessionMock)
+			auth := NewGetUserIDBySessionUC(log, sessionMock, tt.sliding, tt.ttl, tt.maxLifetime)
 
 			out, err := auth.Execute(context.Background(), tt.authInput)
 			require.Equal(t, tt.expErr, err)
@@ -0,0 +1,67 @@
+package authenticatetoken
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	"userservice/internal/repository/apitoken"
+	authtokenerr "userservice/internal/usecase/errors/authenticatetoken"
+	authtokenmodel "userservice/internal/usecase/models/authenticatetoken"
+)
+
+var (
+	invalidId uint32 = 0
+)
+
+type GetUserIDByTokenUC struct {
+	log *slog.Logger
+
+	tokenRepo apitoken.ApiTokenRepo
+}
+
+func NewGetUserIDByTokenUC(log *slog.Logger, tokenRepo apitoken.ApiTokenRepo) *GetUserIDByTokenUC {
+	return &GetUserIDByTokenUC{
+		log:       log,
+		tokenRepo: tokenRepo,
+	}
+}
+
+func (a *GetUserIDByTokenUC) Execute(ctx context.Context, in *authtokenmodel.AuthTokenInput) (*authtokenmodel.AuthTokenOutput, error) {
+	const op = "authenticatetoken.Execute"
+	log := a.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "authenticate api token started")
+
+	if !apitokendomain.IsSecret(in.Token) {
+		log.InfoContext(ctx, "authenticate api token stopped: malformed token")
+		return authtokenmodel.NewAuthTokenOutput(invalidId), authtokenerr.ErrInvalidToken
+	}
+
+	td, err := a.tokenRepo.FindApiTokenByHash(ctx, apitokendomain.HashSecret(in.Token))
+	if err != nil {
+		if errors.Is(err, apitoken.ErrNotFound) {
+			log.InfoContext(ctx, "authenticate api token stopped: token not found")
+			return authtokenmodel.NewAuthTokenOutput(invalidId), authtokenerr.ErrInvalidToken
+		}
+		log.WarnContext(ctx, "authenticate api token stopped", slog.String("error", err.Error()))
+		return authtokenmodel.NewAuthTokenOutput(invalidId), err
+	}
+
+	log = log.With(slog.Uint64("token_id", uint64(td.Id)), slog.Uint64("user_id", uint64(td.UserId)))
+
+	now := time.Now().UTC()
+	if td.IsExpired(now) {
+		log.InfoContext(ctx, "authenticate api token stopped: token expired")
+		return authtokenmodel.NewAuthTokenOutput(invalidId), authtokenerr.ErrInvalidToken
+	}
+
+	if err := a.tokenRepo.TouchApiToken(ctx, td.Id, now); err != nil {
+		log.WarnContext(ctx, "cannot update token last use", slog.String("error", err.Error()))
+	}
+
+	log.InfoContext(ctx, "authenticate api token completed successfully")
+
+	return authtokenmodel.NewAuthTokenOutput(td.UserId), nil
+}
@@ -0,0 +1,126 @@
+package authenticatetoken
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	"userservice/internal/repository/apitoken"
+	authtokenerr "userservice/internal/usecase/errors/authenticatetoken"
+	authtokenmocks "userservice/internal/usecase/implementations/authenticatetoken/mocks"
+	authtokenmodel "userservice/internal/usecase/models/authenticatetoken"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/apitoken/apitokenrepo.go -destination=./mocks/mock_apitoken.go -package=authtokenmocks
+func TestAuthenticateToken(t *testing.T) {
+	secret := "pat_0123456789abcdef0123456789abcdef"
+	expired := time.Now().Add(-time.Minute)
+	valid := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		testName string
+
+		expFind    bool
+		findOutput *apitokendomain.ApiTokenDomain
+		findErr    error
+
+		expTouch bool
+		touchErr error
+
+		in        *authtokenmodel.AuthTokenInput
+		expOutput *authtokenmodel.AuthTokenOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			expFind:    true,
+			findOutput: &apitokendomain.ApiTokenDomain{Id: 7, UserId: 1, ExpiresAt: &valid},
+			findErr:    nil,
+
+			expTouch: true,
+			touchErr: nil,
+
+			in:        authtokenmodel.NewAuthTokenInput(secret),
+			expOutput: authtokenmodel.NewAuthTokenOutput(1),
+			expErr:    nil,
+		}, {
+			testName: "Touch error is ignored",
+
+			expFind:    true,
+			findOutput: &apitokendomain.ApiTokenDomain{Id: 7, UserId: 1},
+			findErr:    nil,
+
+			expTouch: true,
+			touchErr: errors.New("db down"),
+
+			in:        authtokenmodel.NewAuthTokenInput(secret),
+			expOutput: authtokenmodel.NewAuthTokenOutput(1),
+			expErr:    nil,
+		}, {
+			testName: "Malformed token",
+
+			expFind:  false,
+			expTouch: false,
+
+			in:        authtokenmodel.NewAuthTokenInput("sessionId"),
+			expOutput: authtokenmodel.NewAuthTokenOutput(0),
+			expErr:    authtokenerr.ErrInvalidToken,
+		}, {
+			testName: "Token not found",
+
+			expFind:    true,
+			findOutput: nil,
+			findErr:    apitoken.ErrNotFound,
+
+			expTouch: false,
+
+			in:        authtokenmodel.NewAuthTokenInput(secret),
+			expOutput: authtokenmodel.NewAuthTokenOutput(0),
+			expErr:    authtokenerr.ErrInvalidToken,
+		}, {
+			testName: "Token expired",
+
+			expFind:    true,
+			findOutput: &apitokendomain.ApiTokenDomain{Id: 7, UserId: 1, ExpiresAt: &expired},
+			findErr:    nil,
+
+			expTouch: false,
+
+			in:        authtokenmodel.NewAuthTokenInput(secret),
+			expOutput: authtokenmodel.NewAuthTokenOutput(0),
+			expErr:    authtokenerr.ErrInvalidToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			tokenMock := authtokenmocks.NewMockApiTokenRepo(ctrl)
+
+			if tt.expFind {
+				tokenMock.EXPECT().FindApiTokenByHash(gomock.Any(), apitokendomain.HashSecret(tt.in.Token)).
+					Return(tt.findOutput, tt.findErr)
+			}
+			if tt.expTouch {
+				tokenMock.EXPECT().TouchApiToken(gomock.Any(), tt.findOutput.Id, gomock.Any()).
+					Return(tt.touchErr)
+			}
+
+			authUC := NewGetUserIDByTokenUC(log, tokenMock)
+
+			out, err := authUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package batchgetusers
+
+import (
+	"context"
+	"log/slog"
+	userdomain "userservice/internal/domain/user"
+	storagerepo "userservice/internal/repository/storage"
+	batchgeterr "userservice/internal/usecase/errors/batchgetusers"
+	batchgetmodel "userservice/internal/usecase/models/batchgetusers"
+)
+
+const MaxBatchSize = 100
+
+type BatchGetUsersUC struct {
+	log *slog.Logger
+
+	storageRepo storagerepo.StorageRepo
+}
+
+func NewBatchGetUsersUC(log *slog.Logger, storageRepo storagerepo.StorageRepo) *BatchGetUsersUC {
+	return &BatchGetUsersUC{
+		log:         log,
+		storageRepo: storageRepo,
+	}
+}
+
+func (b *BatchGetUsersUC) Execute(ctx context.Context, in *batchgetmodel.BatchGetUsersInput) (*batchgetmodel.BatchGetUsersOutput, error) {
+	const op = "batchgetusers.Execute"
+	log := b.log.With(slog.String("op", op), slog.Int("requested", len(in.UserIds)))
+
+	log.InfoContext(ctx, "batch get users started")
+
+	ids := uniqueIds(in.UserIds)
+	if len(ids) > MaxBatchSize {
+		log.InfoContext(ctx, "batch get users stopped: too many ids")
+		return nil, batchgeterr.ErrTooManyIds
+	}
+	if len(ids) == 0 {
+		log.InfoContext(ctx, "batch get users completed: nothing to fetch")
+		return batchgetmodel.NewBatchGetUsersOutput([]*userdomain.UserDomain{}), nil
+	}
+
+	users, err := b.storageRepo.FindByIds(ctx, ids)
+	if err != nil {
+		log.WarnContext(ctx, "batch get users stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "batch get users completed successfully", slog.Int("found", len(users)))
+
+	return batchgetmodel.NewBatchGetUsersOutput(users), nil
+}
+
+func uniqueIds(userIds []uint32) []uint32 {
+	seen := make(map[uint32]struct{}, len(userIds))
+	ids := make([]uint32, 0, len(userIds))
+	for _, id := range userIds {
+		if id == 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
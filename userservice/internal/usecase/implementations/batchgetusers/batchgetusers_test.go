@@ -0,0 +1,102 @@
+package batchgetusers
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	userdomain "userservice/internal/domain/user"
+	batchgeterr "userservice/internal/usecase/errors/batchgetusers"
+	batchgetmocks "userservice/internal/usecase/implementations/batchgetusers/mocks"
+	batchgetmodel "userservice/internal/usecase/models/batchgetusers"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=batchgetmocks
+func TestBatchGetUsers(t *testing.T) {
+	users := []*userdomain.UserDomain{
+		userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "somePass", "ivan@gmail.com"),
+		userdomain.NewUserDomain(2, "Petr", "", "Petrov", "somePass", "petr@gmail.com"),
+	}
+
+	tooMany := make([]uint32, 0, MaxBatchSize+1)
+	for i := 1; i <= MaxBatchSize+1; i++ {
+		tooMany = append(tooMany, uint32(i))
+	}
+
+	tests := []struct {
+		testName string
+
+		expStorage bool
+		storInput  []uint32
+		storOutput []*userdomain.UserDomain
+		storErr    error
+
+		in        *batchgetmodel.BatchGetUsersInput
+		expOutput *batchgetmodel.BatchGetUsersOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			expStorage: true,
+			storInput:  []uint32{1, 2, 3},
+			storOutput: users,
+			storErr:    nil,
+
+			in:        batchgetmodel.NewBatchGetUsersInput([]uint32{1, 2, 3}),
+			expOutput: batchgetmodel.NewBatchGetUsersOutput(users),
+			expErr:    nil,
+		}, {
+			testName: "Duplicates and zero ids dropped",
+
+			expStorage: true,
+			storInput:  []uint32{2, 1},
+			storOutput: users,
+			storErr:    nil,
+
+			in:        batchgetmodel.NewBatchGetUsersInput([]uint32{2, 0, 1, 2}),
+			expOutput: batchgetmodel.NewBatchGetUsersOutput(users),
+			expErr:    nil,
+		}, {
+			testName: "Empty input",
+
+			expStorage: false,
+
+			in:        batchgetmodel.NewBatchGetUsersInput(nil),
+			expOutput: batchgetmodel.NewBatchGetUsersOutput([]*userdomain.UserDomain{}),
+			expErr:    nil,
+		}, {
+			testName: "Too many ids",
+
+			expStorage: false,
+
+			in:        batchgetmodel.NewBatchGetUsersInput(tooMany),
+			expOutput: nil,
+			expErr:    batchgeterr.ErrTooManyIds,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			storMock := batchgetmocks.NewMockStorageRepo(ctrl)
+
+			if tt.expStorage {
+				storMock.EXPECT().FindByIds(gomock.Any(), tt.storInput).
+					Return(tt.storOutput, tt.storErr)
+			}
+
+			batchGet := NewBatchGetUsersUC(log, storMock)
+
+			out, err := batchGet.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package changepassword
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/hasher"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	changepasserr "userservice/internal/usecase/errors/changepassword"
+	changepassmodel "userservice/internal/usecase/models/changepassword"
+)
+
+type ChangePasswordUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	storageRepo storagerepo.StorageRepo
+	passHasher  hasher.PasswordHasher
+	refreshRepo token.RefreshTokenRepo
+}
+
+func NewChangePasswordUC(
+	log *slog.Logger,
+	sessionRepo session.SessionRepo,
+	storageRepo storagerepo.StorageRepo,
+	passHasher hasher.PasswordHasher,
+	refreshRepo token.RefreshTokenRepo,
+) *ChangePasswordUC {
+	return &ChangePasswordUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		storageRepo: storageRepo,
+		passHasher:  passHasher,
+		refreshRepo: refreshRepo,
+	}
+}
+
+func (c *ChangePasswordUC) Execute(ctx context.Context, in *changepassmodel.ChangePasswordInput) (*changepassmodel.ChangePasswordOutput, error) {
+	const op = "changepassword.Execute"
+	log := c.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "change password started")
+
+	if in.OldPassword == in.NewPassword {
+		log.InfoContext(ctx, "change password stopped: new password equals old")
+		return changepassmodel.NewChangePasswordOutput(false), changepasserr.ErrSamePassword
+	}
+
+	current, err := c.sessionRepo.GetSession(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "change password stopped: session not found")
+			return changepassmodel.NewChangePasswordOutput(false), changepasserr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "change password stopped", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(current.UserId)))
+
+	ud, err := c.storageRepo.FindById(ctx, current.UserId)
+	if err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "change password stopped: user not found")
+			return changepassmodel.NewChangePasswordOutput(false), changepasserr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "change password stopped: cannot get user", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	if err := c.passHasher.ComparePassword([]byte(ud.HashPassword), []byte(in.OldPassword)); err != nil {
+		if errors.Is(err, hasher.ErrWrongPassword) {
+			log.InfoContext(ctx, "change password stopped: wrong password")
+			return changepassmodel.NewChangePasswordOutput(false), changepasserr.ErrWrongPassword
+		}
+		log.WarnContext(ctx, "change password stopped", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	hashPass, err := c.passHasher.Hash([]byte(in.NewPassword))
+	if err != nil {
+		log.WarnContext(ctx, "change password stopped: cannot hash password", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	if err := c.storageRepo.UpdatePassword(ctx, ud.Id, string(hashPass)); err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "change password stopped: user not found")
+			return changepassmodel.NewChangePasswordOutput(false), changepasserr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "change password stopped: cannot update password", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	sessions, err := c.sessionRepo.GetAllForUser(ctx, ud.Id)
+	if err != nil {
+		log.WarnContext(ctx, "change password stopped: cannot get sessions", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	for _, s := range sessions {
+		if s.Id == current.Id {
+			continue
+		}
+		if err := c.sessionRepo.DeleteForUser(ctx, ud.Id, s.Id); err != nil && !errors.Is(err, session.ErrKeyNotFound) {
+			log.WarnContext(ctx, "change password stopped: cannot revoke session", slog.String("error", err.Error()))
+			return changepassmodel.NewChangePasswordOutput(false), err
+		}
+	}
+
+	// the current session stays, but a leaked refresh token of it must not
+	if err := c.refreshRepo.DeleteAllForUser(ctx, ud.Id); err != nil {
+		log.WarnContext(ctx, "change password stopped: cannot revoke refresh tokens", slog.String("error", err.Error()))
+		return changepassmodel.NewChangePasswordOutput(false), err
+	}
+
+	log.InfoContext(ctx, "change password completed successfully")
+
+	return changepassmodel.NewChangePasswordOutput(true), nil
+}
@@ -0,0 +1,243 @@
+package changepassword
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	sessiondomain "userservice/internal/domain/session"
+	userdomain "userservice/internal/domain/user"
+	"userservice/internal/repository/hasher"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	changepasserr "userservice/internal/usecase/errors/changepassword"
+	changepassmocks "userservice/internal/usecase/implementations/changepassword/mocks"
+	changepassmodel "userservice/internal/usecase/models/changepassword"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=changepassmocks
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=mocks/mock_session.go -package=changepassmocks
+//go:generate mockgen -source=./../../../repository/hasher/password_hasher.go -destination=mocks/mock_password_hasher.go -package=changepassmocks
+//go:generate mockgen -source=./../../../repository/token/refreshtokenrepo.go -destination=mocks/mock_refresh_token.go -package=changepassmocks
+func TestChangePassword(t *testing.T) {
+	errRedis := errors.New("redis error")
+
+	current := &sessiondomain.SessionDomain{Id: "1", UserId: 1}
+	user := &userdomain.UserDomain{Id: 1, FirstName: "Ivan", LastName: "Ivanov", HashPassword: "oldHash", Email: "ivan@mail.ru"}
+
+	tests := []struct {
+		testName string
+
+		expGetSession    bool
+		getSessionReturn *sessiondomain.SessionDomain
+		getSessionErr    error
+
+		expFindById    bool
+		findByIdReturn *userdomain.UserDomain
+		findByIdErr    error
+
+		expCompare bool
+		compareErr error
+
+		expHash    bool
+		hashReturn []byte
+		hashErr    error
+
+		expUpdate bool
+		updateErr error
+
+		expGetAll    bool
+		getAllReturn []*sessiondomain.SessionDomain
+		getAllErr    error
+
+		expDeleteIds []string
+		deleteErr    error
+
+		expDeleteRefresh bool
+		deleteRefreshErr error
+
+		in     *changepassmodel.ChangePasswordInput
+		expOut *changepassmodel.ChangePasswordOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			expGetSession:    true,
+			getSessionReturn: current,
+
+			expFindById:    true,
+			findByIdReturn: user,
+
+			expCompare: true,
+
+			expHash:    true,
+			hashReturn: []byte("newHash"),
+
+			expUpdate: true,
+
+			expGetAll: true,
+			getAllReturn: []*sessiondomain.SessionDomain{
+				current,
+				{Id: "2", UserId: 1},
+				{Id: "3", UserId: 1},
+			},
+
+			expDeleteIds: []string{"2", "3"},
+
+			expDeleteRefresh: true,
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "new"),
+			expOut: changepassmodel.NewChangePasswordOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Cannot revoke refresh tokens",
+
+			expGetSession:    true,
+			getSessionReturn: current,
+
+			expFindById:    true,
+			findByIdReturn: user,
+
+			expCompare: true,
+
+			expHash:    true,
+			hashReturn: []byte("newHash"),
+
+			expUpdate: true,
+
+			expGetAll:    true,
+			getAllReturn: []*sessiondomain.SessionDomain{current},
+
+			expDeleteRefresh: true,
+			deleteRefreshErr: errRedis,
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "new"),
+			expOut: changepassmodel.NewChangePasswordOutput(false),
+			expErr: errRedis,
+		}, {
+			testName: "Same password",
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "old"),
+			expOut: changepassmodel.NewChangePasswordOutput(false),
+			expErr: changepasserr.ErrSamePassword,
+		}, {
+			testName: "Session not found",
+
+			expGetSession: true,
+			getSessionErr: session.ErrKeyNotFound,
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "new"),
+			expOut: changepassmodel.NewChangePasswordOutput(false),
+			expErr: changepasserr.ErrSessionNotFound,
+		}, {
+			testName: "User not found",
+
+			expGetSession:    true,
+			getSessionReturn: current,
+
+			expFindById: true,
+			findByIdErr: storagerepo.ErrNoRows,
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "new"),
+			expOut: changepassmodel.NewChangePasswordOutput(false),
+			expErr: changepasserr.ErrUserNotFound,
+		}, {
+			testName: "Wrong password",
+
+			expGetSession:    true,
+			getSessionReturn: current,
+
+			expFindById:    true,
+			findByIdReturn: user,
+
+			expCompare: true,
+			compareErr: hasher.ErrWrongPassword,
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "new"),
+			expOut: changepassmodel.NewChangePasswordOutput(false),
+			expErr: changepasserr.ErrWrongPassword,
+		}, {
+			testName: "Cannot revoke sessions",
+
+			expGetSession:    true,
+			getSessionReturn: current,
+
+			expFindById:    true,
+			findByIdReturn: user,
+
+			expCompare: true,
+
+			expHash:    true,
+			hashReturn: []byte("newHash"),
+
+			expUpdate: true,
+
+			expGetAll: true,
+			getAllErr: errRedis,
+
+			in:     changepassmodel.NewChangePasswordInput("sessionId", "old", "new"),
+			expOut: changepassmodel.NewChangePasswordOutput(false),
+			expErr: errRedis,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			sessionMock := changepassmocks.NewMockSessionRepo(ctrl)
+			if tt.expGetSession {
+				sessionMock.EXPECT().GetSession(gomock.Any(), tt.in.SessionId).
+					Return(tt.getSessionReturn, tt.getSessionErr)
+			}
+			if tt.expGetAll {
+				sessionMock.EXPECT().GetAllForUser(gomock.Any(), current.UserId).
+					Return(tt.getAllReturn, tt.getAllErr)
+			}
+			for _, id := range tt.expDeleteIds {
+				sessionMock.EXPECT().DeleteForUser(gomock.Any(), current.UserId, id).
+					Return(tt.deleteErr)
+			}
+
+			storageMock := changepassmocks.NewMockStorageRepo(ctrl)
+			if tt.expFindById {
+				storageMock.EXPECT().FindById(gomock.Any(), current.UserId).
+					Return(tt.findByIdReturn, tt.findByIdErr)
+			}
+			if tt.expUpdate {
+				storageMock.EXPECT().UpdatePassword(gomock.Any(), user.Id, string(tt.hashReturn)).
+					Return(tt.updateErr)
+			}
+
+			hasherMock := changepassmocks.NewMockPasswordHasher(ctrl)
+			if tt.expCompare {
+				hasherMock.EXPECT().ComparePassword([]byte(user.HashPassword), []byte(tt.in.OldPassword)).
+					Return(tt.compareErr)
+			}
+			if tt.expHash {
+				hasherMock.EXPECT().Hash([]byte(tt.in.NewPassword)).
+					Return(tt.hashReturn, tt.hashErr)
+			}
+
+			refreshMock := changepassmocks.NewMockRefreshTokenRepo(ctrl)
+			if tt.expDeleteRefresh {
+				refreshMock.EXPECT().DeleteAllForUser(gomock.Any(), user.Id).
+					Return(tt.deleteRefreshErr)
+			}
+
+			changePassUC := NewChangePasswordUC(log, sessionMock, storageMock, hasherMock, refreshMock)
+
+			out, err := changePassUC.Execute(context.Background(), tt.in)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
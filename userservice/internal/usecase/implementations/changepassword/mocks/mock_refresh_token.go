@@ -0,0 +1,85 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/token/refreshtokenrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/token/refreshtokenrepo.go -destination=mocks/mock_refresh_token.go -package=changepassmocks
+//
+
+// Package changepassmocks is a generated GoMock package.
+package changepassmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRefreshTokenRepo is a mock of RefreshTokenRepo interface.
+type MockRefreshTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefreshTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockRefreshTokenRepoMockRecorder is the mock recorder for MockRefreshTokenRepo.
+type MockRefreshTokenRepoMockRecorder struct {
+	mock *MockRefreshTokenRepo
+}
+
+// NewMockRefreshTokenRepo creates a new mock instance.
+func NewMockRefreshTokenRepo(ctrl *gomock.Controller) *MockRefreshTokenRepo {
+	mock := &MockRefreshTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockRefreshTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefreshTokenRepo) EXPECT() *MockRefreshTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockRefreshTokenRepo) Consume(ctx context.Context, token string) (*refreshtokendomain.RefreshTokenDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, token)
+	ret0, _ := ret[0].(*refreshtokendomain.RefreshTokenDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockRefreshTokenRepoMockRecorder) Consume(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockRefreshTokenRepo)(nil).Consume), ctx, token)
+}
+
+// DeleteAllForUser mocks base method.
+func (m *MockRefreshTokenRepo) DeleteAllForUser(ctx context.Context, userId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllForUser", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllForUser indicates an expected call of DeleteAllForUser.
+func (mr *MockRefreshTokenRepoMockRecorder) DeleteAllForUser(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllForUser", reflect.TypeOf((*MockRefreshTokenRepo)(nil).DeleteAllForUser), ctx, userId)
+}
+
+// Save mocks base method.
+func (m *MockRefreshTokenRepo) Save(ctx context.Context, token string, rt *refreshtokendomain.RefreshTokenDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, token, rt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockRefreshTokenRepoMockRecorder) Save(ctx, token, rt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockRefreshTokenRepo)(nil).Save), ctx, token, rt)
+}
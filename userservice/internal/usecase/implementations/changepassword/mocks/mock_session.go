@@ -0,0 +1,158 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/session/sessionrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/session/sessionrepo.go -destination=mocks/mock_session.go -package=changepassmocks
+//
+
+// Package changepassmocks is a generated GoMock package.
+package changepassmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	sessiondomain "userservice/internal/domain/session"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockSessionRepo is a mock of SessionRepo interface.
+type MockSessionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockSessionRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockSessionRepoMockRecorder is the mock recorder for MockSessionRepo.
+type MockSessionRepoMockRecorder struct {
+	mock *MockSessionRepo
+}
+
+// NewMockSessionRepo creates a new mock instance.
+func NewMockSessionRepo(ctrl *gomock.Controller) *MockSessionRepo {
+	mock := &MockSessionRepo{ctrl: ctrl}
+	mock.recorder = &MockSessionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSessionRepo) EXPECT() *MockSessionRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockSessionRepo) Delete(ctx context.Context, sessionId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, sessionId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockSessionRepoMockRecorder) Delete(ctx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSessionRepo)(nil).Delete), ctx, sessionId)
+}
+
+// DeleteAllForUser mocks base method.
+func (m *MockSessionRepo) DeleteAllForUser(ctx context.Context, userId uint32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAllForUser", ctx, userId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAllForUser indicates an expected call of DeleteAllForUser.
+func (mr *MockSessionRepoMockRecorder) DeleteAllForUser(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAllForUser", reflect.TypeOf((*MockSessionRepo)(nil).DeleteAllForUser), ctx, userId)
+}
+
+// DeleteForUser mocks base method.
+func (m *MockSessionRepo) DeleteForUser(ctx context.Context, userId uint32, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteForUser", ctx, userId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteForUser indicates an expected call of DeleteForUser.
+func (mr *MockSessionRepoMockRecorder) DeleteForUser(ctx, userId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteForUser", reflect.TypeOf((*MockSessionRepo)(nil).DeleteForUser), ctx, userId, id)
+}
+
+// Get mocks base method.
+func (m *MockSessionRepo) Get(ctx context.Context, sessionId string) (uint32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, sessionId)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockSessionRepoMockRecorder) Get(ctx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSessionRepo)(nil).Get), ctx, sessionId)
+}
+
+// GetAllForUser mocks base method.
+func (m *MockSessionRepo) GetAllForUser(ctx context.Context, userId uint32) ([]*sessiondomain.SessionDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllForUser", ctx, userId)
+	ret0, _ := ret[0].([]*sessiondomain.SessionDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllForUser indicates an expected call of GetAllForUser.
+func (mr *MockSessionRepoMockRecorder) GetAllForUser(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllForUser", reflect.TypeOf((*MockSessionRepo)(nil).GetAllForUser), ctx, userId)
+}
+
+// GetSession mocks base method.
+func (m *MockSessionRepo) GetSession(ctx context.Context, sessionId string) (*sessiondomain.SessionDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionId)
+	ret0, _ := ret[0].(*sessiondomain.SessionDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockSessionRepoMockRecorder) GetSession(ctx, sessionId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockSessionRepo)(nil).GetSession), ctx, sessionId)
+}
+
+// Save mocks base method.
+func (m *MockSessionRepo) Save(ctx context.Context, sessionId string, s *sessiondomain.SessionDomain) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, sessionId, s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockSessionRepoMockRecorder) Save(ctx, sessionId, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockSessionRepo)(nil).Save), ctx, sessionId, s)
+}
+
+// Touch mocks base method.
+func (m *MockSessionRepo) Touch(ctx context.Context, sessionId string, lastSeen time.Time, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Touch", ctx, sessionId, lastSeen, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Touch indicates an expected call of Touch.
+func (mr *MockSessionRepoMockRecorder) Touch(ctx, sessionId, lastSeen, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Touch", reflect.TypeOf((*MockSessionRepo)(nil).Touch), ctx, sessionId, lastSeen, ttl)
+}
@@ -0,0 +1,91 @@
+package confirmreset
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/apitoken"
+	"userservice/internal/repository/hasher"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	confreseterr "userservice/internal/usecase/errors/confirmreset"
+	confresetmodel "userservice/internal/usecase/models/confirmreset"
+)
+
+type ConfirmResetUC struct {
+	log *slog.Logger
+
+	tokenRepo   token.TokenRepo
+	storageRepo storagerepo.StorageRepo
+	sessionRepo session.SessionRepo
+	apiTokRepo  apitoken.ApiTokenRepo
+	passHasher  hasher.PasswordHasher
+}
+
+func NewConfirmResetUC(
+	log *slog.Logger,
+	tokenRepo token.TokenRepo,
+	storageRepo storagerepo.StorageRepo,
+	sessionRepo session.SessionRepo,
+	apiTokRepo apitoken.ApiTokenRepo,
+	passHasher hasher.PasswordHasher,
+) *ConfirmResetUC {
+	return &ConfirmResetUC{
+		log:         log,
+		tokenRepo:   tokenRepo,
+		storageRepo: storageRepo,
+		sessionRepo: sessionRepo,
+		apiTokRepo:  apiTokRepo,
+		passHasher:  passHasher,
+	}
+}
+
+func (c *ConfirmResetUC) Execute(ctx context.Context, in *confresetmodel.ConfirmResetInput) (*confresetmodel.ConfirmResetOutput, error) {
+	const op = "confirmreset.Execute"
+	log := c.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "password reset confirm started")
+
+	userId, err := c.tokenRepo.Consume(ctx, in.Token)
+	if err != nil {
+		if errors.Is(err, token.ErrTokenNotFound) {
+			log.InfoContext(ctx, "password reset confirm stopped: token not found")
+			return confresetmodel.NewConfirmResetOutput(false), confreseterr.ErrInvalidToken
+		}
+		log.WarnContext(ctx, "password reset confirm stopped", slog.String("error", err.Error()))
+		return confresetmodel.NewConfirmResetOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	hashPass, err := c.passHasher.Hash([]byte(in.NewPassword))
+	if err != nil {
+		log.WarnContext(ctx, "password reset confirm stopped: cannot hash password", slog.String("error", err.Error()))
+		return confresetmodel.NewConfirmResetOutput(false), err
+	}
+
+	if err := c.storageRepo.UpdatePassword(ctx, userId, string(hashPass)); err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "password reset confirm stopped: user not found")
+			return confresetmodel.NewConfirmResetOutput(false), confreseterr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "password reset confirm stopped: cannot update password", slog.String("error", err.Error()))
+		return confresetmodel.NewConfirmResetOutput(false), err
+	}
+
+	if err := c.sessionRepo.DeleteAllForUser(ctx, userId); err != nil {
+		log.WarnContext(ctx, "password reset confirm stopped: cannot revoke sessions", slog.String("error", err.Error()))
+		return confresetmodel.NewConfirmResetOutput(false), err
+	}
+
+	// api tokens outlive sessions, whoever held the old password may have made some
+	if err := c.apiTokRepo.DeleteApiTokensForUser(ctx, userId); err != nil {
+		log.WarnContext(ctx, "password reset confirm stopped: cannot revoke api tokens", slog.String("error", err.Error()))
+		return confresetmodel.NewConfirmResetOutput(false), err
+	}
+
+	log.InfoContext(ctx, "password reset confirm completed successfully")
+
+	return confresetmodel.NewConfirmResetOutput(true), nil
+}
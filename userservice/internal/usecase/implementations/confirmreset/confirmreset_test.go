@@ -0,0 +1,164 @@
+package confirmreset
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	confreseterr "userservice/internal/usecase/errors/confirmreset"
+	confresetmocks "userservice/internal/usecase/implementations/confirmreset/mocks"
+	confresetmodel "userservice/internal/usecase/models/confirmreset"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/token/tokenrepo.go -destination=mocks/mock_token.go -package=confresetmocks
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=confresetmocks
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=mocks/mock_session.go -package=confresetmocks
+//go:generate mockgen -source=./../../../repository/apitoken/apitokenrepo.go -destination=mocks/mock_apitoken.go -package=confresetmocks
+//go:generate mockgen -source=./../../../repository/hasher/password_hasher.go -destination=mocks/mock_password_hasher.go -package=confresetmocks
+func TestConfirmReset(t *testing.T) {
+	errRedis := errors.New("redis error")
+	errDB := errors.New("db error")
+
+	tests := []struct {
+		testName string
+
+		consumeReturn uint32
+		consumeErr    error
+
+		expHash bool
+		hashErr error
+
+		expUpdate bool
+		updateErr error
+
+		expDeleteAll bool
+		deleteAllErr error
+
+		expDeleteTokens bool
+		deleteTokensErr error
+
+		in     *confresetmodel.ConfirmResetInput
+		expOut *confresetmodel.ConfirmResetOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			consumeReturn: 1,
+
+			expHash: true,
+
+			expUpdate: true,
+
+			expDeleteAll: true,
+
+			expDeleteTokens: true,
+
+			in:     confresetmodel.NewConfirmResetInput("token", "new"),
+			expOut: confresetmodel.NewConfirmResetOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Invalid token",
+
+			consumeErr: token.ErrTokenNotFound,
+
+			in:     confresetmodel.NewConfirmResetInput("token", "new"),
+			expOut: confresetmodel.NewConfirmResetOutput(false),
+			expErr: confreseterr.ErrInvalidToken,
+		}, {
+			testName: "User not found",
+
+			consumeReturn: 1,
+
+			expHash: true,
+
+			expUpdate: true,
+			updateErr: storagerepo.ErrNoRows,
+
+			in:     confresetmodel.NewConfirmResetInput("token", "new"),
+			expOut: confresetmodel.NewConfirmResetOutput(false),
+			expErr: confreseterr.ErrUserNotFound,
+		}, {
+			testName: "Cannot revoke sessions",
+
+			consumeReturn: 1,
+
+			expHash: true,
+
+			expUpdate: true,
+
+			expDeleteAll: true,
+			deleteAllErr: errRedis,
+
+			in:     confresetmodel.NewConfirmResetInput("token", "new"),
+			expOut: confresetmodel.NewConfirmResetOutput(false),
+			expErr: errRedis,
+		}, {
+			testName: "Cannot revoke api tokens",
+
+			consumeReturn: 1,
+
+			expHash: true,
+
+			expUpdate: true,
+
+			expDeleteAll: true,
+
+			expDeleteTokens: true,
+			deleteTokensErr: errDB,
+
+			in:     confresetmodel.NewConfirmResetInput("token", "new"),
+			expOut: confresetmodel.NewConfirmResetOutput(false),
+			expErr: errDB,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			tokenMock := confresetmocks.NewMockTokenRepo(ctrl)
+			tokenMock.EXPECT().Consume(gomock.Any(), tt.in.Token).
+				Return(tt.consumeReturn, tt.consumeErr)
+
+			hasherMock := confresetmocks.NewMockPasswordHasher(ctrl)
+			if tt.expHash {
+				hasherMock.EXPECT().Hash([]byte(tt.in.NewPassword)).
+					Return([]byte("hash"), tt.hashErr)
+			}
+
+			storageMock := confresetmocks.NewMockStorageRepo(ctrl)
+			if tt.expUpdate {
+				storageMock.EXPECT().UpdatePassword(gomock.Any(), tt.consumeReturn, "hash").
+					Return(tt.updateErr)
+			}
+
+			sessionMock := confresetmocks.NewMockSessionRepo(ctrl)
+			if tt.expDeleteAll {
+				sessionMock.EXPECT().DeleteAllForUser(gomock.Any(), tt.consumeReturn).
+					Return(tt.deleteAllErr)
+			}
+
+			apiTokMock := confresetmocks.NewMockApiTokenRepo(ctrl)
+			if tt.expDeleteTokens {
+				apiTokMock.EXPECT().DeleteApiTokensForUser(gomock.Any(), tt.consumeReturn).
+					Return(tt.deleteTokensErr)
+			}
+
+			confResetUC := NewConfirmResetUC(log, tokenMock, storageMock, sessionMock, apiTokMock, hasherMock)
+
+			out, err := confResetUC.Execute(context.Background(), tt.in)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package createapitoken
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	"userservice/internal/repository/apitoken"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/session"
+	createtokenerr "userservice/internal/usecase/errors/createapitoken"
+	createtokenmodel "userservice/internal/usecase/models/createapitoken"
+)
+
+type CreateApiTokenUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	tokenRepo   apitoken.ApiTokenRepo
+	idgen       idgenerator.IDGenerator
+}
+
+func NewCreateApiTokenUC(
+	log *slog.Logger,
+	sessionRepo session.SessionRepo,
+	tokenRepo apitoken.ApiTokenRepo,
+	idgen idgenerator.IDGenerator,
+) *CreateApiTokenUC {
+	return &CreateApiTokenUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		tokenRepo:   tokenRepo,
+		idgen:       idgen,
+	}
+}
+
+func (c *CreateApiTokenUC) Execute(ctx context.Context, in *createtokenmodel.CreateTokenInput) (*createtokenmodel.CreateTokenOutput, error) {
+	const op = "createapitoken.Execute"
+	log := c.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "create api token started")
+
+	if in.TTL < 0 {
+		log.InfoContext(ctx, "create api token stopped: invalid ttl")
+		return nil, createtokenerr.ErrInvalidTTL
+	}
+
+	current, err := c.sessionRepo.GetSession(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "create api token stopped: session not found")
+			return nil, createtokenerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "create api token stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(current.UserId)))
+
+	now := time.Now().UTC()
+	var expiresAt *time.Time
+	if in.TTL > 0 {
+		exp := now.Add(in.TTL)
+		expiresAt = &exp
+	}
+
+	secret := apitokendomain.NewSecret(c.idgen.New())
+
+	td, err := apitokendomain.NewApiTokenDomain(current.UserId, in.Name, secret, now, expiresAt)
+	if err != nil {
+		log.InfoContext(ctx, "create api token stopped: invalid token data", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	tokenId, err := c.tokenRepo.SaveApiToken(ctx, td)
+	if err != nil {
+		log.WarnContext(ctx, "create api token stopped: cannot save token", slog.String("error", err.Error()))
+		return nil, err
+	}
+	td.Id = tokenId
+
+	log.InfoContext(ctx, "create api token completed successfully", slog.Uint64("token_id", uint64(tokenId)))
+
+	return createtokenmodel.NewCreateTokenOutput(secret, td), nil
+}
@@ -0,0 +1,164 @@
+package createapitoken
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	apitokendomain "userservice/internal/domain/apitoken"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/session"
+	createtokenerr "userservice/internal/usecase/errors/createapitoken"
+	createtokenmocks "userservice/internal/usecase/implementations/createapitoken/mocks"
+	createtokenmodel "userservice/internal/usecase/models/createapitoken"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=createtokenmocks
+//go:generate mockgen -source=./../../../repository/apitoken/apitokenrepo.go -destination=./mocks/mock_apitoken.go -package=createtokenmocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=./mocks/mock_id_generator.go -package=createtokenmocks
+func TestCreateApiToken(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expGetSession    bool
+		getSessionOutput *sessiondomain.SessionDomain
+		getSessionErr    error
+
+		expSave     bool
+		saveOutput  uint32
+		saveErr     error
+		expName     string
+		expExpiring bool
+
+		in        *createtokenmodel.CreateTokenInput
+		expSecret string
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			expGetSession:    true,
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expSave:     true,
+			saveOutput:  7,
+			saveErr:     nil,
+			expName:     "ci",
+			expExpiring: true,
+
+			in:        createtokenmodel.NewCreateTokenInput("sessionId", " ci ", 24*time.Hour),
+			expSecret: "pat_0123456789abcdef0123456789abcdef",
+			expErr:    nil,
+		}, {
+			testName: "Success without expiration",
+
+			expGetSession:    true,
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expSave:     true,
+			saveOutput:  8,
+			saveErr:     nil,
+			expName:     "ci",
+			expExpiring: false,
+
+			in:        createtokenmodel.NewCreateTokenInput("sessionId", "ci", 0),
+			expSecret: "pat_0123456789abcdef0123456789abcdef",
+			expErr:    nil,
+		}, {
+			testName: "Invalid ttl",
+
+			expGetSession: false,
+			expSave:       false,
+
+			in:     createtokenmodel.NewCreateTokenInput("sessionId", "ci", -time.Hour),
+			expErr: createtokenerr.ErrInvalidTTL,
+		}, {
+			testName: "Session not found",
+
+			expGetSession:    true,
+			getSessionOutput: nil,
+			getSessionErr:    session.ErrKeyNotFound,
+
+			expSave: false,
+
+			in:     createtokenmodel.NewCreateTokenInput("sessionId", "ci", 0),
+			expErr: createtokenerr.ErrSessionNotFound,
+		}, {
+			testName: "Invalid name",
+
+			expGetSession:    true,
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expSave: false,
+
+			in:     createtokenmodel.NewCreateTokenInput("sessionId", "  ", 0),
+			expErr: apitokendomain.ErrInvalidName,
+		}, {
+			testName: "Storage error",
+
+			expGetSession:    true,
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expSave:    true,
+			saveOutput: 0,
+			saveErr:    errors.New("db down"),
+			expName:    "ci",
+
+			in:     createtokenmodel.NewCreateTokenInput("sessionId", "ci", 0),
+			expErr: errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := createtokenmocks.NewMockSessionRepo(ctrl)
+			tokenMock := createtokenmocks.NewMockApiTokenRepo(ctrl)
+			idgenMock := createtokenmocks.NewMockIDGenerator(ctrl)
+
+			if tt.expGetSession {
+				sessionMock.EXPECT().GetSession(gomock.Any(), tt.in.SessionId).
+					Return(tt.getSessionOutput, tt.getSessionErr)
+			}
+			if tt.expGetSession && tt.getSessionErr == nil {
+				idgenMock.EXPECT().New().Return("01234567-89ab-cdef-0123-456789abcdef")
+			}
+			if tt.expSave {
+				tokenMock.EXPECT().SaveApiToken(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, td *apitokendomain.ApiTokenDomain) (uint32, error) {
+						require.Equal(t, uint32(1), td.UserId)
+						require.Equal(t, tt.expName, td.Name)
+						require.Equal(t, apitokendomain.HashSecret("pat_0123456789abcdef0123456789abcdef"), td.Hash)
+						require.Equal(t, "pat_01234567", td.Prefix)
+						require.Equal(t, tt.expExpiring, td.ExpiresAt != nil)
+						return tt.saveOutput, tt.saveErr
+					})
+			}
+
+			createUC := NewCreateApiTokenUC(log, sessionMock, tokenMock, idgenMock)
+
+			out, err := createUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			if tt.expErr != nil {
+				require.Nil(t, out)
+				return
+			}
+			require.Equal(t, tt.expSecret, out.Secret)
+			require.Equal(t, tt.saveOutput, out.Token.Id)
+		})
+	}
+}
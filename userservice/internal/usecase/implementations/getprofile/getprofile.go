@@ -0,0 +1,59 @@
+package getprofile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	getprofileerr "userservice/internal/usecase/errors/getprofile"
+	getprofilemodel "userservice/internal/usecase/models/getprofile"
+)
+
+type GetProfileUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	storageRepo storagerepo.StorageRepo
+}
+
+func NewGetProfileUC(log *slog.Logger, sessionRepo session.SessionRepo, storageRepo storagerepo.StorageRepo) *GetProfileUC {
+	return &GetProfileUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		storageRepo: storageRepo,
+	}
+}
+
+func (g *GetProfileUC) Execute(ctx context.Context, in *getprofilemodel.GetProfileInput) (*getprofilemodel.GetProfileOutput, error) {
+	const op = "getprofile.Execute"
+	log := g.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "get profile started")
+
+	userId, err := g.sessionRepo.Get(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "get profile stopped: session not found")
+			return nil, getprofileerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "get profile stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	ud, err := g.storageRepo.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "get profile stopped: user not found")
+			return nil, getprofileerr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "get profile stopped: cannot get user", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "get profile completed successfully")
+
+	return getprofilemodel.NewGetProfileOutput(ud), nil
+}
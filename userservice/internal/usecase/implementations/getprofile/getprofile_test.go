@@ -0,0 +1,98 @@
+package getprofile
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	userdomain "userservice/internal/domain/user"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	getprofileerr "userservice/internal/usecase/errors/getprofile"
+	getprofilemocks "userservice/internal/usecase/implementations/getprofile/mocks"
+	getprofilemodel "userservice/internal/usecase/models/getprofile"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=getprofilemocks
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getprofilemocks
+func TestGetProfile(t *testing.T) {
+	user := userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "gmail@gmail.com")
+
+	tests := []struct {
+		testName string
+
+		sessionOutput uint32
+		sessionErr    error
+
+		expStorage bool
+		storOutput *userdomain.UserDomain
+		storErr    error
+
+		expOutput *getprofilemodel.GetProfileOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			sessionOutput: 1,
+			sessionErr:    nil,
+
+			expStorage: true,
+			storOutput: user,
+			storErr:    nil,
+
+			expOutput: getprofilemodel.NewGetProfileOutput(user),
+			expErr:    nil,
+		}, {
+			testName: "Session not found",
+
+			sessionOutput: 0,
+			sessionErr:    session.ErrKeyNotFound,
+
+			expStorage: false,
+
+			expOutput: nil,
+			expErr:    getprofileerr.ErrSessionNotFound,
+		}, {
+			testName: "User not found",
+
+			sessionOutput: 1,
+			sessionErr:    nil,
+
+			expStorage: true,
+			storOutput: nil,
+			storErr:    storagerepo.ErrNoRows,
+
+			expOutput: nil,
+			expErr:    getprofileerr.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			sessionMock := getprofilemocks.NewMockSessionRepo(ctrl)
+			sessionMock.EXPECT().Get(gomock.Any(), "sessionId").
+				Return(tt.sessionOutput, tt.sessionErr)
+
+			storMock := getprofilemocks.NewMockStorageRepo(ctrl)
+			if tt.expStorage {
+				storMock.EXPECT().FindById(gomock.Any(), tt.sessionOutput).
+					Return(tt.storOutput, tt.storErr)
+			}
+
+			getProfile := NewGetProfileUC(log, sessionMock, storMock)
+
+			out, err := getProfile.Execute(context.Background(), getprofilemodel.NewGetProfileInput("sessionId"))
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
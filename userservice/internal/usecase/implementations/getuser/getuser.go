@@ -0,0 +1,49 @@
+package getuser
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	storagerepo "userservice/internal/repository/storage"
+	getusererr "userservice/internal/usecase/errors/getuser"
+	getusermodel "userservice/internal/usecase/models/getuser"
+)
+
+type GetUserUC struct {
+	log *slog.Logger
+
+	storageRepo storagerepo.StorageRepo
+}
+
+func NewGetUserUC(log *slog.Logger, storageRepo storagerepo.StorageRepo) *GetUserUC {
+	return &GetUserUC{
+		log:         log,
+		storageRepo: storageRepo,
+	}
+}
+
+func (g *GetUserUC) Execute(ctx context.Context, in *getusermodel.GetUserInput) (*getusermodel.GetUserOutput, error) {
+	const op = "getuser.Execute"
+	log := g.log.With(slog.String("op", op), slog.Uint64("user_id", uint64(in.UserId)))
+
+	log.InfoContext(ctx, "get user started")
+
+	if in.UserId == 0 {
+		log.InfoContext(ctx, "get user stopped: invalid user id")
+		return nil, getusererr.ErrInvalidUserId
+	}
+
+	ud, err := g.storageRepo.FindById(ctx, in.UserId)
+	if err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "get user stopped: user not found")
+			return nil, getusererr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "get user stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "get user completed successfully")
+
+	return getusermodel.NewGetUserOutput(ud), nil
+}
@@ -0,0 +1,96 @@
+package getuser
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	userdomain "userservice/internal/domain/user"
+	storagerepo "userservice/internal/repository/storage"
+	getusererr "userservice/internal/usecase/errors/getuser"
+	getusermocks "userservice/internal/usecase/implementations/getuser/mocks"
+	getusermodel "userservice/internal/usecase/models/getuser"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=getusermocks
+func TestGetUser(t *testing.T) {
+	user := userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "somePass", "gmail@gmail.com")
+	storErr := errors.New("storage error")
+
+	tests := []struct {
+		testName string
+
+		expStorage bool
+		storOutput *userdomain.UserDomain
+		storErr    error
+
+		in        *getusermodel.GetUserInput
+		expOutput *getusermodel.GetUserOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			expStorage: true,
+			storOutput: user,
+			storErr:    nil,
+
+			in:        getusermodel.NewGetUserInput(1),
+			expOutput: getusermodel.NewGetUserOutput(user),
+			expErr:    nil,
+		}, {
+			testName: "Invalid user id",
+
+			expStorage: false,
+
+			in:        getusermodel.NewGetUserInput(0),
+			expOutput: nil,
+			expErr:    getusererr.ErrInvalidUserId,
+		}, {
+			testName: "User not found",
+
+			expStorage: true,
+			storOutput: nil,
+			storErr:    storagerepo.ErrNoRows,
+
+			in:        getusermodel.NewGetUserInput(1),
+			expOutput: nil,
+			expErr:    getusererr.ErrUserNotFound,
+		}, {
+			testName: "Storage error",
+
+			expStorage: true,
+			storOutput: nil,
+			storErr:    storErr,
+
+			in:        getusermodel.NewGetUserInput(1),
+			expOutput: nil,
+			expErr:    storErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			storMock := getusermocks.NewMockStorageRepo(ctrl)
+
+			if tt.expStorage {
+				storMock.EXPECT().FindById(gomock.Any(), tt.in.UserId).
+					Return(tt.storOutput, tt.storErr)
+			}
+
+			getUser := NewGetUserUC(log, storMock)
+
+			out, err := getUser.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
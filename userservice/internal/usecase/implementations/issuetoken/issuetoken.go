@@ -0,0 +1,81 @@
+package issuetoken
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	"userservice/internal/repository/accesstoken"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/session"
+	"userservice/internal/repository/token"
+	issuetokenerr "userservice/internal/usecase/errors/issuetoken"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+)
+
+type IssueTokenUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	issuer      accesstoken.AccessTokenIssuer
+	refreshRepo token.RefreshTokenRepo
+	idgen       idgenerator.IDGenerator
+	refreshTTL  time.Duration
+}
+
+func NewIssueTokenUC(
+	log *slog.Logger,
+	sessionRepo session.SessionRepo,
+	issuer accesstoken.AccessTokenIssuer,
+	refreshRepo token.RefreshTokenRepo,
+	idgen idgenerator.IDGenerator,
+	refreshTTL time.Duration,
+) *IssueTokenUC {
+	return &IssueTokenUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		issuer:      issuer,
+		refreshRepo: refreshRepo,
+		idgen:       idgen,
+		refreshTTL:  refreshTTL,
+	}
+}
+
+func (i *IssueTokenUC) Execute(ctx context.Context, in *issuetokenmodel.IssueTokenInput) (*issuetokenmodel.IssueTokenOutput, error) {
+	const op = "issuetoken.Execute"
+	log := i.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "issue token started")
+
+	userId, err := i.sessionRepo.Get(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "issue token stopped: session not found")
+			return nil, issuetokenerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "issue token stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	accessToken, expiresAt, err := i.issuer.Issue(ctx, userId)
+	if err != nil {
+		log.WarnContext(ctx, "issue token stopped: cannot sign access token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	// the lifetime is fixed here, rotations keep it
+	rt := refreshtokendomain.NewRefreshTokenDomain(userId, in.SessionId, time.Now().UTC().Add(i.refreshTTL))
+
+	refreshToken := i.idgen.New()
+	if err := i.refreshRepo.Save(ctx, refreshToken, rt); err != nil {
+		log.WarnContext(ctx, "issue token stopped: cannot save refresh token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "issue token completed successfully")
+
+	return issuetokenmodel.NewIssueTokenOutput(accessToken, expiresAt, refreshToken), nil
+}
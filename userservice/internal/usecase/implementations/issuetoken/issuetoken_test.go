@@ -0,0 +1,148 @@
+package issuetoken
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	"userservice/internal/repository/session"
+	issuetokenerr "userservice/internal/usecase/errors/issuetoken"
+	issuetokenmocks "userservice/internal/usecase/implementations/issuetoken/mocks"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=issuetokenmocks
+//go:generate mockgen -source=./../../../repository/accesstoken/token_issuer.go -destination=./mocks/mock_token_issuer.go -package=issuetokenmocks
+//go:generate mockgen -source=./../../../repository/token/refreshtokenrepo.go -destination=./mocks/mock_token.go -package=issuetokenmocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=./mocks/mock_id_generator.go -package=issuetokenmocks
+func TestIssueToken(t *testing.T) {
+	expiresAt := time.Now().Add(5 * time.Minute)
+
+	tests := []struct {
+		testName string
+
+		getInput  string
+		getOutput uint32
+		getErr    error
+
+		expIssue       bool
+		issueOutput    string
+		issueExpiresAt time.Time
+		issueErr       error
+
+		expSave bool
+		saveErr error
+
+		in        *issuetokenmodel.IssueTokenInput
+		expOutput *issuetokenmodel.IssueTokenOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			getInput:  "sessionId",
+			getOutput: 1,
+			getErr:    nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: nil,
+
+			in:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			expOutput: issuetokenmodel.NewIssueTokenOutput("access", expiresAt, "refresh"),
+			expErr:    nil,
+		}, {
+			testName: "Session not found",
+
+			getInput:  "sessionId",
+			getOutput: 0,
+			getErr:    session.ErrKeyNotFound,
+
+			expIssue: false,
+			expSave:  false,
+
+			in:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			expOutput: nil,
+			expErr:    issuetokenerr.ErrSessionNotFound,
+		}, {
+			testName: "Issuer error",
+
+			getInput:  "sessionId",
+			getOutput: 1,
+			getErr:    nil,
+
+			expIssue: true,
+			issueErr: errors.New("db down"),
+
+			expSave: false,
+
+			in:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			expOutput: nil,
+			expErr:    errors.New("db down"),
+		}, {
+			testName: "Save refresh token error",
+
+			getInput:  "sessionId",
+			getOutput: 1,
+			getErr:    nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: errors.New("redis down"),
+
+			in:        issuetokenmodel.NewIssueTokenInput("sessionId"),
+			expOutput: nil,
+			expErr:    errors.New("redis down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := issuetokenmocks.NewMockSessionRepo(ctrl)
+			issuerMock := issuetokenmocks.NewMockAccessTokenIssuer(ctrl)
+			refreshMock := issuetokenmocks.NewMockRefreshTokenRepo(ctrl)
+			idgenMock := issuetokenmocks.NewMockIDGenerator(ctrl)
+
+			sessionMock.EXPECT().Get(gomock.Any(), tt.getInput).
+				Return(tt.getOutput, tt.getErr)
+			if tt.expIssue {
+				issuerMock.EXPECT().Issue(gomock.Any(), tt.getOutput).
+					Return(tt.issueOutput, tt.issueExpiresAt, tt.issueErr)
+			}
+			if tt.expSave {
+				idgenMock.EXPECT().New().Return("refresh")
+				refreshMock.EXPECT().Save(gomock.Any(), "refresh", gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ string, rt *refreshtokendomain.RefreshTokenDomain) error {
+						require.Equal(t, tt.getOutput, rt.UserId)
+						require.Equal(t, tt.getInput, rt.SessionId)
+						require.WithinDuration(t, time.Now().Add(time.Hour), rt.ExpiresAt, time.Second)
+						return tt.saveErr
+					})
+			}
+
+			issueUC := NewIssueTokenUC(log, sessionMock, issuerMock, refreshMock, idgenMock, time.Hour)
+
+			out, err := issueUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
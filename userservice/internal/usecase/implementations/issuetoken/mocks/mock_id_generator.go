@@ -0,0 +1,54 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/idgenerator/id_generator.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=./mocks/mock_id_generator.go -package=issuetokenmocks
+//
+
+// Package issuetokenmocks is a generated GoMock package.
+package issuetokenmocks
+
+import (
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockIDGenerator is a mock of IDGenerator interface.
+type MockIDGenerator struct {
+	ctrl     *gomock.Controller
+	recorder *MockIDGeneratorMockRecorder
+	isgomock struct{}
+}
+
+// MockIDGeneratorMockRecorder is the mock recorder for MockIDGenerator.
+type MockIDGeneratorMockRecorder struct {
+	mock *MockIDGenerator
+}
+
+// NewMockIDGenerator creates a new mock instance.
+func NewMockIDGenerator(ctrl *gomock.Controller) *MockIDGenerator {
+	mock := &MockIDGenerator{ctrl: ctrl}
+	mock.recorder = &MockIDGeneratorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIDGenerator) EXPECT() *MockIDGeneratorMockRecorder {
+	return m.recorder
+}
+
+// New mocks base method.
+func (m *MockIDGenerator) New() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "New")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// New indicates an expected call of New.
+func (mr *MockIDGeneratorMockRecorder) New() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "New", reflect.TypeOf((*MockIDGenerator)(nil).New))
+}
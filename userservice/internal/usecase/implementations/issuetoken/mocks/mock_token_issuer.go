@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/accesstoken/token_issuer.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/accesstoken/token_issuer.go -destination=./mocks/mock_token_issuer.go -package=issuetokenmocks
+//
+
+// Package issuetokenmocks is a generated GoMock package.
+package issuetokenmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+	signingkeydomain "userservice/internal/domain/signingkey"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAccessTokenIssuer is a mock of AccessTokenIssuer interface.
+type MockAccessTokenIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAccessTokenIssuerMockRecorder
+	isgomock struct{}
+}
+
+// MockAccessTokenIssuerMockRecorder is the mock recorder for MockAccessTokenIssuer.
+type MockAccessTokenIssuerMockRecorder struct {
+	mock *MockAccessTokenIssuer
+}
+
+// NewMockAccessTokenIssuer creates a new mock instance.
+func NewMockAccessTokenIssuer(ctrl *gomock.Controller) *MockAccessTokenIssuer {
+	mock := &MockAccessTokenIssuer{ctrl: ctrl}
+	mock.recorder = &MockAccessTokenIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAccessTokenIssuer) EXPECT() *MockAccessTokenIssuerMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockAccessTokenIssuer) Issue(ctx context.Context, userId uint32) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, userId)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockAccessTokenIssuerMockRecorder) Issue(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockAccessTokenIssuer)(nil).Issue), ctx, userId)
+}
+
+// PublicKeys mocks base method.
+func (m *MockAccessTokenIssuer) PublicKeys(ctx context.Context) ([]*signingkeydomain.SigningKeyDomain, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKeys", ctx)
+	ret0, _ := ret[0].([]*signingkeydomain.SigningKeyDomain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKeys indicates an expected call of PublicKeys.
+func (mr *MockAccessTokenIssuerMockRecorder) PublicKeys(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKeys", reflect.TypeOf((*MockAccessTokenIssuer)(nil).PublicKeys), ctx)
+}
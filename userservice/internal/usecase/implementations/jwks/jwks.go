@@ -0,0 +1,38 @@
+package jwks
+
+import (
+	"context"
+	"log/slog"
+	"userservice/internal/repository/accesstoken"
+	jwksmodel "userservice/internal/usecase/models/jwks"
+)
+
+type GetJWKSUC struct {
+	log *slog.Logger
+
+	issuer accesstoken.AccessTokenIssuer
+}
+
+func NewGetJWKSUC(log *slog.Logger, issuer accesstoken.AccessTokenIssuer) *GetJWKSUC {
+	return &GetJWKSUC{
+		log:    log,
+		issuer: issuer,
+	}
+}
+
+func (g *GetJWKSUC) Execute(ctx context.Context) (*jwksmodel.JWKSOutput, error) {
+	const op = "jwks.Execute"
+	log := g.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "get jwks started")
+
+	keys, err := g.issuer.PublicKeys(ctx)
+	if err != nil {
+		log.WarnContext(ctx, "get jwks stopped: cannot get signing keys", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "get jwks completed successfully", slog.Int("keys", len(keys)))
+
+	return jwksmodel.NewJWKSOutput(keys), nil
+}
@@ -0,0 +1,65 @@
+package jwks
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	signingkeydomain "userservice/internal/domain/signingkey"
+	jwksmocks "userservice/internal/usecase/implementations/jwks/mocks"
+	jwksmodel "userservice/internal/usecase/models/jwks"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/accesstoken/token_issuer.go -destination=./mocks/mock_token_issuer.go -package=jwksmocks
+func TestGetJWKS(t *testing.T) {
+	tests := []struct {
+		testName string
+
+		keysOutput []*signingkeydomain.SigningKeyDomain
+		keysErr    error
+
+		expOutput *jwksmodel.JWKSOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			keysOutput: []*signingkeydomain.SigningKeyDomain{{Kid: "kid", Algorithm: signingkeydomain.AlgEdDSA}},
+			keysErr:    nil,
+
+			expOutput: jwksmodel.NewJWKSOutput([]*signingkeydomain.SigningKeyDomain{{Kid: "kid", Algorithm: signingkeydomain.AlgEdDSA}}),
+			expErr:    nil,
+		}, {
+			testName: "Repository error",
+
+			keysOutput: nil,
+			keysErr:    errors.New("db down"),
+
+			expOutput: nil,
+			expErr:    errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			issuerMock := jwksmocks.NewMockAccessTokenIssuer(ctrl)
+
+			issuerMock.EXPECT().PublicKeys(gomock.Any()).
+				Return(tt.keysOutput, tt.keysErr)
+
+			jwksUC := NewGetJWKSUC(log, issuerMock)
+
+			out, err := jwksUC.Execute(context.Background())
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
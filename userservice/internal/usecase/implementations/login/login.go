@@ -4,6 +4,10 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"strings"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/attempts"
 	"userservice/internal/repository/hasher"
 	"userservice/internal/repository/idgenerator"
 	"userservice/internal/repository/session"
@@ -14,15 +18,23 @@ import (
 
 var (
 	invalidSessionId = "invalid"
+
+	// bcrypt hash compared against when the user does not exist,
+	// so both branches take the same time
+	dummyHash = []byte("$2a$10$0heIe0Y/0xWhTfmvBkZL.edpAU7lNXAqn8vcsMcNUTFK3hsp7eLVa")
 )
 
 type LoginUserUC struct {
 	log *slog.Logger
 
-	storage     storagerepo.StorageRepo
-	passHasher  hasher.PasswordHasher
-	sessionRepo session.SessionRepo
-	idgen       idgenerator.IDGenerator
+	storage      storagerepo.StorageRepo
+	passHasher   hasher.PasswordHasher
+	sessionRepo  session.SessionRepo
+	idgen        idgenerator.IDGenerator
+	attemptsRepo attempts.AttemptsRepo
+
+	policy          LockoutPolicy
+	requireVerified bool
 }
 
 func NewLoginUserUC(
@@ -31,13 +43,20 @@ func NewLoginUserUC(
 	passHasher hasher.PasswordHasher,
 	sessionRepo session.SessionRepo,
 	idgen idgenerator.IDGenerator,
+	attemptsRepo attempts.AttemptsRepo,
+	policy LockoutPolicy,
+	requireVerified bool,
 ) *LoginUserUC {
 	return &LoginUserUC{
-		log:         log,
-		storage:     storage,
-		passHasher:  passHasher,
-		sessionRepo: sessionRepo,
-		idgen:       idgen,
+		log:          log,
+		storage:      storage,
+		passHasher:   passHasher,
+		sessionRepo:  sessionRepo,
+		idgen:        idgen,
+		attemptsRepo: attemptsRepo,
+
+		policy:          policy,
+		requireVerified: requireVerified,
 	}
 }
 
@@ -45,35 +64,100 @@ func (l *LoginUserUC) Execute(ctx context.Context, in *logmodel.LoginInput) (*lo
 	const op = "login.Execute"
 	log := l.log.With(slog.String("op", op), slog.String("email", in.Email))
 
-	log.Info("user login started")
+	log.InfoContext(ctx, "user login started")
+
+	emailKey := "email:" + strings.ToLower(in.Email)
+	ipKey := "ip:" + in.IP
+
+	retryAfter, err := l.lockedFor(ctx, emailKey, ipKey)
+	if err != nil {
+		log.WarnContext(ctx, "login stopped: cannot check attempts", slog.String("error", err.Error()))
+		return &logmodel.LoginOutput{}, err
+	}
+	if retryAfter > 0 {
+		log.InfoContext(ctx, "login stopped: too many attempts", slog.Duration("retry_after", retryAfter))
+		return &logmodel.LoginOutput{RetryAfter: retryAfter}, logerr.ErrTooManyAttempts
+	}
 
 	ud, err := l.storage.FindByEmail(ctx, in.Email)
 	if err != nil {
 		if errors.Is(err, storagerepo.ErrNoRows) {
-			log.Info("login stopped: user not found")
-			return &logmodel.LoginOutput{}, logerr.ErrUserNotFound
+			_ = l.passHasher.ComparePassword(dummyHash, []byte(in.Password))
+			l.registerFailure(ctx, log, emailKey, ipKey)
+			log.InfoContext(ctx, "login stopped: user not found")
+			return &logmodel.LoginOutput{}, logerr.ErrInvalidCredentials
 		}
-		log.Warn("login stopped", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "login stopped", slog.String("error", err.Error()))
 		return &logmodel.LoginOutput{}, err
 	}
 
 	if err := l.passHasher.ComparePassword([]byte(ud.HashPassword), []byte(in.Password)); err != nil {
 		if errors.Is(err, hasher.ErrWrongPassword) {
-			log.Info("login stopped: wrong password")
-			return &logmodel.LoginOutput{}, logerr.ErrWrongPassword
+			l.registerFailure(ctx, log, emailKey, ipKey)
+			log.InfoContext(ctx, "login stopped: wrong password")
+			return &logmodel.LoginOutput{}, logerr.ErrInvalidCredentials
 		}
-		log.Warn("login stopped", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "login stopped", slog.String("error", err.Error()))
 		return &logmodel.LoginOutput{}, err
 	}
 
+	if l.requireVerified && !ud.EmailVerified {
+		log.InfoContext(ctx, "login stopped: email not verified")
+		return &logmodel.LoginOutput{}, logerr.ErrEmailNotVerified
+	}
+
+	if err := l.attemptsRepo.Reset(ctx, emailKey); err != nil {
+		log.WarnContext(ctx, "cannot reset login attempts", slog.String("error", err.Error()))
+	}
+
 	sessionId := l.idgen.New()
+	now := time.Now().UTC()
+	s := sessiondomain.NewSessionDomain(l.idgen.New(), ud.Id, in.UserAgent, in.IP, now, now)
 
-	if err := l.sessionRepo.Save(ctx, sessionId, ud.Id); err != nil {
-		log.Warn("login stopped: cannot save session")
+	if err := l.sessionRepo.Save(ctx, sessionId, s); err != nil {
+		log.WarnContext(ctx, "login stopped: cannot save session")
 		return &logmodel.LoginOutput{}, err
 	}
 
-	log.Info("user successfully login")
+	log.InfoContext(ctx, "user successfully login")
 
 	return logmodel.NewLoginOutput(sessionId, ud.FirstName, ud.MiddleName, ud.LastName), nil
 }
+
+func (l *LoginUserUC) lockedFor(ctx context.Context, keys ...string) (time.Duration, error) {
+	var res time.Duration
+	for _, key := range keys {
+		d, err := l.attemptsRepo.LockedFor(ctx, key)
+		if err != nil {
+			return 0, err
+		}
+		res = max(res, d)
+	}
+	return res, nil
+}
+
+func (l *LoginUserUC) registerFailure(ctx context.Context, log *slog.Logger, emailKey, ipKey string) {
+	limits := []struct {
+		key         string
+		maxAttempts int64
+	}{
+		{key: emailKey, maxAttempts: l.policy.MaxEmailAttempts},
+		{key: ipKey, maxAttempts: l.policy.MaxIPAttempts},
+	}
+
+	for _, lim := range limits {
+		fails, err := l.attemptsRepo.RegisterFailure(ctx, lim.key)
+		if err != nil {
+			log.WarnContext(ctx, "cannot register failed attempt", slog.String("error", err.Error()))
+			continue
+		}
+
+		if d := l.policy.lockoutFor(fails, lim.maxAttempts); d > 0 {
+			if err := l.attemptsRepo.Lock(ctx, lim.key, d); err != nil {
+				log.WarnContext(ctx, "cannot lock login", slog.String("error", err.Error()))
+				continue
+			}
+			log.InfoContext(ctx, "login locked", slog.String("key", lim.key), slog.Duration("lockout", d))
+		}
+	}
+}
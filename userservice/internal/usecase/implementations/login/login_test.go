@@ -5,6 +5,8 @@ import (
 	"io"
 	"log/slog"
 	"testing"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
 	userdomain "userservice/internal/domain/user"
 	"userservice/internal/repository/hasher"
 	storagerepo "userservice/internal/repository/storage"
@@ -16,13 +18,25 @@ import (
 	"go.uber.org/mock/gomock"
 )
 
+var testPolicy = LockoutPolicy{
+	MaxEmailAttempts: 5,
+	MaxIPAttempts:    20,
+	Lockout:          time.Minute,
+	MaxLockout:       time.Hour,
+}
+
 //go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=logmocks
 //go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=mocks/mock_session.go -package=logmocks
 //go:generate mockgen -source=./../../../repository/hasher/password_hasher.go -destination=mocks/mock_password_hasher.go -package=logmocks
 //go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=mocks/mock_id_generator.go -package=logmocks
+//go:generate mockgen -source=./../../../repository/attempts/attemptsrepo.go -destination=mocks/mock_attempts.go -package=logmocks
 func TestLogin(t *testing.T) {
 	tests := []struct {
-		testName string
+		testName        string
+		requireVerified bool
+
+		emailLockedFor time.Duration
+		ipLockedFor    time.Duration
 
 		expFindByEmail       bool
 		findByEmailInput     string
@@ -36,11 +50,19 @@ func TestLogin(t *testing.T) {
 
 		expSave            bool
 		saveSessionIdInput string
-		saveUserIdInput    uint32
+		saveSessionInput   *sessiondomain.SessionDomain
 		saveErrReturn      error
 
-		expNew    bool
-		newReturn string
+		expNew      bool
+		newReturn   string
+		newIdReturn string
+
+		expFailure bool
+		emailFails int64
+		ipFails    int64
+		expLock    time.Duration
+
+		expReset bool
 
 		loginInput     *logmodel.LoginInput
 		expLoginOutput *logmodel.LoginOutput
@@ -68,13 +90,21 @@ func TestLogin(t *testing.T) {
 
 			expSave:            true,
 			saveSessionIdInput: "1",
-			saveUserIdInput:    1,
-			saveErrReturn:      nil,
+			saveSessionInput: &sessiondomain.SessionDomain{
+				Id:        "2",
+				UserId:    1,
+				UserAgent: "Mozilla/5.0",
+				IP:        "127.0.0.1",
+			},
+			saveErrReturn: nil,
+
+			expNew:      true,
+			newReturn:   "1",
+			newIdReturn: "2",
 
-			expNew:    true,
-			newReturn: "1",
+			expReset: true,
 
-			loginInput: logmodel.NewLoginInput("gmail@gmail.com", "pass"),
+			loginInput: logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
 			expLoginOutput: logmodel.NewLoginOutput(
 				"1",
 				"Ivan",
@@ -90,13 +120,21 @@ func TestLogin(t *testing.T) {
 			findByEmailUdReturn:  nil,
 			findByEmailErrReturn: storagerepo.ErrNoRows,
 
-			expComparePassword: false,
-			expSave:            false,
-			expNew:             false,
+			expComparePassword:           true,
+			comparePasswordHashPassInput: dummyHash,
+			comparePasswordPassInput:     []byte("pass"),
+			comparePasswordErrReturn:     hasher.ErrWrongPassword,
+
+			expSave: false,
+			expNew:  false,
 
-			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass"),
+			expFailure: true,
+			emailFails: 1,
+			ipFails:    1,
+
+			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
 			expLoginOutput: &logmodel.LoginOutput{},
-			expLoginErr:    logerr.ErrUserNotFound,
+			expLoginErr:    logerr.ErrInvalidCredentials,
 		}, {
 			testName: "Wrong password",
 
@@ -120,9 +158,141 @@ func TestLogin(t *testing.T) {
 			expSave: false,
 			expNew:  false,
 
-			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass"),
+			expFailure: true,
+			emailFails: 1,
+			ipFails:    1,
+
+			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
+			expLoginOutput: &logmodel.LoginOutput{},
+			expLoginErr:    logerr.ErrInvalidCredentials,
+		}, {
+			testName: "Wrong password locks email",
+
+			expFindByEmail:   true,
+			findByEmailInput: "Gmail@gmail.com",
+			findByEmailUdReturn: userdomain.NewUserDomain(
+				1,
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"hashPass",
+				"gmail@gmail.com",
+			),
+			findByEmailErrReturn: nil,
+
+			expComparePassword:           true,
+			comparePasswordHashPassInput: []byte("hashPass"),
+			comparePasswordPassInput:     []byte("pass"),
+			comparePasswordErrReturn:     hasher.ErrWrongPassword,
+
+			expSave: false,
+			expNew:  false,
+
+			expFailure: true,
+			emailFails: 7,
+			ipFails:    7,
+			expLock:    4 * time.Minute,
+
+			loginInput:     logmodel.NewLoginInput("Gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
+			expLoginOutput: &logmodel.LoginOutput{},
+			expLoginErr:    logerr.ErrInvalidCredentials,
+		}, {
+			testName:       "Email locked",
+			emailLockedFor: 2 * time.Minute,
+
+			expFindByEmail:     false,
+			expComparePassword: false,
+			expSave:            false,
+			expNew:             false,
+
+			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
+			expLoginOutput: &logmodel.LoginOutput{RetryAfter: 2 * time.Minute},
+			expLoginErr:    logerr.ErrTooManyAttempts,
+		}, {
+			testName:    "IP locked",
+			ipLockedFor: 5 * time.Minute,
+
+			expFindByEmail:     false,
+			expComparePassword: false,
+			expSave:            false,
+			expNew:             false,
+
+			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
+			expLoginOutput: &logmodel.LoginOutput{RetryAfter: 5 * time.Minute},
+			expLoginErr:    logerr.ErrTooManyAttempts,
+		}, {
+			testName:        "Email not verified",
+			requireVerified: true,
+
+			expFindByEmail:   true,
+			findByEmailInput: "gmail@gmail.com",
+			findByEmailUdReturn: userdomain.NewUserDomain(
+				1,
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"hashPass",
+				"gmail@gmail.com",
+			),
+			findByEmailErrReturn: nil,
+
+			expComparePassword:           true,
+			comparePasswordHashPassInput: []byte("hashPass"),
+			comparePasswordPassInput:     []byte("pass"),
+			comparePasswordErrReturn:     nil,
+
+			expSave: false,
+			expNew:  false,
+
+			loginInput:     logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
 			expLoginOutput: &logmodel.LoginOutput{},
-			expLoginErr:    logerr.ErrWrongPassword,
+			expLoginErr:    logerr.ErrEmailNotVerified,
+		}, {
+			testName:        "Email verified",
+			requireVerified: true,
+
+			expFindByEmail:   true,
+			findByEmailInput: "gmail@gmail.com",
+			findByEmailUdReturn: &userdomain.UserDomain{
+				Id:            1,
+				FirstName:     "Ivan",
+				MiddleName:    "Ivanovich",
+				LastName:      "Ivanov",
+				HashPassword:  "hashPass",
+				Email:         "gmail@gmail.com",
+				EmailVerified: true,
+			},
+			findByEmailErrReturn: nil,
+
+			expComparePassword:           true,
+			comparePasswordHashPassInput: []byte("hashPass"),
+			comparePasswordPassInput:     []byte("pass"),
+			comparePasswordErrReturn:     nil,
+
+			expSave:            true,
+			saveSessionIdInput: "1",
+			saveSessionInput: &sessiondomain.SessionDomain{
+				Id:        "2",
+				UserId:    1,
+				UserAgent: "Mozilla/5.0",
+				IP:        "127.0.0.1",
+			},
+			saveErrReturn: nil,
+
+			expNew:      true,
+			newReturn:   "1",
+			newIdReturn: "2",
+
+			expReset: true,
+
+			loginInput: logmodel.NewLoginInput("gmail@gmail.com", "pass", "Mozilla/5.0", "127.0.0.1"),
+			expLoginOutput: logmodel.NewLoginOutput(
+				"1",
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+			),
+			expLoginErr: nil,
 		},
 	}
 
@@ -147,16 +317,44 @@ func TestLogin(t *testing.T) {
 
 			sessionMock := logmocks.NewMockSessionRepo(ctrl)
 			if tt.expSave {
-				sessionMock.EXPECT().Save(gomock.Any(), tt.saveSessionIdInput, tt.saveUserIdInput).
+				sessionMock.EXPECT().Save(gomock.Any(), tt.saveSessionIdInput, gomock.Cond(func(s *sessiondomain.SessionDomain) bool {
+					return s.Id == tt.saveSessionInput.Id &&
+						s.UserId == tt.saveSessionInput.UserId &&
+						s.UserAgent == tt.saveSessionInput.UserAgent &&
+						s.IP == tt.saveSessionInput.IP &&
+						!s.CreatedAt.IsZero() &&
+						s.LastSeen.Equal(s.CreatedAt)
+				})).
 					Return(tt.saveErrReturn)
 			}
 
 			idgen := logmocks.NewMockIDGenerator(ctrl)
 			if tt.expNew {
 				idgen.EXPECT().New().Return(tt.newReturn)
+				idgen.EXPECT().New().Return(tt.newIdReturn)
+			}
+
+			attemptsMock := logmocks.NewMockAttemptsRepo(ctrl)
+			attemptsMock.EXPECT().LockedFor(gomock.Any(), "email:gmail@gmail.com").
+				Return(tt.emailLockedFor, nil)
+			attemptsMock.EXPECT().LockedFor(gomock.Any(), "ip:127.0.0.1").
+				Return(tt.ipLockedFor, nil)
+			if tt.expFailure {
+				attemptsMock.EXPECT().RegisterFailure(gomock.Any(), "email:gmail@gmail.com").
+					Return(tt.emailFails, nil)
+				attemptsMock.EXPECT().RegisterFailure(gomock.Any(), "ip:127.0.0.1").
+					Return(tt.ipFails, nil)
+			}
+			if tt.expLock > 0 {
+				attemptsMock.EXPECT().Lock(gomock.Any(), "email:gmail@gmail.com", tt.expLock).
+					Return(nil)
+			}
+			if tt.expReset {
+				attemptsMock.EXPECT().Reset(gomock.Any(), "email:gmail@gmail.com").
+					Return(nil)
 			}
 
-			logUC := NewLoginUserUC(log, storageMock, passHasherMock, sessionMock, idgen)
+			logUC := NewLoginUserUC(log, storageMock, passHasherMock, sessionMock, idgen, attemptsMock, testPolicy, tt.requireVerified)
 			lo, err := logUC.Execute(context.Background(), tt.loginInput)
 			require.ErrorIs(t, err, tt.expLoginErr)
 			require.Equal(t, tt.expLoginOutput, lo)
@@ -0,0 +1,100 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/attempts/attemptsrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/attempts/attemptsrepo.go -destination=mocks/mock_attempts.go -package=logmocks
+//
+
+// Package logmocks is a generated GoMock package.
+package logmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAttemptsRepo is a mock of AttemptsRepo interface.
+type MockAttemptsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttemptsRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockAttemptsRepoMockRecorder is the mock recorder for MockAttemptsRepo.
+type MockAttemptsRepoMockRecorder struct {
+	mock *MockAttemptsRepo
+}
+
+// NewMockAttemptsRepo creates a new mock instance.
+func NewMockAttemptsRepo(ctrl *gomock.Controller) *MockAttemptsRepo {
+	mock := &MockAttemptsRepo{ctrl: ctrl}
+	mock.recorder = &MockAttemptsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttemptsRepo) EXPECT() *MockAttemptsRepoMockRecorder {
+	return m.recorder
+}
+
+// Lock mocks base method.
+func (m *MockAttemptsRepo) Lock(ctx context.Context, key string, d time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lock", ctx, key, d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockAttemptsRepoMockRecorder) Lock(ctx, key, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockAttemptsRepo)(nil).Lock), ctx, key, d)
+}
+
+// LockedFor mocks base method.
+func (m *MockAttemptsRepo) LockedFor(ctx context.Context, key string) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockedFor", ctx, key)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockedFor indicates an expected call of LockedFor.
+func (mr *MockAttemptsRepoMockRecorder) LockedFor(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockedFor", reflect.TypeOf((*MockAttemptsRepo)(nil).LockedFor), ctx, key)
+}
+
+// RegisterFailure mocks base method.
+func (m *MockAttemptsRepo) RegisterFailure(ctx context.Context, key string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterFailure", ctx, key)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterFailure indicates an expected call of RegisterFailure.
+func (mr *MockAttemptsRepoMockRecorder) RegisterFailure(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterFailure", reflect.TypeOf((*MockAttemptsRepo)(nil).RegisterFailure), ctx, key)
+}
+
+// Reset mocks base method.
+func (m *MockAttemptsRepo) Reset(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reset", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reset indicates an expected call of Reset.
+func (mr *MockAttemptsRepoMockRecorder) Reset(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reset", reflect.TypeOf((*MockAttemptsRepo)(nil).Reset), ctx, key)
+}
@@ -0,0 +1,25 @@
+package login
+
+import "time"
+
+type LockoutPolicy struct {
+	MaxEmailAttempts int64
+	MaxIPAttempts    int64
+	Lockout          time.Duration
+	MaxLockout       time.Duration
+}
+
+func (p LockoutPolicy) lockoutFor(fails, maxAttempts int64) time.Duration {
+	if maxAttempts <= 0 || fails < maxAttempts {
+		return 0
+	}
+
+	d := p.Lockout
+	for i := maxAttempts; i < fails && d < p.MaxLockout; i++ {
+		d *= 2
+	}
+	if p.MaxLockout > 0 && d > p.MaxLockout {
+		d = p.MaxLockout
+	}
+	return d
+}
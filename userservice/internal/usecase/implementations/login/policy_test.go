@@ -0,0 +1,29 @@
+package login
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLockoutPolicy_LockoutFor(t *testing.T) {
+	tests := []struct {
+		testName    string
+		fails       int64
+		maxAttempts int64
+		exp         time.Duration
+	}{
+		{testName: "Below limit", fails: 4, maxAttempts: 5, exp: 0},
+		{testName: "At limit", fails: 5, maxAttempts: 5, exp: time.Minute},
+		{testName: "Backoff", fails: 7, maxAttempts: 5, exp: 4 * time.Minute},
+		{testName: "Capped", fails: 50, maxAttempts: 5, exp: time.Hour},
+		{testName: "Disabled", fails: 50, maxAttempts: 0, exp: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			require.Equal(t, tt.exp, testPolicy.lockoutFor(tt.fails, tt.maxAttempts))
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package logout
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/session"
+	logouterr "userservice/internal/usecase/errors/logout"
+	logoutmodel "userservice/internal/usecase/models/logout"
+)
+
+type LogoutUserUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+}
+
+func NewLogoutUserUC(log *slog.Logger, sessionRepo session.SessionRepo) *LogoutUserUC {
+	return &LogoutUserUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (l *LogoutUserUC) Execute(ctx context.Context, in *logoutmodel.LogoutInput) (*logoutmodel.LogoutOutput, error) {
+	const op = "logout.Execute"
+	log := l.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "user logout started")
+
+	if err := l.sessionRepo.Delete(ctx, in.SessionId); err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "logout stopped: session not found")
+			return logoutmodel.NewLogoutOutput(false), logouterr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "logout stopped", slog.String("error", err.Error()))
+		return logoutmodel.NewLogoutOutput(false), err
+	}
+
+	log.InfoContext(ctx, "user successfully logout")
+
+	return logoutmodel.NewLogoutOutput(true), nil
+}
@@ -0,0 +1,80 @@
+package logout
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"userservice/internal/repository/session"
+	logouterr "userservice/internal/usecase/errors/logout"
+	logoutmocks "userservice/internal/usecase/implementations/logout/mocks"
+	logoutmodel "userservice/internal/usecase/models/logout"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=logoutmocks
+func TestLogout(t *testing.T) {
+	errRedis := errors.New("redis error")
+
+	tests := []struct {
+		testName string
+
+		deleteInput string
+		deleteErr   error
+
+		logoutInput *logoutmodel.LogoutInput
+		expOutput   *logoutmodel.LogoutOutput
+		expErr      error
+	}{
+		{
+			testName: "Success",
+
+			deleteInput: "sessionId",
+			deleteErr:   nil,
+
+			logoutInput: logoutmodel.NewLogoutInput("sessionId"),
+			expOutput:   logoutmodel.NewLogoutOutput(true),
+			expErr:      nil,
+		}, {
+			testName: "Session not found",
+
+			deleteInput: "sessionId",
+			deleteErr:   session.ErrKeyNotFound,
+
+			logoutInput: logoutmodel.NewLogoutInput("sessionId"),
+			expOutput:   logoutmodel.NewLogoutOutput(false),
+			expErr:      logouterr.ErrSessionNotFound,
+		}, {
+			testName: "Redis error",
+
+			deleteInput: "sessionId",
+			deleteErr:   errRedis,
+
+			logoutInput: logoutmodel.NewLogoutInput("sessionId"),
+			expOutput:   logoutmodel.NewLogoutOutput(false),
+			expErr:      errRedis,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := logoutmocks.NewMockSessionRepo(ctrl)
+
+			sessionMock.EXPECT().Delete(gomock.Any(), tt.deleteInput).
+				Return(tt.deleteErr)
+
+			logoutUC := NewLogoutUserUC(log, sessionMock)
+
+			out, err := logoutUC.Execute(context.Background(), tt.logoutInput)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
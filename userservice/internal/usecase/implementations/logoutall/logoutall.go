@@ -0,0 +1,51 @@
+package logoutall
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/session"
+	logoutallerr "userservice/internal/usecase/errors/logoutall"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+)
+
+type LogoutAllUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+}
+
+func NewLogoutAllUC(log *slog.Logger, sessionRepo session.SessionRepo) *LogoutAllUC {
+	return &LogoutAllUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (l *LogoutAllUC) Execute(ctx context.Context, in *logoutallmodel.LogoutAllInput) (*logoutallmodel.LogoutAllOutput, error) {
+	const op = "logoutall.Execute"
+	log := l.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "user logout from all sessions started")
+
+	userId, err := l.sessionRepo.Get(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "logout all stopped: session not found")
+			return logoutallmodel.NewLogoutAllOutput(false), logoutallerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "logout all stopped", slog.String("error", err.Error()))
+		return logoutallmodel.NewLogoutAllOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	if err := l.sessionRepo.DeleteAllForUser(ctx, userId); err != nil {
+		log.WarnContext(ctx, "logout all stopped: cannot delete sessions", slog.String("error", err.Error()))
+		return logoutallmodel.NewLogoutAllOutput(false), err
+	}
+
+	log.InfoContext(ctx, "user successfully logout from all sessions")
+
+	return logoutallmodel.NewLogoutAllOutput(true), nil
+}
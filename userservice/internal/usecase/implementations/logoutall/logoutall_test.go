@@ -0,0 +1,102 @@
+package logoutall
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"userservice/internal/repository/session"
+	logoutallerr "userservice/internal/usecase/errors/logoutall"
+	logoutallmocks "userservice/internal/usecase/implementations/logoutall/mocks"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=logoutallmocks
+func TestLogoutAll(t *testing.T) {
+	errRedis := errors.New("redis error")
+
+	tests := []struct {
+		testName string
+
+		getInput  string
+		getOutput uint32
+		getErr    error
+
+		expDeleteAll   bool
+		deleteAllInput uint32
+		deleteAllErr   error
+
+		logoutAllInput *logoutallmodel.LogoutAllInput
+		expOutput      *logoutallmodel.LogoutAllOutput
+		expErr         error
+	}{
+		{
+			testName: "Success",
+
+			getInput:  "sessionId",
+			getOutput: 1,
+			getErr:    nil,
+
+			expDeleteAll:   true,
+			deleteAllInput: 1,
+			deleteAllErr:   nil,
+
+			logoutAllInput: logoutallmodel.NewLogoutAllInput("sessionId"),
+			expOutput:      logoutallmodel.NewLogoutAllOutput(true),
+			expErr:         nil,
+		}, {
+			testName: "Session not found",
+
+			getInput:  "sessionId",
+			getOutput: 0,
+			getErr:    session.ErrKeyNotFound,
+
+			expDeleteAll: false,
+
+			logoutAllInput: logoutallmodel.NewLogoutAllInput("sessionId"),
+			expOutput:      logoutallmodel.NewLogoutAllOutput(false),
+			expErr:         logoutallerr.ErrSessionNotFound,
+		}, {
+			testName: "Delete all error",
+
+			getInput:  "sessionId",
+			getOutput: 1,
+			getErr:    nil,
+
+			expDeleteAll:   true,
+			deleteAllInput: 1,
+			deleteAllErr:   errRedis,
+
+			logoutAllInput: logoutallmodel.NewLogoutAllInput("sessionId"),
+			expOutput:      logoutallmodel.NewLogoutAllOutput(false),
+			expErr:         errRedis,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := logoutallmocks.NewMockSessionRepo(ctrl)
+
+			sessionMock.EXPECT().Get(gomock.Any(), tt.getInput).
+				Return(tt.getOutput, tt.getErr)
+			if tt.expDeleteAll {
+				sessionMock.EXPECT().DeleteAllForUser(gomock.Any(), tt.deleteAllInput).
+					Return(tt.deleteAllErr)
+			}
+
+			logoutAllUC := NewLogoutAllUC(log, sessionMock)
+
+			out, err := logoutAllUC.Execute(context.Background(), tt.logoutAllInput)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -0,0 +1,141 @@
+package refreshtoken
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	"userservice/internal/repository/accesstoken"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	refresherr "userservice/internal/usecase/errors/refreshtoken"
+	refreshmodel "userservice/internal/usecase/models/refreshtoken"
+)
+
+type RefreshTokenUC struct {
+	log *slog.Logger
+
+	refreshRepo token.RefreshTokenRepo
+	sessionRepo session.SessionRepo
+	storageRepo storagerepo.StorageRepo
+	issuer      accesstoken.AccessTokenIssuer
+	idgen       idgenerator.IDGenerator
+
+	sliding     bool
+	ttl         time.Duration
+	maxLifetime time.Duration
+}
+
+func NewRefreshTokenUC(
+	log *slog.Logger,
+	refreshRepo token.RefreshTokenRepo,
+	sessionRepo session.SessionRepo,
+	storageRepo storagerepo.StorageRepo,
+	issuer accesstoken.AccessTokenIssuer,
+	idgen idgenerator.IDGenerator,
+	sliding bool,
+	ttl time.Duration,
+	maxLifetime time.Duration,
+) *RefreshTokenUC {
+	return &RefreshTokenUC{
+		log:         log,
+		refreshRepo: refreshRepo,
+		sessionRepo: sessionRepo,
+		storageRepo: storageRepo,
+		issuer:      issuer,
+		idgen:       idgen,
+		sliding:     sliding,
+		ttl:         ttl,
+		maxLifetime: maxLifetime,
+	}
+}
+
+func (r *RefreshTokenUC) Execute(ctx context.Context, in *refreshmodel.RefreshInput) (*refreshmodel.RefreshOutput, error) {
+	const op = "refreshtoken.Execute"
+	log := r.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "refresh token started")
+
+	// refresh tokens are single use, a new one is returned with every access token
+	rt, err := r.refreshRepo.Consume(ctx, in.RefreshToken)
+	if err != nil {
+		if errors.Is(err, token.ErrTokenNotFound) {
+			log.InfoContext(ctx, "refresh token stopped: token not found")
+			return nil, refresherr.ErrInvalidRefreshToken
+		}
+		log.WarnContext(ctx, "refresh token stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(rt.UserId)))
+
+	if rt.Expired(time.Now()) {
+		log.InfoContext(ctx, "refresh token stopped: token expired")
+		return nil, refresherr.ErrInvalidRefreshToken
+	}
+
+	// a revoked or expired session takes its refresh tokens with it
+	s, err := r.sessionRepo.GetSession(ctx, rt.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "refresh token stopped: session not found")
+			return nil, refresherr.ErrInvalidRefreshToken
+		}
+		log.WarnContext(ctx, "refresh token stopped: cannot get session", slog.String("error", err.Error()))
+		return nil, err
+	}
+	if s.UserId != rt.UserId {
+		log.WarnContext(ctx, "refresh token stopped: session belongs to another user")
+		return nil, refresherr.ErrInvalidRefreshToken
+	}
+
+	// refreshing is session activity, without it the session expires under a live refresh token
+	now := time.Now().UTC()
+
+	var ttl time.Duration
+	if r.sliding {
+		ttl = s.SlidingTTL(now, r.ttl, r.maxLifetime)
+		if ttl <= 0 {
+			log.InfoContext(ctx, "refresh token stopped: session lifetime exceeded")
+			return nil, refresherr.ErrInvalidRefreshToken
+		}
+	}
+
+	if err := r.sessionRepo.Touch(ctx, rt.SessionId, now, ttl); err != nil {
+		log.WarnContext(ctx, "cannot refresh session", slog.String("error", err.Error()))
+	}
+
+	if _, err := r.storageRepo.FindById(ctx, rt.UserId); err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "refresh token stopped: user not found")
+			return nil, refresherr.ErrInvalidRefreshToken
+		}
+		log.WarnContext(ctx, "refresh token stopped: cannot get user", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	accessToken, expiresAt, err := r.issuer.Issue(ctx, rt.UserId)
+	if err != nil {
+		log.WarnContext(ctx, "refresh token stopped: cannot sign access token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	next := refreshtokendomain.NewRefreshTokenDomain(rt.UserId, rt.SessionId, rt.ExpiresAt)
+
+	refreshToken := r.idgen.New()
+	if err := r.refreshRepo.Save(ctx, refreshToken, next); err != nil {
+		if errors.Is(err, token.ErrTokenExpired) {
+			log.InfoContext(ctx, "refresh token stopped: token expired")
+			return nil, refresherr.ErrInvalidRefreshToken
+		}
+		log.WarnContext(ctx, "refresh token stopped: cannot save refresh token", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "refresh token completed successfully")
+
+	return refreshmodel.NewRefreshOutput(accessToken, expiresAt, refreshToken), nil
+}
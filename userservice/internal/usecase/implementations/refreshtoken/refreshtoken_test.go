@@ -0,0 +1,391 @@
+package refreshtoken
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	refreshtokendomain "userservice/internal/domain/refreshtoken"
+	sessiondomain "userservice/internal/domain/session"
+	userdomain "userservice/internal/domain/user"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	refresherr "userservice/internal/usecase/errors/refreshtoken"
+	refreshmocks "userservice/internal/usecase/implementations/refreshtoken/mocks"
+	refreshmodel "userservice/internal/usecase/models/refreshtoken"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/token/refreshtokenrepo.go -destination=./mocks/mock_token.go -package=refreshmocks
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=refreshmocks
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=refreshmocks
+//go:generate mockgen -source=./../../../repository/accesstoken/token_issuer.go -destination=./mocks/mock_token_issuer.go -package=refreshmocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=./mocks/mock_id_generator.go -package=refreshmocks
+func TestRefreshToken(t *testing.T) {
+	expiresAt := time.Now().Add(5 * time.Minute)
+	refreshExpiresAt := time.Now().Add(time.Hour)
+	rt := refreshtokendomain.NewRefreshTokenDomain(1, "sessionId", refreshExpiresAt)
+
+	tests := []struct {
+		testName string
+
+		consumeInput  string
+		consumeOutput *refreshtokendomain.RefreshTokenDomain
+		consumeErr    error
+
+		sliding bool
+
+		expSession    bool
+		sessionUserId uint32
+		sessionAge    time.Duration
+		sessionErr    error
+
+		expTouch    bool
+		touchMinTTL time.Duration
+		touchMaxTTL time.Duration
+		touchErr    error
+
+		expFind bool
+		findErr error
+
+		expIssue       bool
+		issueOutput    string
+		issueExpiresAt time.Time
+		issueErr       error
+
+		expSave bool
+		saveErr error
+
+		in        *refreshmodel.RefreshInput
+		expOutput *refreshmodel.RefreshOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession:    true,
+			sessionUserId: 1,
+
+			expTouch: true,
+
+			expFind: true,
+			findErr: nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: nil,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: refreshmodel.NewRefreshOutput("access", expiresAt, "new"),
+			expErr:    nil,
+		}, {
+			testName: "Token not found",
+
+			consumeInput:  "old",
+			consumeOutput: nil,
+			consumeErr:    token.ErrTokenNotFound,
+
+			expSession: false,
+			expFind:    false,
+			expIssue:   false,
+			expSave:    false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "Token past max lifetime",
+
+			consumeInput:  "old",
+			consumeOutput: refreshtokendomain.NewRefreshTokenDomain(1, "sessionId", time.Now().Add(-time.Second)),
+			consumeErr:    nil,
+
+			expSession: false,
+			expFind:    false,
+			expIssue:   false,
+			expSave:    false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "Session revoked",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession: true,
+			sessionErr: session.ErrKeyNotFound,
+
+			expFind:  false,
+			expIssue: false,
+			expSave:  false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "Session of another user",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession:    true,
+			sessionUserId: 2,
+
+			expFind:  false,
+			expIssue: false,
+			expSave:  false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "User deleted",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession:    true,
+			sessionUserId: 1,
+
+			expTouch: true,
+
+			expFind: true,
+			findErr: storagerepo.ErrNoRows,
+
+			expIssue: false,
+			expSave:  false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "Issuer error",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession:    true,
+			sessionUserId: 1,
+
+			expTouch: true,
+
+			expFind: true,
+			findErr: nil,
+
+			expIssue: true,
+			issueErr: errors.New("db down"),
+
+			expSave: false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    errors.New("db down"),
+		}, {
+			testName: "Expired while rotating",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession:    true,
+			sessionUserId: 1,
+
+			expTouch: true,
+
+			expFind: true,
+			findErr: nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: token.ErrTokenExpired,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "Sliding session extended",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			sliding: true,
+
+			expSession:    true,
+			sessionUserId: 1,
+			sessionAge:    time.Hour,
+
+			expTouch:    true,
+			touchMinTTL: time.Hour,
+			touchMaxTTL: time.Hour,
+
+			expFind: true,
+			findErr: nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: nil,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: refreshmodel.NewRefreshOutput("access", expiresAt, "new"),
+			expErr:    nil,
+		}, {
+			testName: "Sliding session capped by max lifetime",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			sliding: true,
+
+			expSession:    true,
+			sessionUserId: 1,
+			sessionAge:    23*time.Hour + 30*time.Minute,
+
+			expTouch:    true,
+			touchMinTTL: 29 * time.Minute,
+			touchMaxTTL: 30 * time.Minute,
+
+			expFind: true,
+			findErr: nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: nil,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: refreshmodel.NewRefreshOutput("access", expiresAt, "new"),
+			expErr:    nil,
+		}, {
+			testName: "Session lifetime exceeded",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			sliding: true,
+
+			expSession:    true,
+			sessionUserId: 1,
+			sessionAge:    25 * time.Hour,
+
+			expTouch: false,
+			expFind:  false,
+			expIssue: false,
+			expSave:  false,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: nil,
+			expErr:    refresherr.ErrInvalidRefreshToken,
+		}, {
+			testName: "Touch failed",
+
+			consumeInput:  "old",
+			consumeOutput: rt,
+			consumeErr:    nil,
+
+			expSession:    true,
+			sessionUserId: 1,
+
+			expTouch: true,
+			touchErr: session.ErrKeyNotFound,
+
+			expFind: true,
+			findErr: nil,
+
+			expIssue:       true,
+			issueOutput:    "access",
+			issueExpiresAt: expiresAt,
+			issueErr:       nil,
+
+			expSave: true,
+			saveErr: nil,
+
+			in:        refreshmodel.NewRefreshInput("old"),
+			expOutput: refreshmodel.NewRefreshOutput("access", expiresAt, "new"),
+			expErr:    nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			refreshMock := refreshmocks.NewMockRefreshTokenRepo(ctrl)
+			sessionMock := refreshmocks.NewMockSessionRepo(ctrl)
+			storageMock := refreshmocks.NewMockStorageRepo(ctrl)
+			issuerMock := refreshmocks.NewMockAccessTokenIssuer(ctrl)
+			idgenMock := refreshmocks.NewMockIDGenerator(ctrl)
+
+			refreshMock.EXPECT().Consume(gomock.Any(), tt.consumeInput).
+				Return(tt.consumeOutput, tt.consumeErr)
+			if tt.expSession {
+				var s *sessiondomain.SessionDomain
+				if tt.sessionErr == nil {
+					s = &sessiondomain.SessionDomain{Id: "1", UserId: tt.sessionUserId, CreatedAt: time.Now().Add(-tt.sessionAge)}
+				}
+				sessionMock.EXPECT().GetSession(gomock.Any(), tt.consumeOutput.SessionId).
+					Return(s, tt.sessionErr)
+			}
+			if tt.expTouch {
+				sessionMock.EXPECT().Touch(gomock.Any(), tt.consumeOutput.SessionId, gomock.Any(), gomock.Cond(func(ttl time.Duration) bool {
+					return ttl >= tt.touchMinTTL && ttl <= tt.touchMaxTTL
+				})).
+					Return(tt.touchErr)
+			}
+			if tt.expFind {
+				storageMock.EXPECT().FindById(gomock.Any(), tt.consumeOutput.UserId).
+					Return(&userdomain.UserDomain{Id: tt.consumeOutput.UserId}, tt.findErr)
+			}
+			if tt.expIssue {
+				issuerMock.EXPECT().Issue(gomock.Any(), tt.consumeOutput.UserId).
+					Return(tt.issueOutput, tt.issueExpiresAt, tt.issueErr)
+			}
+			if tt.expSave {
+				idgenMock.EXPECT().New().Return("new")
+				// rotation keeps the binding and the original expiry
+				refreshMock.EXPECT().Save(gomock.Any(), "new", tt.consumeOutput).
+					Return(tt.saveErr)
+			}
+
+			refreshUC := NewRefreshTokenUC(log, refreshMock, sessionMock, storageMock, issuerMock, idgenMock, tt.sliding, time.Hour, 24*time.Hour)
+
+			out, err := refreshUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
@@ -6,7 +6,10 @@ import (
 	"log/slog"
 	userdomain "userservice/internal/domain/user"
 	"userservice/internal/repository/hasher"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/notifier"
 	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
 	regerr "userservice/internal/usecase/errors/registration"
 	regmodel "userservice/internal/usecase/models/registration"
 )
@@ -20,13 +23,26 @@ type RegUserUC struct {
 
 	storage    storagerepo.StorageRepo
 	passHasher hasher.PasswordHasher
+	tokenRepo  token.EmailTokenRepo
+	notifier   notifier.Notifier
+	idgen      idgenerator.IDGenerator
 }
 
-func NewRegUserUC(log *slog.Logger, storage storagerepo.StorageRepo, passHasher hasher.PasswordHasher) *RegUserUC {
+func NewRegUserUC(
+	log *slog.Logger,
+	storage storagerepo.StorageRepo,
+	passHasher hasher.PasswordHasher,
+	tokenRepo token.EmailTokenRepo,
+	notifier notifier.Notifier,
+	idgen idgenerator.IDGenerator,
+) *RegUserUC {
 	return &RegUserUC{
 		log:        log,
 		storage:    storage,
 		passHasher: passHasher,
+		tokenRepo:  tokenRepo,
+		notifier:   notifier,
+		idgen:      idgen,
 	}
 }
 
@@ -34,40 +50,67 @@ func (r *RegUserUC) Execute(ctx context.Context, in *regmodel.RegInput) (*regmod
 	const op = "registration.Execute"
 	log := r.log.With(slog.String("op", op), slog.String("email", in.Email))
 
-	log.Info("user registration started")
+	log.InfoContext(ctx, "user registration started")
 
-	ud, err := r.storage.FindByEmail(ctx, in.Email)
+	ud := userdomain.NewUserDomain(
+		invalidId,
+		in.FirstName,
+		in.MiddleName,
+		in.LastName,
+		"",
+		in.Email,
+	)
+	if err := ud.Validate(); err != nil {
+		log.InfoContext(ctx, "registration stopped, invalid user data", slog.String("error", err.Error()))
+		return regmodel.NewRegOutput(false), err
+	}
+
+	found, err := r.storage.FindByEmail(ctx, in.Email)
 	if err != nil && !errors.Is(err, storagerepo.ErrNoRows) {
-		log.Warn("registration stopped", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "registration stopped", slog.String("error", err.Error()))
 		return regmodel.NewRegOutput(false), err
 	}
-	if ud != nil {
-		log.Info("registration stopped, user already exists")
+	if found != nil {
+		log.InfoContext(ctx, "registration stopped, user already exists")
 		return regmodel.NewRegOutput(false), regerr.ErrUserAlreadyExists
 	}
 
 	hashPass, err := r.passHasher.Hash([]byte(in.Password))
 	if err != nil {
-		log.Warn("registration stopped, impossible to hash", slog.String("error", err.Error()))
+		log.WarnContext(ctx, "registration stopped, impossible to hash", slog.String("error", err.Error()))
 		return regmodel.NewRegOutput(false), err
 	}
 
-	ud = userdomain.NewUserDomain(
-		invalidId,
-		in.FirstName,
-		in.MiddleName,
-		in.LastName,
-		string(hashPass),
-		in.Email,
-	)
+	ud.HashPassword = string(hashPass)
 
-	_, err = r.storage.Save(ctx, ud)
+	userId, err := r.storage.Save(ctx, ud)
 	if err != nil {
-		log.Warn("registration stopped, failed to save user", slog.String("error", err.Error()))
+		if errors.Is(err, storagerepo.ErrAlreadyExists) {
+			log.InfoContext(ctx, "registration stopped, user already exists")
+			return regmodel.NewRegOutput(false), regerr.ErrUserAlreadyExists
+		}
+		log.WarnContext(ctx, "registration stopped, failed to save user", slog.String("error", err.Error()))
 		return regmodel.NewRegOutput(false), err
 	}
 
-	log.Info("user successfully registered")
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	// the user is already saved, so a failed verification mail must not fail the registration
+	if err := r.sendVerification(ctx, userId, ud.Email); err != nil {
+		log.WarnContext(ctx, "cannot send verification email", slog.String("error", err.Error()))
+	}
+
+	log.InfoContext(ctx, "user successfully registered")
 
 	return regmodel.NewRegOutput(true), nil
 }
+
+func (r *RegUserUC) sendVerification(ctx context.Context, userId uint32, email string) error {
+	tkn := r.idgen.New()
+
+	if err := r.tokenRepo.Save(ctx, tkn, userId, email); err != nil {
+		return err
+	}
+
+	return r.notifier.SendEmailVerification(ctx, email, tkn)
+}
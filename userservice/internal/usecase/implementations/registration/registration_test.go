@@ -2,6 +2,7 @@ package registration
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log/slog"
 	"testing"
@@ -17,6 +18,9 @@ import (
 
 //go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=regmocks
 //go:generate mockgen -source=./../../../repository/hasher/password_hasher.go -destination=mocks/mock_hasher.go -package=regmocks
+//go:generate mockgen -source=./../../../repository/token/emailtokenrepo.go -destination=mocks/mock_token.go -package=regmocks
+//go:generate mockgen -source=./../../../repository/notifier/notifier.go -destination=mocks/mock_notifier.go -package=regmocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=mocks/mock_id_generator.go -package=regmocks
 func TestRegUser(t *testing.T) {
 	tests := []struct {
 		testName string
@@ -36,6 +40,10 @@ func TestRegUser(t *testing.T) {
 		saveIdReturn  uint32
 		saveErrReturn error
 
+		expectVerification bool
+		tokenSaveErrReturn error
+		notifyErrReturn    error
+
 		regUserInput        regmodel.RegInput
 		regUserExpectOutput regmodel.RegOutput
 		regUserExpectErr    error
@@ -65,6 +73,8 @@ func TestRegUser(t *testing.T) {
 			saveIdReturn:  1,
 			saveErrReturn: nil,
 
+			expectVerification: true,
+
 			regUserInput: *regmodel.NewRegInput(
 				"Ivan",
 				"Ivanovich",
@@ -105,6 +115,99 @@ func TestRegUser(t *testing.T) {
 				false,
 			),
 			regUserExpectErr: regerr.ErrUserAlreadyExists,
+		}, {
+			testName: "Invalid email",
+
+			expectFindByEmail: false,
+			expectHash:        false,
+			expectSave:        false,
+
+			regUserInput: *regmodel.NewRegInput(
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"somePass",
+				"gmail",
+			),
+			regUserExpectOutput: *regmodel.NewRegOutput(
+				false,
+			),
+			regUserExpectErr: userdomain.ErrInvalidEmail,
+		}, {
+			testName: "User created concurrently",
+
+			expectFindByEmail:     true,
+			findByEmailInput:      "gmail@gmail.com",
+			findByEmailUserReturn: nil,
+			findByEmailErrReturn:  storagerepo.ErrNoRows,
+
+			expectHash:     true,
+			hashInput:      []byte("somePass"),
+			hashPassReturn: []byte("hashPass"),
+			hashErrReturn:  nil,
+
+			expectSave: true,
+			saveInput: userdomain.NewUserDomain(
+				0,
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"hashPass",
+				"gmail@gmail.com",
+			),
+			saveIdReturn:  0,
+			saveErrReturn: storagerepo.ErrAlreadyExists,
+
+			regUserInput: *regmodel.NewRegInput(
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"somePass",
+				"gmail@gmail.com",
+			),
+			regUserExpectOutput: *regmodel.NewRegOutput(
+				false,
+			),
+			regUserExpectErr: regerr.ErrUserAlreadyExists,
+		}, {
+			testName: "Verification email not sent",
+
+			expectFindByEmail:     true,
+			findByEmailInput:      "gmail@gmail.com",
+			findByEmailUserReturn: nil,
+			findByEmailErrReturn:  storagerepo.ErrNoRows,
+
+			expectHash:     true,
+			hashInput:      []byte("somePass"),
+			hashPassReturn: []byte("hashPass"),
+			hashErrReturn:  nil,
+
+			expectSave: true,
+			saveInput: userdomain.NewUserDomain(
+				0,
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"hashPass",
+				"gmail@gmail.com",
+			),
+			saveIdReturn:  1,
+			saveErrReturn: nil,
+
+			expectVerification: true,
+			notifyErrReturn:    errors.New("smtp error"),
+
+			regUserInput: *regmodel.NewRegInput(
+				"Ivan",
+				"Ivanovich",
+				"Ivanov",
+				"somePass",
+				"gmail@gmail.com",
+			),
+			regUserExpectOutput: *regmodel.NewRegOutput(
+				true,
+			),
+			regUserExpectErr: nil,
 		},
 	}
 
@@ -129,9 +232,20 @@ func TestRegUser(t *testing.T) {
 					Return(tt.hashPassReturn, tt.hashErrReturn)
 			}
 
+			idgenMock := regmocks.NewMockIDGenerator(ctrl)
+			tokenMock := regmocks.NewMockEmailTokenRepo(ctrl)
+			notifierMock := regmocks.NewMockNotifier(ctrl)
+			if tt.expectVerification {
+				idgenMock.EXPECT().New().Return("token")
+				tokenMock.EXPECT().Save(gomock.Any(), "token", tt.saveIdReturn, tt.saveInput.Email).
+					Return(tt.tokenSaveErrReturn)
+				notifierMock.EXPECT().SendEmailVerification(gomock.Any(), tt.saveInput.Email, "token").
+					Return(tt.notifyErrReturn)
+			}
+
 			log := slog.New(slog.NewTextHandler(io.Discard, nil))
 
-			regUC := NewRegUserUC(log, storMock, hasherMock)
+			regUC := NewRegUserUC(log, storMock, hasherMock, tokenMock, notifierMock, idgenMock)
 
 			out, err := regUC.Execute(context.Background(), &tt.regUserInput)
 			require.ErrorIs(t, tt.regUserExpectErr, err)
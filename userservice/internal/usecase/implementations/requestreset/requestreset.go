@@ -0,0 +1,92 @@
+package requestreset
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/notifier"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	reqresetmodel "userservice/internal/usecase/models/requestreset"
+)
+
+type RequestResetUC struct {
+	log *slog.Logger
+
+	storageRepo storagerepo.StorageRepo
+	tokenRepo   token.TokenRepo
+	notifier    notifier.Notifier
+	idgen       idgenerator.IDGenerator
+
+	// sends run after the response so the timing does not reveal the account
+	sends sync.WaitGroup
+}
+
+func NewRequestResetUC(
+	log *slog.Logger,
+	storageRepo storagerepo.StorageRepo,
+	tokenRepo token.TokenRepo,
+	notifier notifier.Notifier,
+	idgen idgenerator.IDGenerator,
+) *RequestResetUC {
+	return &RequestResetUC{
+		log:         log,
+		storageRepo: storageRepo,
+		tokenRepo:   tokenRepo,
+		notifier:    notifier,
+		idgen:       idgen,
+	}
+}
+
+func (r *RequestResetUC) Execute(ctx context.Context, in *reqresetmodel.RequestResetInput) (*reqresetmodel.RequestResetOutput, error) {
+	const op = "requestreset.Execute"
+	log := r.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "password reset request started")
+
+	ud, err := r.storageRepo.FindByEmail(ctx, in.Email)
+	if err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			// the response must not reveal whether the email is registered
+			log.InfoContext(ctx, "password reset request stopped: user not found")
+			return reqresetmodel.NewRequestResetOutput(true), nil
+		}
+		log.WarnContext(ctx, "password reset request stopped", slog.String("error", err.Error()))
+		return reqresetmodel.NewRequestResetOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(ud.Id)))
+
+	sendCtx := context.WithoutCancel(ctx)
+	r.sends.Add(1)
+	go func() {
+		defer r.sends.Done()
+
+		if err := r.send(sendCtx, ud.Id, ud.Email); err != nil {
+			log.WarnContext(sendCtx, "cannot send password reset", slog.String("error", err.Error()))
+			return
+		}
+		log.InfoContext(sendCtx, "password reset sent")
+	}()
+
+	log.InfoContext(ctx, "password reset request completed successfully")
+
+	return reqresetmodel.NewRequestResetOutput(true), nil
+}
+
+// Wait blocks until the password reset emails already accepted are sent.
+func (r *RequestResetUC) Wait() {
+	r.sends.Wait()
+}
+
+func (r *RequestResetUC) send(ctx context.Context, userId uint32, email string) error {
+	tkn := r.idgen.New()
+
+	if err := r.tokenRepo.Save(ctx, tkn, userId); err != nil {
+		return err
+	}
+
+	return r.notifier.SendPasswordReset(ctx, email, tkn)
+}
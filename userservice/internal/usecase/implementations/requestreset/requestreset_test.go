@@ -0,0 +1,171 @@
+package requestreset
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	userdomain "userservice/internal/domain/user"
+	storagerepo "userservice/internal/repository/storage"
+	reqresetmocks "userservice/internal/usecase/implementations/requestreset/mocks"
+	reqresetmodel "userservice/internal/usecase/models/requestreset"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=reqresetmocks
+//go:generate mockgen -source=./../../../repository/token/tokenrepo.go -destination=mocks/mock_token.go -package=reqresetmocks
+//go:generate mockgen -source=./../../../repository/notifier/notifier.go -destination=mocks/mock_notifier.go -package=reqresetmocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=mocks/mock_id_generator.go -package=reqresetmocks
+func TestRequestReset(t *testing.T) {
+	errDB := errors.New("db error")
+	errRedis := errors.New("redis error")
+	errNotify := errors.New("notify error")
+
+	user := &userdomain.UserDomain{Id: 1, FirstName: "Ivan", LastName: "Ivanov", Email: "ivan@mail.ru"}
+
+	tests := []struct {
+		testName string
+
+		findByEmailReturn *userdomain.UserDomain
+		findByEmailErr    error
+
+		expToken bool
+		saveErr  error
+
+		expNotify bool
+		notifyErr error
+
+		in     *reqresetmodel.RequestResetInput
+		expOut *reqresetmodel.RequestResetOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			findByEmailReturn: user,
+
+			expToken: true,
+
+			expNotify: true,
+
+			in:     reqresetmodel.NewRequestResetInput("ivan@mail.ru"),
+			expOut: reqresetmodel.NewRequestResetOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Unknown email",
+
+			findByEmailErr: storagerepo.ErrNoRows,
+
+			in:     reqresetmodel.NewRequestResetInput("unknown@mail.ru"),
+			expOut: reqresetmodel.NewRequestResetOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Storage error",
+
+			findByEmailErr: errDB,
+
+			in:     reqresetmodel.NewRequestResetInput("ivan@mail.ru"),
+			expOut: reqresetmodel.NewRequestResetOutput(false),
+			expErr: errDB,
+		}, {
+			testName: "Cannot save token",
+
+			findByEmailReturn: user,
+
+			expToken: true,
+			saveErr:  errRedis,
+
+			in:     reqresetmodel.NewRequestResetInput("ivan@mail.ru"),
+			expOut: reqresetmodel.NewRequestResetOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Cannot send notification",
+
+			findByEmailReturn: user,
+
+			expToken: true,
+
+			expNotify: true,
+			notifyErr: errNotify,
+
+			in:     reqresetmodel.NewRequestResetInput("ivan@mail.ru"),
+			expOut: reqresetmodel.NewRequestResetOutput(true),
+			expErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			storageMock := reqresetmocks.NewMockStorageRepo(ctrl)
+			storageMock.EXPECT().FindByEmail(gomock.Any(), tt.in.Email).
+				Return(tt.findByEmailReturn, tt.findByEmailErr)
+
+			idgenMock := reqresetmocks.NewMockIDGenerator(ctrl)
+			tokenMock := reqresetmocks.NewMockTokenRepo(ctrl)
+			if tt.expToken {
+				idgenMock.EXPECT().New().Return("token")
+				tokenMock.EXPECT().Save(gomock.Any(), "token", user.Id).
+					Return(tt.saveErr)
+			}
+
+			notifierMock := reqresetmocks.NewMockNotifier(ctrl)
+			if tt.expNotify {
+				notifierMock.EXPECT().SendPasswordReset(gomock.Any(), user.Email, "token").
+					Return(tt.notifyErr)
+			}
+
+			reqResetUC := NewRequestResetUC(log, storageMock, tokenMock, notifierMock, idgenMock)
+
+			out, err := reqResetUC.Execute(context.Background(), tt.in)
+			reqResetUC.Wait()
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
+
+func TestRequestReset_SendOutlivesRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	user := &userdomain.UserDomain{Id: 1, Email: "ivan@mail.ru"}
+
+	storageMock := reqresetmocks.NewMockStorageRepo(ctrl)
+	storageMock.EXPECT().FindByEmail(gomock.Any(), user.Email).Return(user, nil)
+
+	idgenMock := reqresetmocks.NewMockIDGenerator(ctrl)
+	idgenMock.EXPECT().New().Return("token")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	tokenMock := reqresetmocks.NewMockTokenRepo(ctrl)
+	tokenMock.EXPECT().Save(gomock.Any(), "token", user.Id).Return(nil)
+
+	// the request context is gone by the time the email goes out
+	var sendErr error
+	notifierMock := reqresetmocks.NewMockNotifier(ctrl)
+	notifierMock.EXPECT().SendPasswordReset(gomock.Any(), user.Email, "token").
+		DoAndReturn(func(sendCtx context.Context, _, _ string) error {
+			<-ctx.Done()
+			sendErr = sendCtx.Err()
+			return sendErr
+		})
+
+	reqResetUC := NewRequestResetUC(log, storageMock, tokenMock, notifierMock, idgenMock)
+
+	_, err := reqResetUC.Execute(ctx, reqresetmodel.NewRequestResetInput(user.Email))
+	cancel()
+	reqResetUC.Wait()
+	require.NoError(t, err)
+	require.NoError(t, sendErr)
+}
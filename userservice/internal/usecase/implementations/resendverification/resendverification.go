@@ -0,0 +1,97 @@
+package resendverification
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"sync"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/notifier"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	resendmodel "userservice/internal/usecase/models/resendverification"
+)
+
+type ResendVerificationUC struct {
+	log *slog.Logger
+
+	storageRepo storagerepo.StorageRepo
+	tokenRepo   token.EmailTokenRepo
+	notifier    notifier.Notifier
+	idgen       idgenerator.IDGenerator
+
+	// sends run after the response so the timing does not reveal the account
+	sends sync.WaitGroup
+}
+
+func NewResendVerificationUC(
+	log *slog.Logger,
+	storageRepo storagerepo.StorageRepo,
+	tokenRepo token.EmailTokenRepo,
+	notifier notifier.Notifier,
+	idgen idgenerator.IDGenerator,
+) *ResendVerificationUC {
+	return &ResendVerificationUC{
+		log:         log,
+		storageRepo: storageRepo,
+		tokenRepo:   tokenRepo,
+		notifier:    notifier,
+		idgen:       idgen,
+	}
+}
+
+func (r *ResendVerificationUC) Execute(ctx context.Context, in *resendmodel.ResendInput) (*resendmodel.ResendOutput, error) {
+	const op = "resendverification.Execute"
+	log := r.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "resend verification request started")
+
+	ud, err := r.storageRepo.FindByEmail(ctx, in.Email)
+	if err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			// the response must not reveal whether the email is registered
+			log.InfoContext(ctx, "resend verification request stopped: user not found")
+			return resendmodel.NewResendOutput(true), nil
+		}
+		log.WarnContext(ctx, "resend verification request stopped", slog.String("error", err.Error()))
+		return resendmodel.NewResendOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(ud.Id)))
+
+	if ud.EmailVerified {
+		log.InfoContext(ctx, "resend verification request stopped: email already verified")
+		return resendmodel.NewResendOutput(true), nil
+	}
+
+	sendCtx := context.WithoutCancel(ctx)
+	r.sends.Add(1)
+	go func() {
+		defer r.sends.Done()
+
+		if err := r.send(sendCtx, ud.Id, ud.Email); err != nil {
+			log.WarnContext(sendCtx, "cannot resend verification email", slog.String("error", err.Error()))
+			return
+		}
+		log.InfoContext(sendCtx, "verification email resent")
+	}()
+
+	log.InfoContext(ctx, "resend verification request completed successfully")
+
+	return resendmodel.NewResendOutput(true), nil
+}
+
+// Wait blocks until the verification emails already accepted are sent.
+func (r *ResendVerificationUC) Wait() {
+	r.sends.Wait()
+}
+
+func (r *ResendVerificationUC) send(ctx context.Context, userId uint32, email string) error {
+	tkn := r.idgen.New()
+
+	if err := r.tokenRepo.Save(ctx, tkn, userId, email); err != nil {
+		return err
+	}
+
+	return r.notifier.SendEmailVerification(ctx, email, tkn)
+}
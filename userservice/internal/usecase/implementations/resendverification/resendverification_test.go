@@ -0,0 +1,180 @@
+package resendverification
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	userdomain "userservice/internal/domain/user"
+	storagerepo "userservice/internal/repository/storage"
+	resendmocks "userservice/internal/usecase/implementations/resendverification/mocks"
+	resendmodel "userservice/internal/usecase/models/resendverification"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=resendmocks
+//go:generate mockgen -source=./../../../repository/token/emailtokenrepo.go -destination=mocks/mock_token.go -package=resendmocks
+//go:generate mockgen -source=./../../../repository/notifier/notifier.go -destination=mocks/mock_notifier.go -package=resendmocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=mocks/mock_id_generator.go -package=resendmocks
+func TestResendVerification(t *testing.T) {
+	errDB := errors.New("db error")
+	errRedis := errors.New("redis error")
+	errNotify := errors.New("notify error")
+
+	user := &userdomain.UserDomain{Id: 1, FirstName: "Ivan", LastName: "Ivanov", Email: "ivan@mail.ru"}
+	verified := &userdomain.UserDomain{Id: 2, FirstName: "Ivan", LastName: "Ivanov", Email: "verified@mail.ru", EmailVerified: true}
+
+	tests := []struct {
+		testName string
+
+		findByEmailReturn *userdomain.UserDomain
+		findByEmailErr    error
+
+		expToken bool
+		saveErr  error
+
+		expNotify bool
+		notifyErr error
+
+		in     *resendmodel.ResendInput
+		expOut *resendmodel.ResendOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			findByEmailReturn: user,
+
+			expToken: true,
+
+			expNotify: true,
+
+			in:     resendmodel.NewResendInput("ivan@mail.ru"),
+			expOut: resendmodel.NewResendOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Unknown email",
+
+			findByEmailErr: storagerepo.ErrNoRows,
+
+			in:     resendmodel.NewResendInput("unknown@mail.ru"),
+			expOut: resendmodel.NewResendOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Already verified",
+
+			findByEmailReturn: verified,
+
+			in:     resendmodel.NewResendInput("verified@mail.ru"),
+			expOut: resendmodel.NewResendOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Storage error",
+
+			findByEmailErr: errDB,
+
+			in:     resendmodel.NewResendInput("ivan@mail.ru"),
+			expOut: resendmodel.NewResendOutput(false),
+			expErr: errDB,
+		}, {
+			testName: "Cannot save token",
+
+			findByEmailReturn: user,
+
+			expToken: true,
+			saveErr:  errRedis,
+
+			in:     resendmodel.NewResendInput("ivan@mail.ru"),
+			expOut: resendmodel.NewResendOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Cannot send notification",
+
+			findByEmailReturn: user,
+
+			expToken: true,
+
+			expNotify: true,
+			notifyErr: errNotify,
+
+			in:     resendmodel.NewResendInput("ivan@mail.ru"),
+			expOut: resendmodel.NewResendOutput(true),
+			expErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			storageMock := resendmocks.NewMockStorageRepo(ctrl)
+			storageMock.EXPECT().FindByEmail(gomock.Any(), tt.in.Email).
+				Return(tt.findByEmailReturn, tt.findByEmailErr)
+
+			idgenMock := resendmocks.NewMockIDGenerator(ctrl)
+			tokenMock := resendmocks.NewMockEmailTokenRepo(ctrl)
+			if tt.expToken {
+				idgenMock.EXPECT().New().Return("token")
+				tokenMock.EXPECT().Save(gomock.Any(), "token", user.Id, user.Email).
+					Return(tt.saveErr)
+			}
+
+			notifierMock := resendmocks.NewMockNotifier(ctrl)
+			if tt.expNotify {
+				notifierMock.EXPECT().SendEmailVerification(gomock.Any(), user.Email, "token").
+					Return(tt.notifyErr)
+			}
+
+			resendUC := NewResendVerificationUC(log, storageMock, tokenMock, notifierMock, idgenMock)
+
+			out, err := resendUC.Execute(context.Background(), tt.in)
+			resendUC.Wait()
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
+
+func TestResendVerification_SendOutlivesRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	user := &userdomain.UserDomain{Id: 1, Email: "ivan@mail.ru"}
+
+	storageMock := resendmocks.NewMockStorageRepo(ctrl)
+	storageMock.EXPECT().FindByEmail(gomock.Any(), user.Email).Return(user, nil)
+
+	idgenMock := resendmocks.NewMockIDGenerator(ctrl)
+	idgenMock.EXPECT().New().Return("token")
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	tokenMock := resendmocks.NewMockEmailTokenRepo(ctrl)
+	tokenMock.EXPECT().Save(gomock.Any(), "token", user.Id, user.Email).Return(nil)
+
+	// the request context is gone by the time the email goes out
+	var sendErr error
+	notifierMock := resendmocks.NewMockNotifier(ctrl)
+	notifierMock.EXPECT().SendEmailVerification(gomock.Any(), user.Email, "token").
+		DoAndReturn(func(sendCtx context.Context, _, _ string) error {
+			<-ctx.Done()
+			sendErr = sendCtx.Err()
+			return sendErr
+		})
+
+	resendUC := NewResendVerificationUC(log, storageMock, tokenMock, notifierMock, idgenMock)
+
+	_, err := resendUC.Execute(ctx, resendmodel.NewResendInput(user.Email))
+	cancel()
+	resendUC.Wait()
+	require.NoError(t, err)
+	require.NoError(t, sendErr)
+}
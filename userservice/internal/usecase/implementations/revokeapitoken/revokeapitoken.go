@@ -0,0 +1,63 @@
+package revokeapitoken
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/apitoken"
+	"userservice/internal/repository/session"
+	revoketokenerr "userservice/internal/usecase/errors/revokeapitoken"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+)
+
+type RevokeApiTokenUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	tokenRepo   apitoken.ApiTokenRepo
+}
+
+func NewRevokeApiTokenUC(log *slog.Logger, sessionRepo session.SessionRepo, tokenRepo apitoken.ApiTokenRepo) *RevokeApiTokenUC {
+	return &RevokeApiTokenUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		tokenRepo:   tokenRepo,
+	}
+}
+
+func (r *RevokeApiTokenUC) Execute(ctx context.Context, in *revoketokenmodel.RevokeTokenInput) (*revoketokenmodel.RevokeTokenOutput, error) {
+	const op = "revokeapitoken.Execute"
+	log := r.log.With(slog.String("op", op), slog.Uint64("token_id", uint64(in.TokenId)))
+
+	log.InfoContext(ctx, "revoke api token started")
+
+	if in.TokenId == 0 {
+		log.InfoContext(ctx, "revoke api token stopped: invalid token id")
+		return revoketokenmodel.NewRevokeTokenOutput(false), revoketokenerr.ErrInvalidTokenId
+	}
+
+	current, err := r.sessionRepo.GetSession(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "revoke api token stopped: session not found")
+			return revoketokenmodel.NewRevokeTokenOutput(false), revoketokenerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "revoke api token stopped", slog.String("error", err.Error()))
+		return revoketokenmodel.NewRevokeTokenOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(current.UserId)))
+
+	if err := r.tokenRepo.DeleteApiTokenForUser(ctx, current.UserId, in.TokenId); err != nil {
+		if errors.Is(err, apitoken.ErrNotFound) {
+			log.InfoContext(ctx, "revoke api token stopped: token not found")
+			return revoketokenmodel.NewRevokeTokenOutput(false), revoketokenerr.ErrTokenNotFound
+		}
+		log.WarnContext(ctx, "revoke api token stopped: cannot delete token", slog.String("error", err.Error()))
+		return revoketokenmodel.NewRevokeTokenOutput(false), err
+	}
+
+	log.InfoContext(ctx, "revoke api token completed successfully")
+
+	return revoketokenmodel.NewRevokeTokenOutput(true), nil
+}
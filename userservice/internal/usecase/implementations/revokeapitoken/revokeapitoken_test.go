@@ -0,0 +1,114 @@
+package revokeapitoken
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/apitoken"
+	"userservice/internal/repository/session"
+	revoketokenerr "userservice/internal/usecase/errors/revokeapitoken"
+	revoketokenmocks "userservice/internal/usecase/implementations/revokeapitoken/mocks"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=revoketokenmocks
+//go:generate mockgen -source=./../../../repository/apitoken/apitokenrepo.go -destination=./mocks/mock_apitoken.go -package=revoketokenmocks
+func TestRevokeApiToken(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expGetSession    bool
+		getSessionOutput *sessiondomain.SessionDomain
+		getSessionErr    error
+
+		expDelete bool
+		deleteErr error
+
+		in        *revoketokenmodel.RevokeTokenInput
+		expOutput *revoketokenmodel.RevokeTokenOutput
+		expErr    error
+	}{
+		{
+			testName: "Success",
+
+			expGetSession:    true,
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expDelete: true,
+			deleteErr: nil,
+
+			in:        revoketokenmodel.NewRevokeTokenInput("sessionId", 7),
+			expOutput: revoketokenmodel.NewRevokeTokenOutput(true),
+			expErr:    nil,
+		}, {
+			testName: "Invalid token id",
+
+			expGetSession: false,
+			expDelete:     false,
+
+			in:        revoketokenmodel.NewRevokeTokenInput("sessionId", 0),
+			expOutput: revoketokenmodel.NewRevokeTokenOutput(false),
+			expErr:    revoketokenerr.ErrInvalidTokenId,
+		}, {
+			testName: "Session not found",
+
+			expGetSession:    true,
+			getSessionOutput: nil,
+			getSessionErr:    session.ErrKeyNotFound,
+
+			expDelete: false,
+
+			in:        revoketokenmodel.NewRevokeTokenInput("sessionId", 7),
+			expOutput: revoketokenmodel.NewRevokeTokenOutput(false),
+			expErr:    revoketokenerr.ErrSessionNotFound,
+		}, {
+			testName: "Token not found",
+
+			expGetSession:    true,
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expDelete: true,
+			deleteErr: apitoken.ErrNotFound,
+
+			in:        revoketokenmodel.NewRevokeTokenInput("sessionId", 7),
+			expOutput: revoketokenmodel.NewRevokeTokenOutput(false),
+			expErr:    revoketokenerr.ErrTokenNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := revoketokenmocks.NewMockSessionRepo(ctrl)
+			tokenMock := revoketokenmocks.NewMockApiTokenRepo(ctrl)
+
+			if tt.expGetSession {
+				sessionMock.EXPECT().GetSession(gomock.Any(), tt.in.SessionId).
+					Return(tt.getSessionOutput, tt.getSessionErr)
+			}
+			if tt.expDelete {
+				tokenMock.EXPECT().DeleteApiTokenForUser(gomock.Any(), uint32(1), tt.in.TokenId).
+					Return(tt.deleteErr)
+			}
+
+			revokeUC := NewRevokeApiTokenUC(log, sessionMock, tokenMock)
+
+			out, err := revokeUC.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
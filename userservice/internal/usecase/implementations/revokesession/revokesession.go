@@ -0,0 +1,60 @@
+package revokesession
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/session"
+	revokeerr "userservice/internal/usecase/errors/revokesession"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+)
+
+type RevokeSessionUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+}
+
+func NewRevokeSessionUC(log *slog.Logger, sessionRepo session.SessionRepo) *RevokeSessionUC {
+	return &RevokeSessionUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (r *RevokeSessionUC) Execute(ctx context.Context, in *revokemodel.RevokeInput) (*revokemodel.RevokeOutput, error) {
+	const op = "revokesession.Execute"
+	log := r.log.With(slog.String("op", op), slog.String("target_id", in.TargetId))
+
+	log.InfoContext(ctx, "revoke session started")
+
+	if in.TargetId == "" {
+		log.InfoContext(ctx, "revoke session stopped: invalid target id")
+		return revokemodel.NewRevokeOutput(false, false), revokeerr.ErrInvalidTargetId
+	}
+
+	current, err := r.sessionRepo.GetSession(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "revoke session stopped: session not found")
+			return revokemodel.NewRevokeOutput(false, false), revokeerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "revoke session stopped", slog.String("error", err.Error()))
+		return revokemodel.NewRevokeOutput(false, false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(current.UserId)))
+
+	if err := r.sessionRepo.DeleteForUser(ctx, current.UserId, in.TargetId); err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "revoke session stopped: target session not found")
+			return revokemodel.NewRevokeOutput(false, false), revokeerr.ErrTargetSessionNotFound
+		}
+		log.WarnContext(ctx, "revoke session stopped: cannot delete session", slog.String("error", err.Error()))
+		return revokemodel.NewRevokeOutput(false, false), err
+	}
+
+	log.InfoContext(ctx, "revoke session completed successfully")
+
+	return revokemodel.NewRevokeOutput(true, current.Id == in.TargetId), nil
+}
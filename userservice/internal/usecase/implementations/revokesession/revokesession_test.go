@@ -0,0 +1,137 @@
+package revokesession
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/session"
+	revokeerr "userservice/internal/usecase/errors/revokesession"
+	revokemocks "userservice/internal/usecase/implementations/revokesession/mocks"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=revokemocks
+func TestRevokeSession(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		expGetSession    bool
+		getSessionInput  string
+		getSessionOutput *sessiondomain.SessionDomain
+		getSessionErr    error
+
+		expDelete       bool
+		deleteUserInput uint32
+		deleteIdInput   string
+		deleteErr       error
+
+		revokeInput *revokemodel.RevokeInput
+		expOutput   *revokemodel.RevokeOutput
+		expErr      error
+	}{
+		{
+			testName: "Success",
+
+			expGetSession:    true,
+			getSessionInput:  "sessionId",
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expDelete:       true,
+			deleteUserInput: 1,
+			deleteIdInput:   "2",
+			deleteErr:       nil,
+
+			revokeInput: revokemodel.NewRevokeInput("sessionId", "2"),
+			expOutput:   revokemodel.NewRevokeOutput(true, false),
+			expErr:      nil,
+		}, {
+			testName: "Revoke current session",
+
+			expGetSession:    true,
+			getSessionInput:  "sessionId",
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expDelete:       true,
+			deleteUserInput: 1,
+			deleteIdInput:   "1",
+			deleteErr:       nil,
+
+			revokeInput: revokemodel.NewRevokeInput("sessionId", "1"),
+			expOutput:   revokemodel.NewRevokeOutput(true, true),
+			expErr:      nil,
+		}, {
+			testName: "Invalid target id",
+
+			expGetSession: false,
+			expDelete:     false,
+
+			revokeInput: revokemodel.NewRevokeInput("sessionId", ""),
+			expOutput:   revokemodel.NewRevokeOutput(false, false),
+			expErr:      revokeerr.ErrInvalidTargetId,
+		}, {
+			testName: "Session not found",
+
+			expGetSession:    true,
+			getSessionInput:  "sessionId",
+			getSessionOutput: nil,
+			getSessionErr:    session.ErrKeyNotFound,
+
+			expDelete: false,
+
+			revokeInput: revokemodel.NewRevokeInput("sessionId", "2"),
+			expOutput:   revokemodel.NewRevokeOutput(false, false),
+			expErr:      revokeerr.ErrSessionNotFound,
+		}, {
+			testName: "Target session not found",
+
+			expGetSession:    true,
+			getSessionInput:  "sessionId",
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expDelete:       true,
+			deleteUserInput: 1,
+			deleteIdInput:   "2",
+			deleteErr:       session.ErrKeyNotFound,
+
+			revokeInput: revokemodel.NewRevokeInput("sessionId", "2"),
+			expOutput:   revokemodel.NewRevokeOutput(false, false),
+			expErr:      revokeerr.ErrTargetSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := revokemocks.NewMockSessionRepo(ctrl)
+
+			if tt.expGetSession {
+				sessionMock.EXPECT().GetSession(gomock.Any(), tt.getSessionInput).
+					Return(tt.getSessionOutput, tt.getSessionErr)
+			}
+			if tt.expDelete {
+				sessionMock.EXPECT().DeleteForUser(gomock.Any(), tt.deleteUserInput, tt.deleteIdInput).
+					Return(tt.deleteErr)
+			}
+
+			revokeUC := NewRevokeSessionUC(log, sessionMock)
+
+			out, err := revokeUC.Execute(context.Background(), tt.revokeInput)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
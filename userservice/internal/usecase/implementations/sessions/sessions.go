@@ -0,0 +1,52 @@
+package sessions
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/session"
+	sessionserr "userservice/internal/usecase/errors/sessions"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
+)
+
+type GetSessionsUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+}
+
+func NewGetSessionsUC(log *slog.Logger, sessionRepo session.SessionRepo) *GetSessionsUC {
+	return &GetSessionsUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+	}
+}
+
+func (g *GetSessionsUC) Execute(ctx context.Context, in *sessionsmodel.SessionsInput) (*sessionsmodel.SessionsOutput, error) {
+	const op = "sessions.Execute"
+	log := g.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "get sessions started")
+
+	current, err := g.sessionRepo.GetSession(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "get sessions stopped: session not found")
+			return nil, sessionserr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "get sessions stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(current.UserId)))
+
+	sessions, err := g.sessionRepo.GetAllForUser(ctx, current.UserId)
+	if err != nil {
+		log.WarnContext(ctx, "get sessions stopped: cannot get user sessions", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "get sessions completed successfully")
+
+	return sessionsmodel.NewSessionsOutput(current.Id, sessions), nil
+}
@@ -0,0 +1,97 @@
+package sessions
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+	sessiondomain "userservice/internal/domain/session"
+	"userservice/internal/repository/session"
+	sessionserr "userservice/internal/usecase/errors/sessions"
+	sessionsmocks "userservice/internal/usecase/implementations/sessions/mocks"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=sessionsmocks
+func TestGetSessions(t *testing.T) {
+	timeNow := time.Now()
+
+	tests := []struct {
+		testName string
+
+		getSessionInput  string
+		getSessionOutput *sessiondomain.SessionDomain
+		getSessionErr    error
+
+		expGetAll    bool
+		getAllInput  uint32
+		getAllOutput []*sessiondomain.SessionDomain
+		getAllErr    error
+
+		sessionsInput *sessionsmodel.SessionsInput
+		expOutput     *sessionsmodel.SessionsOutput
+		expErr        error
+	}{
+		{
+			testName: "Success",
+
+			getSessionInput:  "sessionId",
+			getSessionOutput: sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+			getSessionErr:    nil,
+
+			expGetAll:   true,
+			getAllInput: 1,
+			getAllOutput: []*sessiondomain.SessionDomain{
+				sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+				sessiondomain.NewSessionDomain("2", 1, "other", "10.0.0.1", timeNow, timeNow),
+			},
+			getAllErr: nil,
+
+			sessionsInput: sessionsmodel.NewSessionsInput("sessionId"),
+			expOutput: sessionsmodel.NewSessionsOutput("1", []*sessiondomain.SessionDomain{
+				sessiondomain.NewSessionDomain("1", 1, "agent", "127.0.0.1", timeNow, timeNow),
+				sessiondomain.NewSessionDomain("2", 1, "other", "10.0.0.1", timeNow, timeNow),
+			}),
+			expErr: nil,
+		}, {
+			testName: "Session not found",
+
+			getSessionInput:  "sessionId",
+			getSessionOutput: nil,
+			getSessionErr:    session.ErrKeyNotFound,
+
+			expGetAll: false,
+
+			sessionsInput: sessionsmodel.NewSessionsInput("sessionId"),
+			expOutput:     nil,
+			expErr:        sessionserr.ErrSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+			sessionMock := sessionsmocks.NewMockSessionRepo(ctrl)
+
+			sessionMock.EXPECT().GetSession(gomock.Any(), tt.getSessionInput).
+				Return(tt.getSessionOutput, tt.getSessionErr)
+			if tt.expGetAll {
+				sessionMock.EXPECT().GetAllForUser(gomock.Any(), tt.getAllInput).
+					Return(tt.getAllOutput, tt.getAllErr)
+			}
+
+			sessionsUC := NewGetSessionsUC(log, sessionMock)
+
+			out, err := sessionsUC.Execute(context.Background(), tt.sessionsInput)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
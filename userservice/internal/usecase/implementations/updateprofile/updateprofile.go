@@ -0,0 +1,145 @@
+package updateprofile
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"userservice/internal/repository/idgenerator"
+	"userservice/internal/repository/notifier"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	updprofileerr "userservice/internal/usecase/errors/updateprofile"
+	updprofilemodel "userservice/internal/usecase/models/updateprofile"
+)
+
+type UpdateProfileUC struct {
+	log *slog.Logger
+
+	sessionRepo session.SessionRepo
+	storageRepo storagerepo.StorageRepo
+	tokenRepo   token.EmailTokenRepo
+	notifier    notifier.Notifier
+	idgen       idgenerator.IDGenerator
+}
+
+func NewUpdateProfileUC(
+	log *slog.Logger,
+	sessionRepo session.SessionRepo,
+	storageRepo storagerepo.StorageRepo,
+	tokenRepo token.EmailTokenRepo,
+	notifier notifier.Notifier,
+	idgen idgenerator.IDGenerator,
+) *UpdateProfileUC {
+	return &UpdateProfileUC{
+		log:         log,
+		sessionRepo: sessionRepo,
+		storageRepo: storageRepo,
+		tokenRepo:   tokenRepo,
+		notifier:    notifier,
+		idgen:       idgen,
+	}
+}
+
+func (u *UpdateProfileUC) Execute(ctx context.Context, in *updprofilemodel.UpdateProfileInput) (*updprofilemodel.UpdateProfileOutput, error) {
+	const op = "updateprofile.Execute"
+	log := u.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "update profile started")
+
+	if in.FirstName == nil && in.MiddleName == nil && in.LastName == nil && in.Email == nil {
+		log.InfoContext(ctx, "update profile stopped: nothing to update")
+		return nil, updprofileerr.ErrNothingToUpdate
+	}
+
+	userId, err := u.sessionRepo.Get(ctx, in.SessionId)
+	if err != nil {
+		if errors.Is(err, session.ErrKeyNotFound) {
+			log.InfoContext(ctx, "update profile stopped: session not found")
+			return nil, updprofileerr.ErrSessionNotFound
+		}
+		log.WarnContext(ctx, "update profile stopped", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	ud, err := u.storageRepo.FindById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "update profile stopped: user not found")
+			return nil, updprofileerr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "update profile stopped: cannot get user", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	if in.FirstName != nil {
+		if err := ud.ChangeFirstName(*in.FirstName); err != nil {
+			log.InfoContext(ctx, "update profile stopped", slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+	if in.MiddleName != nil {
+		if err := ud.ChangeMiddleName(*in.MiddleName); err != nil {
+			log.InfoContext(ctx, "update profile stopped", slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+	if in.LastName != nil {
+		if err := ud.ChangeLastName(*in.LastName); err != nil {
+			log.InfoContext(ctx, "update profile stopped", slog.String("error", err.Error()))
+			return nil, err
+		}
+	}
+	emailChanged := in.Email != nil && *in.Email != ud.Email
+	if emailChanged {
+		if err := ud.ChangeEmail(*in.Email); err != nil {
+			log.InfoContext(ctx, "update profile stopped", slog.String("error", err.Error()))
+			return nil, err
+		}
+
+		found, err := u.storageRepo.FindByEmail(ctx, ud.Email)
+		if err != nil && !errors.Is(err, storagerepo.ErrNoRows) {
+			log.WarnContext(ctx, "update profile stopped: cannot check email", slog.String("error", err.Error()))
+			return nil, err
+		}
+		if found != nil && found.Id != ud.Id {
+			log.InfoContext(ctx, "update profile stopped: email already in use")
+			return nil, updprofileerr.ErrEmailAlreadyExists
+		}
+	}
+
+	if err := u.storageRepo.Update(ctx, ud); err != nil {
+		if errors.Is(err, storagerepo.ErrAlreadyExists) {
+			log.InfoContext(ctx, "update profile stopped: email already in use")
+			return nil, updprofileerr.ErrEmailAlreadyExists
+		} else if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "update profile stopped: user not found")
+			return nil, updprofileerr.ErrUserNotFound
+		}
+		log.WarnContext(ctx, "update profile stopped: cannot update user", slog.String("error", err.Error()))
+		return nil, err
+	}
+
+	// the profile is already saved, so a failed verification mail must not fail the update
+	if emailChanged {
+		if err := u.sendVerification(ctx, ud.Id, ud.Email); err != nil {
+			log.WarnContext(ctx, "cannot send verification email", slog.String("error", err.Error()))
+		}
+	}
+
+	log.InfoContext(ctx, "update profile completed successfully")
+
+	return updprofilemodel.NewUpdateProfileOutput(ud), nil
+}
+
+func (u *UpdateProfileUC) sendVerification(ctx context.Context, userId uint32, email string) error {
+	tkn := u.idgen.New()
+
+	if err := u.tokenRepo.Save(ctx, tkn, userId, email); err != nil {
+		return err
+	}
+
+	return u.notifier.SendEmailVerification(ctx, email, tkn)
+}
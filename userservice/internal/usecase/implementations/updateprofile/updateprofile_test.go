@@ -0,0 +1,259 @@
+package updateprofile
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	userdomain "userservice/internal/domain/user"
+	"userservice/internal/repository/session"
+	storagerepo "userservice/internal/repository/storage"
+	updprofileerr "userservice/internal/usecase/errors/updateprofile"
+	updprofilemocks "userservice/internal/usecase/implementations/updateprofile/mocks"
+	updprofilemodel "userservice/internal/usecase/models/updateprofile"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func ptr(s string) *string {
+	return &s
+}
+
+func verified(ud *userdomain.UserDomain) *userdomain.UserDomain {
+	ud.VerifyEmail()
+	return ud
+}
+
+//go:generate mockgen -source=./../../../repository/session/sessionrepo.go -destination=./mocks/mock_session.go -package=updprofilemocks
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=./mocks/mock_storage.go -package=updprofilemocks
+//go:generate mockgen -source=./../../../repository/token/emailtokenrepo.go -destination=./mocks/mock_token.go -package=updprofilemocks
+//go:generate mockgen -source=./../../../repository/notifier/notifier.go -destination=./mocks/mock_notifier.go -package=updprofilemocks
+//go:generate mockgen -source=./../../../repository/idgenerator/id_generator.go -destination=./mocks/mock_id_generator.go -package=updprofilemocks
+func TestUpdateProfile(t *testing.T) {
+	errSmtp := errors.New("smtp error")
+
+	tests := []struct {
+		testName string
+
+		expSession bool
+		sessionErr error
+
+		expFindById bool
+		findByIdErr error
+
+		expFindByEmail    bool
+		findByEmailEmail  string
+		findByEmailOutput *userdomain.UserDomain
+		findByEmailErr    error
+
+		expUpdate   bool
+		updateInput *userdomain.UserDomain
+		updateErr   error
+
+		expVerification bool
+		tokenSaveErr    error
+		notifyErr       error
+
+		in        *updprofilemodel.UpdateProfileInput
+		expOutput *updprofilemodel.UpdateProfileOutput
+		expErr    error
+	}{
+		{
+			testName: "Update names",
+
+			expSession: true,
+
+			expFindById: true,
+
+			expUpdate:   true,
+			updateInput: verified(userdomain.NewUserDomain(1, "Petr", "", "Ivanov", "hashPass", "old@gmail.com")),
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", ptr("Petr"), ptr(""), nil, nil),
+			expOutput: updprofilemodel.NewUpdateProfileOutput(verified(userdomain.NewUserDomain(1, "Petr", "", "Ivanov", "hashPass", "old@gmail.com"))),
+			expErr:    nil,
+		}, {
+			testName: "Update email",
+
+			expSession: true,
+
+			expFindById: true,
+
+			expFindByEmail:   true,
+			findByEmailEmail: "new@gmail.com",
+			findByEmailErr:   storagerepo.ErrNoRows,
+
+			expUpdate:   true,
+			updateInput: userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "new@gmail.com"),
+
+			expVerification: true,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, ptr("new@gmail.com")),
+			expOutput: updprofilemodel.NewUpdateProfileOutput(userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "new@gmail.com")),
+			expErr:    nil,
+		}, {
+			testName: "Update email, verification mail fails",
+
+			expSession: true,
+
+			expFindById: true,
+
+			expFindByEmail:   true,
+			findByEmailEmail: "new@gmail.com",
+			findByEmailErr:   storagerepo.ErrNoRows,
+
+			expUpdate:   true,
+			updateInput: userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "new@gmail.com"),
+
+			expVerification: true,
+			notifyErr:       errSmtp,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, ptr("new@gmail.com")),
+			expOutput: updprofilemodel.NewUpdateProfileOutput(userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "new@gmail.com")),
+			expErr:    nil,
+		}, {
+			testName: "Same email keeps verification",
+
+			expSession: true,
+
+			expFindById: true,
+
+			expUpdate:   true,
+			updateInput: verified(userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "old@gmail.com")),
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, ptr("old@gmail.com")),
+			expOutput: updprofilemodel.NewUpdateProfileOutput(verified(userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "old@gmail.com"))),
+			expErr:    nil,
+		}, {
+			testName: "Nothing to update",
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, nil),
+			expOutput: nil,
+			expErr:    updprofileerr.ErrNothingToUpdate,
+		}, {
+			testName: "Session not found",
+
+			expSession: true,
+			sessionErr: session.ErrKeyNotFound,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", ptr("Petr"), nil, nil, nil),
+			expOutput: nil,
+			expErr:    updprofileerr.ErrSessionNotFound,
+		}, {
+			testName: "User not found",
+
+			expSession: true,
+
+			expFindById: true,
+			findByIdErr: storagerepo.ErrNoRows,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", ptr("Petr"), nil, nil, nil),
+			expOutput: nil,
+			expErr:    updprofileerr.ErrUserNotFound,
+		}, {
+			testName: "Invalid last name",
+
+			expSession: true,
+
+			expFindById: true,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, ptr(" "), nil),
+			expOutput: nil,
+			expErr:    userdomain.ErrInvalidLastName,
+		}, {
+			testName: "Invalid email",
+
+			expSession: true,
+
+			expFindById: true,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, ptr("gmail")),
+			expOutput: nil,
+			expErr:    userdomain.ErrInvalidEmail,
+		}, {
+			testName: "Email taken",
+
+			expSession: true,
+
+			expFindById: true,
+
+			expFindByEmail:    true,
+			findByEmailEmail:  "new@gmail.com",
+			findByEmailOutput: userdomain.NewUserDomain(2, "Petr", "", "Petrov", "hashPass", "new@gmail.com"),
+			findByEmailErr:    nil,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, ptr("new@gmail.com")),
+			expOutput: nil,
+			expErr:    updprofileerr.ErrEmailAlreadyExists,
+		}, {
+			testName: "Email taken concurrently",
+
+			expSession: true,
+
+			expFindById: true,
+
+			expFindByEmail:   true,
+			findByEmailEmail: "new@gmail.com",
+			findByEmailErr:   storagerepo.ErrNoRows,
+
+			expUpdate:   true,
+			updateInput: userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "new@gmail.com"),
+			updateErr:   storagerepo.ErrAlreadyExists,
+
+			in:        updprofilemodel.NewUpdateProfileInput("sessionId", nil, nil, nil, ptr("new@gmail.com")),
+			expOutput: nil,
+			expErr:    updprofileerr.ErrEmailAlreadyExists,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			sessionMock := updprofilemocks.NewMockSessionRepo(ctrl)
+			if tt.expSession {
+				sessionMock.EXPECT().Get(gomock.Any(), "sessionId").
+					Return(uint32(1), tt.sessionErr)
+			}
+
+			storMock := updprofilemocks.NewMockStorageRepo(ctrl)
+			if tt.expFindById {
+				var ud *userdomain.UserDomain
+				if tt.findByIdErr == nil {
+					ud = verified(userdomain.NewUserDomain(1, "Ivan", "Ivanovich", "Ivanov", "hashPass", "old@gmail.com"))
+				}
+				storMock.EXPECT().FindById(gomock.Any(), uint32(1)).
+					Return(ud, tt.findByIdErr)
+			}
+			if tt.expFindByEmail {
+				storMock.EXPECT().FindByEmail(gomock.Any(), tt.findByEmailEmail).
+					Return(tt.findByEmailOutput, tt.findByEmailErr)
+			}
+			if tt.expUpdate {
+				storMock.EXPECT().Update(gomock.Any(), tt.updateInput).
+					Return(tt.updateErr)
+			}
+
+			idgenMock := updprofilemocks.NewMockIDGenerator(ctrl)
+			tokenMock := updprofilemocks.NewMockEmailTokenRepo(ctrl)
+			notifierMock := updprofilemocks.NewMockNotifier(ctrl)
+			if tt.expVerification {
+				idgenMock.EXPECT().New().Return("token")
+				tokenMock.EXPECT().Save(gomock.Any(), "token", uint32(1), *tt.in.Email).
+					Return(tt.tokenSaveErr)
+				notifierMock.EXPECT().SendEmailVerification(gomock.Any(), *tt.in.Email, "token").
+					Return(tt.notifyErr)
+			}
+
+			updProfile := NewUpdateProfileUC(log, sessionMock, storMock, tokenMock, notifierMock, idgenMock)
+
+			out, err := updProfile.Execute(context.Background(), tt.in)
+			require.Equal(t, tt.expErr, err)
+			require.Equal(t, tt.expOutput, out)
+		})
+	}
+}
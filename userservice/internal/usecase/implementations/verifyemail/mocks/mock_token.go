@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./../../../repository/token/emailtokenrepo.go
+//
+// Generated by this command:
+//
+//	mockgen -source=./../../../repository/token/emailtokenrepo.go -destination=mocks/mock_token.go -package=verifymocks
+//
+
+// Package verifymocks is a generated GoMock package.
+package verifymocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEmailTokenRepo is a mock of EmailTokenRepo interface.
+type MockEmailTokenRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailTokenRepoMockRecorder
+	isgomock struct{}
+}
+
+// MockEmailTokenRepoMockRecorder is the mock recorder for MockEmailTokenRepo.
+type MockEmailTokenRepoMockRecorder struct {
+	mock *MockEmailTokenRepo
+}
+
+// NewMockEmailTokenRepo creates a new mock instance.
+func NewMockEmailTokenRepo(ctrl *gomock.Controller) *MockEmailTokenRepo {
+	mock := &MockEmailTokenRepo{ctrl: ctrl}
+	mock.recorder = &MockEmailTokenRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailTokenRepo) EXPECT() *MockEmailTokenRepoMockRecorder {
+	return m.recorder
+}
+
+// Consume mocks base method.
+func (m *MockEmailTokenRepo) Consume(ctx context.Context, token string) (uint32, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Consume", ctx, token)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Consume indicates an expected call of Consume.
+func (mr *MockEmailTokenRepoMockRecorder) Consume(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Consume", reflect.TypeOf((*MockEmailTokenRepo)(nil).Consume), ctx, token)
+}
+
+// Save mocks base method.
+func (m *MockEmailTokenRepo) Save(ctx context.Context, token string, userId uint32, email string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, token, userId, email)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockEmailTokenRepoMockRecorder) Save(ctx, token, userId, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockEmailTokenRepo)(nil).Save), ctx, token, userId, email)
+}
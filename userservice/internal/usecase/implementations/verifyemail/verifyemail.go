@@ -0,0 +1,59 @@
+package verifyemail
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	verifyerr "userservice/internal/usecase/errors/verifyemail"
+	verifymodel "userservice/internal/usecase/models/verifyemail"
+)
+
+type VerifyEmailUC struct {
+	log *slog.Logger
+
+	tokenRepo   token.EmailTokenRepo
+	storageRepo storagerepo.StorageRepo
+}
+
+func NewVerifyEmailUC(log *slog.Logger, tokenRepo token.EmailTokenRepo, storageRepo storagerepo.StorageRepo) *VerifyEmailUC {
+	return &VerifyEmailUC{
+		log:         log,
+		tokenRepo:   tokenRepo,
+		storageRepo: storageRepo,
+	}
+}
+
+func (v *VerifyEmailUC) Execute(ctx context.Context, in *verifymodel.VerifyEmailInput) (*verifymodel.VerifyEmailOutput, error) {
+	const op = "verifyemail.Execute"
+	log := v.log.With(slog.String("op", op))
+
+	log.InfoContext(ctx, "email verification started")
+
+	userId, email, err := v.tokenRepo.Consume(ctx, in.Token)
+	if err != nil {
+		if errors.Is(err, token.ErrTokenNotFound) {
+			log.InfoContext(ctx, "email verification stopped: token not found")
+			return verifymodel.NewVerifyEmailOutput(false), verifyerr.ErrInvalidToken
+		}
+		log.WarnContext(ctx, "email verification stopped", slog.String("error", err.Error()))
+		return verifymodel.NewVerifyEmailOutput(false), err
+	}
+
+	log = log.With(slog.Uint64("user_id", uint64(userId)))
+
+	// no rows also means the user changed the address after the token was sent
+	if err := v.storageRepo.VerifyEmail(ctx, userId, email); err != nil {
+		if errors.Is(err, storagerepo.ErrNoRows) {
+			log.InfoContext(ctx, "email verification stopped: user not found or email changed")
+			return verifymodel.NewVerifyEmailOutput(false), verifyerr.ErrInvalidToken
+		}
+		log.WarnContext(ctx, "email verification stopped: cannot verify email", slog.String("error", err.Error()))
+		return verifymodel.NewVerifyEmailOutput(false), err
+	}
+
+	log.InfoContext(ctx, "email verification completed successfully")
+
+	return verifymodel.NewVerifyEmailOutput(true), nil
+}
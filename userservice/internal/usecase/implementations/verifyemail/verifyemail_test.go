@@ -0,0 +1,104 @@
+package verifyemail
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"testing"
+	storagerepo "userservice/internal/repository/storage"
+	"userservice/internal/repository/token"
+	verifyerr "userservice/internal/usecase/errors/verifyemail"
+	verifymocks "userservice/internal/usecase/implementations/verifyemail/mocks"
+	verifymodel "userservice/internal/usecase/models/verifyemail"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+//go:generate mockgen -source=./../../../repository/token/emailtokenrepo.go -destination=mocks/mock_token.go -package=verifymocks
+//go:generate mockgen -source=./../../../repository/storage/storagerepo.go -destination=mocks/mock_storage.go -package=verifymocks
+func TestVerifyEmail(t *testing.T) {
+	errPostgres := errors.New("postgres error")
+
+	tests := []struct {
+		testName string
+
+		consumeReturn uint32
+		consumeErr    error
+
+		expVerify bool
+		verifyErr error
+
+		in     *verifymodel.VerifyEmailInput
+		expOut *verifymodel.VerifyEmailOutput
+		expErr error
+	}{
+		{
+			testName: "Success",
+
+			consumeReturn: 1,
+
+			expVerify: true,
+
+			in:     verifymodel.NewVerifyEmailInput("token"),
+			expOut: verifymodel.NewVerifyEmailOutput(true),
+			expErr: nil,
+		}, {
+			testName: "Invalid token",
+
+			consumeErr: token.ErrTokenNotFound,
+
+			in:     verifymodel.NewVerifyEmailInput("token"),
+			expOut: verifymodel.NewVerifyEmailOutput(false),
+			expErr: verifyerr.ErrInvalidToken,
+		}, {
+			testName: "User not found or email changed",
+
+			consumeReturn: 1,
+
+			expVerify: true,
+			verifyErr: storagerepo.ErrNoRows,
+
+			in:     verifymodel.NewVerifyEmailInput("token"),
+			expOut: verifymodel.NewVerifyEmailOutput(false),
+			expErr: verifyerr.ErrInvalidToken,
+		}, {
+			testName: "Storage error",
+
+			consumeReturn: 1,
+
+			expVerify: true,
+			verifyErr: errPostgres,
+
+			in:     verifymodel.NewVerifyEmailInput("token"),
+			expOut: verifymodel.NewVerifyEmailOutput(false),
+			expErr: errPostgres,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.testName, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			log := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+			tokenMock := verifymocks.NewMockEmailTokenRepo(ctrl)
+			tokenMock.EXPECT().Consume(gomock.Any(), tt.in.Token).
+				Return(tt.consumeReturn, "ivan@gmail.com", tt.consumeErr)
+
+			storageMock := verifymocks.NewMockStorageRepo(ctrl)
+			if tt.expVerify {
+				storageMock.EXPECT().VerifyEmail(gomock.Any(), tt.consumeReturn, "ivan@gmail.com").
+					Return(tt.verifyErr)
+			}
+
+			verifyUC := NewVerifyEmailUC(log, tokenMock, storageMock)
+
+			out, err := verifyUC.Execute(context.Background(), tt.in)
+			require.ErrorIs(t, err, tt.expErr)
+			require.Equal(t, tt.expOut, out)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	tokensmodel "userservice/internal/usecase/models/apitokens"
+)
+
+type GetApiTokensUsecase interface {
+	Execute(ctx context.Context, in *tokensmodel.TokensInput) (*tokensmodel.TokensOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	authtokenmodel "userservice/internal/usecase/models/authenticatetoken"
+)
+
+type GetUserIDByTokenUsecase interface {
+	Execute(ctx context.Context, in *authtokenmodel.AuthTokenInput) (*authtokenmodel.AuthTokenOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	batchgetmodel "userservice/internal/usecase/models/batchgetusers"
+)
+
+type BatchGetUsersUsecase interface {
+	Execute(ctx context.Context, in *batchgetmodel.BatchGetUsersInput) (*batchgetmodel.BatchGetUsersOutput, error)
+}
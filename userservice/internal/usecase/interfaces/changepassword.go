@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	changepassmodel "userservice/internal/usecase/models/changepassword"
+)
+
+type ChangePasswordUsecase interface {
+	Execute(ctx context.Context, in *changepassmodel.ChangePasswordInput) (*changepassmodel.ChangePasswordOutput, error)
+}
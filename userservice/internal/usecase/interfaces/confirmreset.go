@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	confresetmodel "userservice/internal/usecase/models/confirmreset"
+)
+
+type ConfirmResetUsecase interface {
+	Execute(ctx context.Context, in *confresetmodel.ConfirmResetInput) (*confresetmodel.ConfirmResetOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	createtokenmodel "userservice/internal/usecase/models/createapitoken"
+)
+
+type CreateApiTokenUsecase interface {
+	Execute(ctx context.Context, in *createtokenmodel.CreateTokenInput) (*createtokenmodel.CreateTokenOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	getprofilemodel "userservice/internal/usecase/models/getprofile"
+)
+
+type GetProfileUsecase interface {
+	Execute(ctx context.Context, in *getprofilemodel.GetProfileInput) (*getprofilemodel.GetProfileOutput, error)
+}
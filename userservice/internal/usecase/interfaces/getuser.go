@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	getusermodel "userservice/internal/usecase/models/getuser"
+)
+
+type GetUserUsecase interface {
+	Execute(ctx context.Context, in *getusermodel.GetUserInput) (*getusermodel.GetUserOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	issuetokenmodel "userservice/internal/usecase/models/issuetoken"
+)
+
+type IssueTokenUsecase interface {
+	Execute(ctx context.Context, in *issuetokenmodel.IssueTokenInput) (*issuetokenmodel.IssueTokenOutput, error)
+}
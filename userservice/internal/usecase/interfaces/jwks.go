@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	jwksmodel "userservice/internal/usecase/models/jwks"
+)
+
+type GetJWKSUsecase interface {
+	Execute(ctx context.Context) (*jwksmodel.JWKSOutput, error)
+}
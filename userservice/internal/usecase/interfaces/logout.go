@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	logoutmodel "userservice/internal/usecase/models/logout"
+)
+
+type LogoutUserUsecase interface {
+	Execute(ctx context.Context, in *logoutmodel.LogoutInput) (*logoutmodel.LogoutOutput, error)
+}
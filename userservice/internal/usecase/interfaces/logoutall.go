@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	logoutallmodel "userservice/internal/usecase/models/logoutall"
+)
+
+type LogoutAllUsecase interface {
+	Execute(ctx context.Context, in *logoutallmodel.LogoutAllInput) (*logoutallmodel.LogoutAllOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	refreshmodel "userservice/internal/usecase/models/refreshtoken"
+)
+
+type RefreshTokenUsecase interface {
+	Execute(ctx context.Context, in *refreshmodel.RefreshInput) (*refreshmodel.RefreshOutput, error)
+}
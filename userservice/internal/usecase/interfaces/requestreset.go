@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	reqresetmodel "userservice/internal/usecase/models/requestreset"
+)
+
+type RequestResetUsecase interface {
+	Execute(ctx context.Context, in *reqresetmodel.RequestResetInput) (*reqresetmodel.RequestResetOutput, error)
+}
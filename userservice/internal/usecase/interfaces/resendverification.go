@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	resendmodel "userservice/internal/usecase/models/resendverification"
+)
+
+type ResendVerificationUsecase interface {
+	Execute(ctx context.Context, in *resendmodel.ResendInput) (*resendmodel.ResendOutput, error)
+}
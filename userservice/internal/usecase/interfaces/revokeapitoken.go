@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	revoketokenmodel "userservice/internal/usecase/models/revokeapitoken"
+)
+
+type RevokeApiTokenUsecase interface {
+	Execute(ctx context.Context, in *revoketokenmodel.RevokeTokenInput) (*revoketokenmodel.RevokeTokenOutput, error)
+}
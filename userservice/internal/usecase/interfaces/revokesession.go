@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	revokemodel "userservice/internal/usecase/models/revokesession"
+)
+
+type RevokeSessionUsecase interface {
+	Execute(ctx context.Context, in *revokemodel.RevokeInput) (*revokemodel.RevokeOutput, error)
+}
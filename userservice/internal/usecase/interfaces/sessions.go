@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	sessionsmodel "userservice/internal/usecase/models/sessions"
+)
+
+type GetSessionsUsecase interface {
+	Execute(ctx context.Context, in *sessionsmodel.SessionsInput) (*sessionsmodel.SessionsOutput, error)
+}
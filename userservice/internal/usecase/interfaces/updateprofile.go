@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	updprofilemodel "userservice/internal/usecase/models/updateprofile"
+)
+
+type UpdateProfileUsecase interface {
+	Execute(ctx context.Context, in *updprofilemodel.UpdateProfileInput) (*updprofilemodel.UpdateProfileOutput, error)
+}
@@ -0,0 +1,10 @@
+package interfaces
+
+import (
+	"context"
+	verifymodel "userservice/internal/usecase/models/verifyemail"
+)
+
+type VerifyEmailUsecase interface {
+	Execute(ctx context.Context, in *verifymodel.VerifyEmailInput) (*verifymodel.VerifyEmailOutput, error)
+}
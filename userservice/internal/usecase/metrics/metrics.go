@@ -0,0 +1,94 @@
+package ucmetrics
+
+import (
+	"context"
+	"errors"
+	logerr "userservice/internal/usecase/errors/login"
+	regerr "userservice/internal/usecase/errors/registration"
+	"userservice/internal/usecase/interfaces"
+	logmodel "userservice/internal/usecase/models/login"
+	regmodel "userservice/internal/usecase/models/registration"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	resultSuccess = "success"
+	resultError   = "error"
+)
+
+type UsecaseMetrics struct {
+	logins        *prometheus.CounterVec
+	registrations *prometheus.CounterVec
+}
+
+func NewUsecaseMetrics(reg prometheus.Registerer) *UsecaseMetrics {
+	m := &UsecaseMetrics{
+		logins: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "user_logins_total",
+			Help: "Login attempts by result.",
+		}, []string{"result"}),
+		registrations: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "user_registrations_total",
+			Help: "Registration attempts by result.",
+		}, []string{"result"}),
+	}
+	reg.MustRegister(m.logins, m.registrations)
+	return m
+}
+
+func (m *UsecaseMetrics) Login(uc interfaces.LoginUserUsecase) interfaces.LoginUserUsecase {
+	return &loginUC{uc: uc, logins: m.logins}
+}
+
+func (m *UsecaseMetrics) Registration(uc interfaces.RegisterUserUsecase) interfaces.RegisterUserUsecase {
+	return &regUC{uc: uc, registrations: m.registrations}
+}
+
+type loginUC struct {
+	uc     interfaces.LoginUserUsecase
+	logins *prometheus.CounterVec
+}
+
+func (u *loginUC) Execute(ctx context.Context, in *logmodel.LoginInput) (*logmodel.LoginOutput, error) {
+	out, err := u.uc.Execute(ctx, in)
+	u.logins.WithLabelValues(loginResult(err)).Inc()
+	return out, err
+}
+
+func loginResult(err error) string {
+	switch {
+	case err == nil:
+		return resultSuccess
+	case errors.Is(err, logerr.ErrInvalidCredentials):
+		return "invalid_credentials"
+	case errors.Is(err, logerr.ErrTooManyAttempts):
+		return "too_many_attempts"
+	case errors.Is(err, logerr.ErrEmailNotVerified):
+		return "email_not_verified"
+	default:
+		return resultError
+	}
+}
+
+type regUC struct {
+	uc            interfaces.RegisterUserUsecase
+	registrations *prometheus.CounterVec
+}
+
+func (u *regUC) Execute(ctx context.Context, in *regmodel.RegInput) (*regmodel.RegOutput, error) {
+	out, err := u.uc.Execute(ctx, in)
+	u.registrations.WithLabelValues(regResult(err)).Inc()
+	return out, err
+}
+
+func regResult(err error) string {
+	switch {
+	case err == nil:
+		return resultSuccess
+	case errors.Is(err, regerr.ErrUserAlreadyExists):
+		return "already_exists"
+	default:
+		return resultError
+	}
+}
@@ -0,0 +1,51 @@
+package ucmetrics
+
+import (
+	"context"
+	"errors"
+	"testing"
+	logerr "userservice/internal/usecase/errors/login"
+	regerr "userservice/internal/usecase/errors/registration"
+	logmodel "userservice/internal/usecase/models/login"
+	regmodel "userservice/internal/usecase/models/registration"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+)
+
+type stubLoginUC struct {
+	err error
+}
+
+func (s stubLoginUC) Execute(ctx context.Context, in *logmodel.LoginInput) (*logmodel.LoginOutput, error) {
+	return nil, s.err
+}
+
+type stubRegUC struct {
+	err error
+}
+
+func (s stubRegUC) Execute(ctx context.Context, in *regmodel.RegInput) (*regmodel.RegOutput, error) {
+	return nil, s.err
+}
+
+func TestUsecaseMetrics(t *testing.T) {
+	m := NewUsecaseMetrics(prometheus.NewRegistry())
+
+	for _, err := range []error{nil, logerr.ErrInvalidCredentials, logerr.ErrTooManyAttempts, logerr.ErrInvalidCredentials, errors.New("redis down")} {
+		_, gotErr := m.Login(stubLoginUC{err: err}).Execute(context.Background(), nil)
+		require.Equal(t, err, gotErr)
+	}
+	for _, err := range []error{nil, regerr.ErrUserAlreadyExists} {
+		_, gotErr := m.Registration(stubRegUC{err: err}).Execute(context.Background(), nil)
+		require.Equal(t, err, gotErr)
+	}
+
+	require.Equal(t, float64(1), testutil.ToFloat64(m.logins.WithLabelValues("success")))
+	require.Equal(t, float64(2), testutil.ToFloat64(m.logins.WithLabelValues("invalid_credentials")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.logins.WithLabelValues("too_many_attempts")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.logins.WithLabelValues("error")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.registrations.WithLabelValues("success")))
+	require.Equal(t, float64(1), testutil.ToFloat64(m.registrations.WithLabelValues("already_exists")))
+}
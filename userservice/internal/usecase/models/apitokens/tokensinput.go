@@ -0,0 +1,11 @@
+package tokensmodel
+
+type TokensInput struct {
+	SessionId string
+}
+
+func NewTokensInput(sessionId string) *TokensInput {
+	return &TokensInput{
+		SessionId: sessionId,
+	}
+}
@@ -0,0 +1,13 @@
+package tokensmodel
+
+import apitokendomain "userservice/internal/domain/apitoken"
+
+type TokensOutput struct {
+	Tokens []*apitokendomain.ApiTokenDomain
+}
+
+func NewTokensOutput(tokens []*apitokendomain.ApiTokenDomain) *TokensOutput {
+	return &TokensOutput{
+		Tokens: tokens,
+	}
+}
@@ -0,0 +1,11 @@
+package authtokenmodel
+
+type AuthTokenInput struct {
+	Token string
+}
+
+func NewAuthTokenInput(token string) *AuthTokenInput {
+	return &AuthTokenInput{
+		Token: token,
+	}
+}
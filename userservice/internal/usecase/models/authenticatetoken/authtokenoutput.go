@@ -0,0 +1,11 @@
+package authtokenmodel
+
+type AuthTokenOutput struct {
+	UserId uint32
+}
+
+func NewAuthTokenOutput(userId uint32) *AuthTokenOutput {
+	return &AuthTokenOutput{
+		UserId: userId,
+	}
+}
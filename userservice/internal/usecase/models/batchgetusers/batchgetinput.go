@@ -0,0 +1,11 @@
+package batchgetmodel
+
+type BatchGetUsersInput struct {
+	UserIds []uint32
+}
+
+func NewBatchGetUsersInput(userIds []uint32) *BatchGetUsersInput {
+	return &BatchGetUsersInput{
+		UserIds: userIds,
+	}
+}
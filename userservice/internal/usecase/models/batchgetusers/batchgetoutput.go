@@ -0,0 +1,13 @@
+package batchgetmodel
+
+import userdomain "userservice/internal/domain/user"
+
+type BatchGetUsersOutput struct {
+	Users []*userdomain.UserDomain
+}
+
+func NewBatchGetUsersOutput(users []*userdomain.UserDomain) *BatchGetUsersOutput {
+	return &BatchGetUsersOutput{
+		Users: users,
+	}
+}
@@ -0,0 +1,15 @@
+package changepassmodel
+
+type ChangePasswordInput struct {
+	SessionId   string
+	OldPassword string
+	NewPassword string
+}
+
+func NewChangePasswordInput(sessionId, oldPassword, newPassword string) *ChangePasswordInput {
+	return &ChangePasswordInput{
+		SessionId:   sessionId,
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	}
+}
@@ -0,0 +1,11 @@
+package changepassmodel
+
+type ChangePasswordOutput struct {
+	IsChanged bool
+}
+
+func NewChangePasswordOutput(isChanged bool) *ChangePasswordOutput {
+	return &ChangePasswordOutput{
+		IsChanged: isChanged,
+	}
+}
@@ -0,0 +1,13 @@
+package confresetmodel
+
+type ConfirmResetInput struct {
+	Token       string
+	NewPassword string
+}
+
+func NewConfirmResetInput(token, newPassword string) *ConfirmResetInput {
+	return &ConfirmResetInput{
+		Token:       token,
+		NewPassword: newPassword,
+	}
+}
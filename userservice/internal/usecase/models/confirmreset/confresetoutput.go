@@ -0,0 +1,11 @@
+package confresetmodel
+
+type ConfirmResetOutput struct {
+	IsReset bool
+}
+
+func NewConfirmResetOutput(isReset bool) *ConfirmResetOutput {
+	return &ConfirmResetOutput{
+		IsReset: isReset,
+	}
+}
@@ -0,0 +1,17 @@
+package createtokenmodel
+
+import "time"
+
+type CreateTokenInput struct {
+	SessionId string
+	Name      string
+	TTL       time.Duration
+}
+
+func NewCreateTokenInput(sessionId, name string, ttl time.Duration) *CreateTokenInput {
+	return &CreateTokenInput{
+		SessionId: sessionId,
+		Name:      name,
+		TTL:       ttl,
+	}
+}
@@ -0,0 +1,15 @@
+package createtokenmodel
+
+import apitokendomain "userservice/internal/domain/apitoken"
+
+type CreateTokenOutput struct {
+	Secret string
+	Token  *apitokendomain.ApiTokenDomain
+}
+
+func NewCreateTokenOutput(secret string, token *apitokendomain.ApiTokenDomain) *CreateTokenOutput {
+	return &CreateTokenOutput{
+		Secret: secret,
+		Token:  token,
+	}
+}
@@ -0,0 +1,11 @@
+package getprofilemodel
+
+type GetProfileInput struct {
+	SessionId string
+}
+
+func NewGetProfileInput(sessionId string) *GetProfileInput {
+	return &GetProfileInput{
+		SessionId: sessionId,
+	}
+}
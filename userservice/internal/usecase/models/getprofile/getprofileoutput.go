@@ -0,0 +1,13 @@
+package getprofilemodel
+
+import userdomain "userservice/internal/domain/user"
+
+type GetProfileOutput struct {
+	User *userdomain.UserDomain
+}
+
+func NewGetProfileOutput(user *userdomain.UserDomain) *GetProfileOutput {
+	return &GetProfileOutput{
+		User: user,
+	}
+}
@@ -0,0 +1,11 @@
+package getusermodel
+
+type GetUserInput struct {
+	UserId uint32
+}
+
+func NewGetUserInput(userId uint32) *GetUserInput {
+	return &GetUserInput{
+		UserId: userId,
+	}
+}
@@ -0,0 +1,13 @@
+package getusermodel
+
+import userdomain "userservice/internal/domain/user"
+
+type GetUserOutput struct {
+	User *userdomain.UserDomain
+}
+
+func NewGetUserOutput(user *userdomain.UserDomain) *GetUserOutput {
+	return &GetUserOutput{
+		User: user,
+	}
+}
@@ -0,0 +1,11 @@
+package issuetokenmodel
+
+type IssueTokenInput struct {
+	SessionId string
+}
+
+func NewIssueTokenInput(sessionId string) *IssueTokenInput {
+	return &IssueTokenInput{
+		SessionId: sessionId,
+	}
+}
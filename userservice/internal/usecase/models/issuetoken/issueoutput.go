@@ -0,0 +1,17 @@
+package issuetokenmodel
+
+import "time"
+
+type IssueTokenOutput struct {
+	AccessToken  string
+	ExpiresAt    time.Time
+	RefreshToken string
+}
+
+func NewIssueTokenOutput(accessToken string, expiresAt time.Time, refreshToken string) *IssueTokenOutput {
+	return &IssueTokenOutput{
+		AccessToken:  accessToken,
+		ExpiresAt:    expiresAt,
+		RefreshToken: refreshToken,
+	}
+}
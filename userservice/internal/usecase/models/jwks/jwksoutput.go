@@ -0,0 +1,13 @@
+package jwksmodel
+
+import signingkeydomain "userservice/internal/domain/signingkey"
+
+type JWKSOutput struct {
+	Keys []*signingkeydomain.SigningKeyDomain
+}
+
+func NewJWKSOutput(keys []*signingkeydomain.SigningKeyDomain) *JWKSOutput {
+	return &JWKSOutput{
+		Keys: keys,
+	}
+}
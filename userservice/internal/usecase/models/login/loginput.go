@@ -1,13 +1,17 @@
 package logmodel
 
 type LoginInput struct {
-	Email    string
-	Password string
+	Email     string
+	Password  string
+	UserAgent string
+	IP        string
 }
 
-func NewLoginInput(email, password string) *LoginInput {
+func NewLoginInput(email, password, userAgent, ip string) *LoginInput {
 	return &LoginInput{
-		Email:    email,
-		Password: password,
+		Email:     email,
+		Password:  password,
+		UserAgent: userAgent,
+		IP:        ip,
 	}
 }
@@ -1,10 +1,14 @@
 package logmodel
 
+import "time"
+
 type LoginOutput struct {
 	SessionId  string
 	FirstName  string
 	MiddleName string
 	LastName   string
+
+	RetryAfter time.Duration
 }
 
 func NewLoginOutput(sessionId, firstName, middleName, lastName string) *LoginOutput {
@@ -0,0 +1,11 @@
+package logoutmodel
+
+type LogoutInput struct {
+	SessionId string
+}
+
+func NewLogoutInput(sessionId string) *LogoutInput {
+	return &LogoutInput{
+		SessionId: sessionId,
+	}
+}
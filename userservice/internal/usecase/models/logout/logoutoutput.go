@@ -0,0 +1,11 @@
+package logoutmodel
+
+type LogoutOutput struct {
+	IsLoggedOut bool
+}
+
+func NewLogoutOutput(isLoggedOut bool) *LogoutOutput {
+	return &LogoutOutput{
+		IsLoggedOut: isLoggedOut,
+	}
+}
@@ -0,0 +1,11 @@
+package logoutallmodel
+
+type LogoutAllInput struct {
+	SessionId string
+}
+
+func NewLogoutAllInput(sessionId string) *LogoutAllInput {
+	return &LogoutAllInput{
+		SessionId: sessionId,
+	}
+}
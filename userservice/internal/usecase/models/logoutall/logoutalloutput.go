@@ -0,0 +1,11 @@
+package logoutallmodel
+
+type LogoutAllOutput struct {
+	IsLoggedOut bool
+}
+
+func NewLogoutAllOutput(isLoggedOut bool) *LogoutAllOutput {
+	return &LogoutAllOutput{
+		IsLoggedOut: isLoggedOut,
+	}
+}
@@ -0,0 +1,11 @@
+package refreshmodel
+
+type RefreshInput struct {
+	RefreshToken string
+}
+
+func NewRefreshInput(refreshToken string) *RefreshInput {
+	return &RefreshInput{
+		RefreshToken: refreshToken,
+	}
+}
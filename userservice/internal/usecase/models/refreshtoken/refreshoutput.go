@@ -0,0 +1,17 @@
+package refreshmodel
+
+import "time"
+
+type RefreshOutput struct {
+	AccessToken  string
+	ExpiresAt    time.Time
+	RefreshToken string
+}
+
+func NewRefreshOutput(accessToken string, expiresAt time.Time, refreshToken string) *RefreshOutput {
+	return &RefreshOutput{
+		AccessToken:  accessToken,
+		ExpiresAt:    expiresAt,
+		RefreshToken: refreshToken,
+	}
+}
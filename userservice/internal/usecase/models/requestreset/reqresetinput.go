@@ -0,0 +1,11 @@
+package reqresetmodel
+
+type RequestResetInput struct {
+	Email string
+}
+
+func NewRequestResetInput(email string) *RequestResetInput {
+	return &RequestResetInput{
+		Email: email,
+	}
+}
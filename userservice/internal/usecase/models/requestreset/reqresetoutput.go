@@ -0,0 +1,11 @@
+package reqresetmodel
+
+type RequestResetOutput struct {
+	IsRequested bool
+}
+
+func NewRequestResetOutput(isRequested bool) *RequestResetOutput {
+	return &RequestResetOutput{
+		IsRequested: isRequested,
+	}
+}
@@ -0,0 +1,11 @@
+package resendmodel
+
+type ResendInput struct {
+	Email string
+}
+
+func NewResendInput(email string) *ResendInput {
+	return &ResendInput{
+		Email: email,
+	}
+}
@@ -0,0 +1,11 @@
+package resendmodel
+
+type ResendOutput struct {
+	IsRequested bool
+}
+
+func NewResendOutput(isRequested bool) *ResendOutput {
+	return &ResendOutput{
+		IsRequested: isRequested,
+	}
+}
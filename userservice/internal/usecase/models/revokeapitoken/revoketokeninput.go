@@ -0,0 +1,13 @@
+package revoketokenmodel
+
+type RevokeTokenInput struct {
+	SessionId string
+	TokenId   uint32
+}
+
+func NewRevokeTokenInput(sessionId string, tokenId uint32) *RevokeTokenInput {
+	return &RevokeTokenInput{
+		SessionId: sessionId,
+		TokenId:   tokenId,
+	}
+}
@@ -0,0 +1,11 @@
+package revoketokenmodel
+
+type RevokeTokenOutput struct {
+	IsRevoked bool
+}
+
+func NewRevokeTokenOutput(isRevoked bool) *RevokeTokenOutput {
+	return &RevokeTokenOutput{
+		IsRevoked: isRevoked,
+	}
+}
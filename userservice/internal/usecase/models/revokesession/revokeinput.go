@@ -0,0 +1,13 @@
+package revokemodel
+
+type RevokeInput struct {
+	SessionId string
+	TargetId  string
+}
+
+func NewRevokeInput(sessionId, targetId string) *RevokeInput {
+	return &RevokeInput{
+		SessionId: sessionId,
+		TargetId:  targetId,
+	}
+}
@@ -0,0 +1,13 @@
+package revokemodel
+
+type RevokeOutput struct {
+	IsRevoked bool
+	IsCurrent bool
+}
+
+func NewRevokeOutput(isRevoked, isCurrent bool) *RevokeOutput {
+	return &RevokeOutput{
+		IsRevoked: isRevoked,
+		IsCurrent: isCurrent,
+	}
+}
@@ -0,0 +1,11 @@
+package sessionsmodel
+
+type SessionsInput struct {
+	SessionId string
+}
+
+func NewSessionsInput(sessionId string) *SessionsInput {
+	return &SessionsInput{
+		SessionId: sessionId,
+	}
+}
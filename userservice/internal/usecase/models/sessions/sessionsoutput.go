@@ -0,0 +1,15 @@
+package sessionsmodel
+
+import sessiondomain "userservice/internal/domain/session"
+
+type SessionsOutput struct {
+	CurrentId string
+	Sessions  []*sessiondomain.SessionDomain
+}
+
+func NewSessionsOutput(currentId string, sessions []*sessiondomain.SessionDomain) *SessionsOutput {
+	return &SessionsOutput{
+		CurrentId: currentId,
+		Sessions:  sessions,
+	}
+}
@@ -0,0 +1,19 @@
+package updprofilemodel
+
+type UpdateProfileInput struct {
+	SessionId  string
+	FirstName  *string
+	MiddleName *string
+	LastName   *string
+	Email      *string
+}
+
+func NewUpdateProfileInput(sessionId string, firstName, middleName, lastName, email *string) *UpdateProfileInput {
+	return &UpdateProfileInput{
+		SessionId:  sessionId,
+		FirstName:  firstName,
+		MiddleName: middleName,
+		LastName:   lastName,
+		Email:      email,
+	}
+}
@@ -0,0 +1,13 @@
+package updprofilemodel
+
+import userdomain "userservice/internal/domain/user"
+
+type UpdateProfileOutput struct {
+	User *userdomain.UserDomain
+}
+
+func NewUpdateProfileOutput(user *userdomain.UserDomain) *UpdateProfileOutput {
+	return &UpdateProfileOutput{
+		User: user,
+	}
+}
@@ -0,0 +1,11 @@
+package verifymodel
+
+type VerifyEmailInput struct {
+	Token string
+}
+
+func NewVerifyEmailInput(token string) *VerifyEmailInput {
+	return &VerifyEmailInput{
+		Token: token,
+	}
+}
@@ -0,0 +1,11 @@
+package verifymodel
+
+type VerifyEmailOutput struct {
+	IsVerified bool
+}
+
+func NewVerifyEmailOutput(isVerified bool) *VerifyEmailOutput {
+	return &VerifyEmailOutput{
+		IsVerified: isVerified,
+	}
+}
@@ -0,0 +1,4 @@
+-- accounts created before verification existed count as verified,
+-- new rows start unverified
+ALTER TABLE users ADD COLUMN IF NOT EXISTS email_verified BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE users ALTER COLUMN email_verified SET DEFAULT FALSE;
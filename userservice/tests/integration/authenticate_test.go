@@ -2,8 +2,8 @@ package integration
 
 import (
 	"context"
+	userservicev1 "platform/proto/userservice"
 	"testing"
-	userservicev1 "userservice/proto/userservice"
 
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
@@ -92,8 +92,8 @@ func TestLogin_UserNotFound_Integration(t *testing.T) {
 	require.NoError(t, err)
 	defer resp.Body.Close()
 
-	expBody := "user not found"
-	expStatusCode := http.StatusNotFound
+	expBody := "invalid email or password"
+	expStatusCode := http.StatusUnauthorized
 
 	var resBody struct {
 		Error string `json:"error"`
@@ -118,7 +118,7 @@ func TestLogin_WrongPassword_Integration(t *testing.T) {
 	require.NoError(t, err)
 	defer resp.Body.Close()
 
-	expBody := "wrong password"
+	expBody := "invalid email or password"
 	expStatusCode := http.StatusUnauthorized
 
 	var resBody struct {